@@ -0,0 +1,24 @@
+Feature: switch to the oldest branch of the stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+
+  Scenario: inside a stack
+    Given the current branch is "gamma"
+    When I run "git-town bottom"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | gamma  | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: already at the bottom
+    Given the current branch is "alpha"
+    When I run "git-town bottom"
+    Then it runs no commands
+    And it prints:
+      """
+      branch "alpha" is already at the bottom of its stack
+      """
+    And the current branch is still "alpha"
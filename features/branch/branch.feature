@@ -0,0 +1,82 @@
+Feature: display the branch hierarchy
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a local feature branch "gamma"
+    And a perennial branch "production"
+
+  Scenario: text output
+    When I run "git-town branch"
+    Then it prints:
+      """
+      main
+        alpha
+          beta
+        gamma
+      production
+      """
+
+  Scenario: JSON output
+    When I run "git-town branch --format=json"
+    Then it prints:
+      """
+      {
+        "current_branch": "alpha",
+        "branches": [
+          {
+            "name": "main",
+            "type": "main",
+            "parent": null,
+            "sync_status": "up_to_date",
+            "children": [
+              {
+                "name": "alpha",
+                "type": "feature",
+                "parent": "main",
+                "sync_status": "up_to_date",
+                "children": [
+                  {
+                    "name": "beta",
+                    "type": "feature",
+                    "parent": "alpha",
+                    "sync_status": "up_to_date",
+                    "children": []
+                  }
+                ]
+              },
+              {
+                "name": "gamma",
+                "type": "feature",
+                "parent": "main",
+                "sync_status": "local_only",
+                "children": []
+              }
+            ]
+          },
+          {
+            "name": "production",
+            "type": "perennial",
+            "parent": null,
+            "sync_status": "up_to_date",
+            "children": []
+          },
+          {
+            "name": "initial",
+            "type": "feature",
+            "parent": null,
+            "sync_status": "remote_only",
+            "children": []
+          }
+        ],
+        "unfinished_command": null,
+        "pending_pushes": []
+      }
+      """
+
+  Scenario: unknown format
+    When I run "git-town branch --format=zonk"
+    Then it prints the error:
+      """
+      unknown output format "zonk", please use "text" or "json"
+      """
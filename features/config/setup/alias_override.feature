@@ -9,6 +9,8 @@ Feature: override an existing Git alias
       | main development branch     | enter   |
       | perennial branches          | enter   |
       | perennial regex             | enter   |
+      | contribution regex          | enter   |
+      | observed regex              | enter   |
       | hosting platform            | enter   |
       | origin hostname             | enter   |
       | sync-feature-strategy       | enter   |
@@ -18,6 +20,7 @@ Feature: override an existing Git alias
       | push-hook                   | enter   |
       | ship-delete-tracking-branch | enter   |
       | sync-before-ship            | enter   |
+      | ship-strategy               | enter   |
       | save config to config file  | enter   |
 
   Scenario: result
@@ -13,7 +13,9 @@ Feature: change existing information in Git metadata
       | accept the already configured main branch | enter                  |
       | change the perennial branches             | space down space enter |
       | enter a perennial regex                   | 3 3 6 6 enter          |
-      | set github as hosting service             | up up enter            |
+      | enter a contribution regex                | 4 4 7 7 enter          |
+      | enter an observed regex                   | 5 5 8 8 enter          |
+      | set github as hosting service             | up up up enter         |
       | github token                              | 1 2 3 4 5 6 enter      |
       | origin hostname                           | c o d e enter          |
       | sync-feature-strategy                     | down enter             |
@@ -23,6 +25,7 @@ Feature: change existing information in Git metadata
       | disable the push hook                     | down enter             |
       | disable ship-delete-tracking-branch       | down enter             |
       | sync-before-ship                          | down enter             |
+      | ship-strategy                             | down enter             |
       | save config to Git metadata               | down enter             |
 
   Scenario: result
@@ -66,10 +69,13 @@ Feature: change existing information in Git metadata
     And local Git Town setting "sync-perennial-strategy" is now "merge"
     And local Git Town setting "sync-upstream" is now "false"
     And local Git Town setting "perennial-regex" is now "3366"
+    And local Git Town setting "contribution-regex" is now "4477"
+    And local Git Town setting "observed-regex" is now "5588"
     And local Git Town setting "push-new-branches" is now "true"
     And local Git Town setting "push-hook" is now "true"
     And local Git Town setting "ship-delete-tracking-branch" is now "false"
     And local Git Town setting "sync-before-ship" is now "true"
+    And local Git Town setting "ship-strategy" is now "merge"
 
   Scenario: undo
     When I run "git-town undo"
@@ -93,7 +99,10 @@ Feature: change existing information in Git metadata
     And local Git Town setting "sync-perennial-strategy" now doesn't exist
     And local Git Town setting "sync-upstream" now doesn't exist
     And local Git Town setting "perennial-regex" now doesn't exist
+    And local Git Town setting "contribution-regex" now doesn't exist
+    And local Git Town setting "observed-regex" now doesn't exist
     And local Git Town setting "push-new-branches" is now "false"
     And local Git Town setting "push-hook" is now "false"
     And local Git Town setting "ship-delete-tracking-branch" now doesn't exist
     And local Git Town setting "sync-before-ship" now doesn't exist
+    And local Git Town setting "ship-strategy" now doesn't exist
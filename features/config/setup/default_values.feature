@@ -11,6 +11,8 @@ Feature: Accepting all default values leads to a working setup
       | main development branch     | enter |
       | perennial branches          | enter |
       | perennial regex             | enter |
+      | contribution regex          | enter |
+      | observed regex              | enter |
       | hosting platform            | enter |
       | origin hostname             | enter |
       | sync-feature-strategy       | enter |
@@ -20,6 +22,7 @@ Feature: Accepting all default values leads to a working setup
       | push-hook                   | enter |
       | ship-delete-tracking-branch | enter |
       | sync-before-ship            | enter |
+      | ship-strategy               | enter |
       | save config to config file  | enter |
 
   Scenario: result
@@ -36,6 +39,7 @@ Feature: Accepting all default values leads to a working setup
     And local Git Town setting "sync-upstream" is still not set
     And local Git Town setting "ship-delete-tracking-branch" is still not set
     And local Git Town setting "sync-before-ship" is still not set
+    And local Git Town setting "ship-strategy" is still not set
     And the configuration file is now:
       """
       # Git Town configuration file
@@ -70,6 +74,24 @@ Feature: Accepting all default values leads to a working setup
       # merging pull requests through its UI.
       ship-delete-tracking-branch = true
 
+      # How should "git ship" merge branches into their parent?
+      #
+      # squash-merge: combine all commits on the shipped branch
+      # into a single commit on the parent branch.
+      # If a proposal exists, ship via the code hosting API.
+      #
+      # merge: merge the shipped branch into its parent
+      # using a merge commit.
+      # If a proposal exists, ship via the code hosting API.
+      #
+      # fast-forward: fast-forward the parent branch
+      # to the shipped branch. This keeps the existing commits
+      # and does not create new ones.
+      #
+      # api: squash-merge the proposal via the code hosting API only.
+      # Shipping fails if there is no proposal.
+      ship-strategy = "squash-merge"
+
       # Should "git ship" sync branches before shipping them?
       #
       # Guidance: enable when shipping branches locally on your machine
@@ -114,6 +136,22 @@ Feature: Accepting all default values leads to a working setup
       # If you are not sure, leave this empty.
       perennial-regex = ""
 
+      # All branches whose names match this regular expression
+      # are considered contribution branches.
+      # Git Town pushes your commits to these branches
+      # but does not propose or ship them.
+      #
+      # If you are not sure, leave this empty.
+      contribution-regex = ""
+
+      # All branches whose names match this regular expression
+      # are considered observed branches.
+      # Git Town pulls updates into these branches
+      # but never pushes to them.
+      #
+      # If you are not sure, leave this empty.
+      observed-regex = ""
+
       [hosting]
 
       # Knowing the type of code hosting platform allows Git Town
@@ -169,3 +207,4 @@ Feature: Accepting all default values leads to a working setup
     And local Git Town setting "push-hook" still doesn't exist
     And local Git Town setting "ship-delete-tracking-branch" still doesn't exist
     And local Git Town setting "sync-before-ship" still doesn't exist
+    And local Git Town setting "ship-strategy" still doesn't exist
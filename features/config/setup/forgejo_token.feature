@@ -0,0 +1,64 @@
+Feature: enter the Forgejo API token
+
+  Scenario: auto-detected Forgejo platform
+    Given my repo's "origin" remote is "git@codeberg.org:git-town/git-town.git"
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                        | KEYS              | DESCRIPTION                                 |
+      | welcome                       | enter             |                                             |
+      | aliases                       | enter             |                                             |
+      | main development branch       | enter             |                                             |
+      | perennial branches            |                   | no input here since the dialog doesn't show |
+      | perennial regex               | enter             |                                             |
+      | contribution regex            | enter             |                                             |
+      | observed regex                | enter             |                                             |
+      | hosting platform: auto-detect | enter             |                                             |
+      | forgejo token                 | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname               | enter             |                                             |
+      | sync-feature-strategy         | enter             |                                             |
+      | sync-perennial-strategy       | enter             |                                             |
+      | sync-upstream                 | enter             |                                             |
+      | push-new-branches             | enter             |                                             |
+      | push-hook                     | enter             |                                             |
+      | ship-delete-tracking-branch   | enter             |                                             |
+      | sync-before-ship              | enter             |                                             |
+      | ship-strategy                 | enter             |                                             |
+      | save config to Git metadata   | down enter        |                                             |
+    Then it runs the commands
+      | COMMAND                                  |
+      | git config git-town.forgejo-token 123456 |
+    And local Git Town setting "hosting-platform" still doesn't exist
+    And local Git Town setting "forgejo-token" is now "123456"
+
+  Scenario: select Forgejo manually
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                      | KEYS                 | DESCRIPTION                                 |
+      | welcome                     | enter                |                                             |
+      | aliases                     | enter                |                                             |
+      | main development branch     | enter                |                                             |
+      | perennial branches          |                      | no input here since the dialog doesn't show |
+      | perennial regex             | enter                |                                             |
+      | contribution regex          | enter                |                                             |
+      | observed regex              | enter                |                                             |
+      | hosting platform            | down down down enter |                                             |
+      | forgejo token               | 1 2 3 4 5 6 enter    |                                             |
+      | origin hostname             | enter                |                                             |
+      | sync-feature-strategy       | enter                |                                             |
+      | sync-perennial-strategy     | enter                |                                             |
+      | sync-upstream               | enter                |                                             |
+      | push-new-branches           | enter                |                                             |
+      | push-hook                   | enter                |                                             |
+      | ship-delete-tracking-branch | enter                |                                             |
+      | sync-before-ship            | enter                |                                             |
+      | ship-strategy               | enter                |                                             |
+      | save config to Git metadata | down enter           |                                             |
+    Then it runs the commands
+      | COMMAND                                      |
+      | git config git-town.forgejo-token 123456     |
+      | git config git-town.hosting-platform forgejo |
+    And local Git Town setting "hosting-platform" is now "forgejo"
+    And local Git Town setting "forgejo-token" is now "123456"
+
+  Scenario: undo
+    When I run "git-town undo"
+    And local Git Town setting "hosting-platform" now doesn't exist
+    And local Git Town setting "forgejo-token" now doesn't exist
@@ -9,6 +9,8 @@ Feature: enter the Gitea API token
       | main development branch       | enter             |                                             |
       | perennial branches            |                   | no input here since the dialog doesn't show |
       | perennial regex               | enter             |                                             |
+      | contribution regex            | enter             |                                             |
+      | observed regex                | enter             |                                             |
       | hosting platform: auto-detect | enter             |                                             |
       | gitea token                   | 1 2 3 4 5 6 enter |                                             |
       | origin hostname               | enter             |                                             |
@@ -19,6 +21,7 @@ Feature: enter the Gitea API token
       | push-hook                     | enter             |                                             |
       | ship-delete-tracking-branch   | enter             |                                             |
       | sync-before-ship              | enter             |                                             |
+      | ship-strategy                 | enter             |                                             |
       | save config to Git metadata   | down enter        |                                             |
     Then it runs the commands
       | COMMAND                                |
@@ -28,23 +31,26 @@ Feature: enter the Gitea API token
 
   Scenario: select Gitea manually
     When I run "git-town config setup" and enter into the dialog:
-      | DIALOG                      | KEYS              | DESCRIPTION                                 |
-      | welcome                     | enter             |                                             |
-      | aliases                     | enter             |                                             |
-      | main development branch     | enter             |                                             |
-      | perennial branches          |                   | no input here since the dialog doesn't show |
-      | perennial regex             | enter             |                                             |
-      | hosting platform            | down down enter   |                                             |
-      | gitea token                 | 1 2 3 4 5 6 enter |                                             |
-      | origin hostname             | enter             |                                             |
-      | sync-feature-strategy       | enter             |                                             |
-      | sync-perennial-strategy     | enter             |                                             |
-      | sync-upstream               | enter             |                                             |
-      | push-new-branches           | enter             |                                             |
-      | push-hook                   | enter             |                                             |
-      | ship-delete-tracking-branch | enter             |                                             |
-      | sync-before-ship            | enter             |                                             |
-      | save config to Git metadata | down enter        |                                             |
+      | DIALOG                      | KEYS                      | DESCRIPTION                                 |
+      | welcome                     | enter                     |                                             |
+      | aliases                     | enter                     |                                             |
+      | main development branch     | enter                     |                                             |
+      | perennial branches          |                           | no input here since the dialog doesn't show |
+      | perennial regex             | enter                     |                                             |
+      | contribution regex          | enter                     |                                             |
+      | observed regex              | enter                     |                                             |
+      | hosting platform            | down down down down enter |                                             |
+      | gitea token                 | 1 2 3 4 5 6 enter         |                                             |
+      | origin hostname             | enter                     |                                             |
+      | sync-feature-strategy       | enter                     |                                             |
+      | sync-perennial-strategy     | enter                     |                                             |
+      | sync-upstream               | enter                     |                                             |
+      | push-new-branches           | enter                     |                                             |
+      | push-hook                   | enter                     |                                             |
+      | ship-delete-tracking-branch | enter                     |                                             |
+      | sync-before-ship            | enter                     |                                             |
+      | ship-strategy               | enter                     |                                             |
+      | save config to Git metadata | down enter                |                                             |
     Then it runs the commands
       | COMMAND                                    |
       | git config git-town.gitea-token 123456     |
@@ -9,6 +9,8 @@ Feature: enter the GitLab API token
       | main development branch     | enter             |                                             |
       | perennial branches          |                   | no input here since the dialog doesn't show |
       | perennial regex             | enter             |                                             |
+      | contribution regex          | enter             |                                             |
+      | observed regex              | enter             |                                             |
       | hosting platform            | enter             |                                             |
       | gitlab token                | 1 2 3 4 5 6 enter |                                             |
       | origin hostname             | enter             |                                             |
@@ -19,6 +21,7 @@ Feature: enter the GitLab API token
       | push-hook                   | enter             |                                             |
       | ship-delete-tracking-branch | enter             |                                             |
       | sync-before-ship            | enter             |                                             |
+      | ship-strategy               | enter             |                                             |
       | save config to Git metadata | down enter        |                                             |
     Then it runs the commands
       | COMMAND                                 |
@@ -34,7 +37,9 @@ Feature: enter the GitLab API token
       | main development branch     | enter             |                                             |
       | perennial branches          |                   | no input here since the dialog doesn't show |
       | perennial regex             | enter             |                                             |
-      | hosting platform            | up enter          |                                             |
+      | contribution regex          | enter             |                                             |
+      | observed regex              | enter             |                                             |
+      | hosting platform            | up up enter       |                                             |
       | gitlab token                | 1 2 3 4 5 6 enter |                                             |
       | origin hostname             | enter             |                                             |
       | sync-feature-strategy       | enter             |                                             |
@@ -44,6 +49,7 @@ Feature: enter the GitLab API token
       | push-hook                   | enter             |                                             |
       | ship-delete-tracking-branch | enter             |                                             |
       | sync-before-ship            | enter             |                                             |
+      | ship-strategy               | enter             |                                             |
       | save config to Git metadata | down enter        |                                             |
     Then it runs the commands
       | COMMAND                                     |
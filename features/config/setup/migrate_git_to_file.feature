@@ -4,6 +4,7 @@ Feature: migrate existing configuration in Git metadata to a config file
     Given a perennial branch "qa"
     And the main branch is "main"
     And local Git Town setting "perennial-regex" is "release-.*"
+    And local Git Town setting "observed-regex" is "dependabot/.*"
     And local Git Town setting "push-new-branches" is "false"
     And local Git Town setting "push-hook" is "true"
     And local Git Town setting "sync-before-ship" is "false"
@@ -18,6 +19,8 @@ Feature: migrate existing configuration in Git metadata to a config file
       | accept the already configured main branch | enter |
       | perennial branches                        | enter |
       | perennial regex                           | enter |
+      | contribution regex                        | enter |
+      | observed regex                            | enter |
       | hosting service                           | enter |
       | origin hostname                           | enter |
       | sync-feature-strategy                     | enter |
@@ -27,6 +30,7 @@ Feature: migrate existing configuration in Git metadata to a config file
       | disable the push hook                     | enter |
       | disable ship-delete-tracking-branch       | enter |
       | sync-before-ship                          | enter |
+      | ship-strategy                             | enter |
       | save config to config file                | enter |
 
   Scenario: result
@@ -39,6 +43,7 @@ Feature: migrate existing configuration in Git metadata to a config file
     And local Git Town setting "sync-perennial-strategy" now doesn't exist
     And local Git Town setting "sync-upstream" now doesn't exist
     And local Git Town setting "perennial-regex" now doesn't exist
+    And local Git Town setting "observed-regex" now doesn't exist
     And local Git Town setting "push-new-branches" now doesn't exist
     And local Git Town setting "push-hook" now doesn't exist
     And local Git Town setting "ship-delete-tracking-branch" now doesn't exist
@@ -77,6 +82,24 @@ Feature: migrate existing configuration in Git metadata to a config file
       # merging pull requests through its UI.
       ship-delete-tracking-branch = false
 
+      # How should "git ship" merge branches into their parent?
+      #
+      # squash-merge: combine all commits on the shipped branch
+      # into a single commit on the parent branch.
+      # If a proposal exists, ship via the code hosting API.
+      #
+      # merge: merge the shipped branch into its parent
+      # using a merge commit.
+      # If a proposal exists, ship via the code hosting API.
+      #
+      # fast-forward: fast-forward the parent branch
+      # to the shipped branch. This keeps the existing commits
+      # and does not create new ones.
+      #
+      # api: squash-merge the proposal via the code hosting API only.
+      # Shipping fails if there is no proposal.
+      ship-strategy = "squash-merge"
+
       # Should "git ship" sync branches before shipping them?
       #
       # Guidance: enable when shipping branches locally on your machine
@@ -121,6 +144,22 @@ Feature: migrate existing configuration in Git metadata to a config file
       # If you are not sure, leave this empty.
       perennial-regex = "release-.*"
 
+      # All branches whose names match this regular expression
+      # are considered contribution branches.
+      # Git Town pushes your commits to these branches
+      # but does not propose or ship them.
+      #
+      # If you are not sure, leave this empty.
+      contribution-regex = ""
+
+      # All branches whose names match this regular expression
+      # are considered observed branches.
+      # Git Town pulls updates into these branches
+      # but never pushes to them.
+      #
+      # If you are not sure, leave this empty.
+      observed-regex = "dependabot/.*"
+
       [hosting]
 
       # Knowing the type of code hosting platform allows Git Town
@@ -154,6 +193,7 @@ Feature: migrate existing configuration in Git metadata to a config file
     When I run "git-town undo"
     Then the main branch is now "main"
     And local Git Town setting "perennial-regex" is now "release-.*"
+    And local Git Town setting "observed-regex" is now "dependabot/.*"
     And local Git Town setting "push-new-branches" is now "false"
     And local Git Town setting "push-hook" is now "true"
     And local Git Town setting "sync-before-ship" is now "false"
@@ -17,6 +17,8 @@ Feature: remove existing configuration in Git metadata
     And global Git setting "alias.sync" is "town sync"
     And local Git Town setting "hosting-platform" is "github"
     And local Git Town setting "perennial-regex" is "qa.*"
+    And local Git Town setting "contribution-regex" is "co.*"
+    And local Git Town setting "observed-regex" is "ob.*"
     And local Git Town setting "push-new-branches" is "false"
     And local Git Town setting "push-hook" is "false"
     And local Git Town setting "hosting-origin-hostname" is "code"
@@ -34,7 +36,9 @@ Feature: remove existing configuration in Git metadata
       | keep the already configured main branch | enter                                         |
       | change the perennial branches           | space down space enter                        |
       | remove the perennial regex              | backspace backspace backspace backspace enter |
-      | remove hosting service override         | up up up enter                                |
+      | remove the contribution regex           | backspace backspace backspace backspace enter |
+      | remove the observed regex               | backspace backspace backspace backspace enter |
+      | remove hosting service override         | up up up up up enter                          |
       | remove origin hostname                  | backspace backspace backspace backspace enter |
       | sync-feature-strategy                   | down enter                                    |
       | sync-perennial-strategy                 | down enter                                    |
@@ -43,6 +47,7 @@ Feature: remove existing configuration in Git metadata
       | disable the push hook                   | down enter                                    |
       | disable ship-delete-tracking-branch     | down enter                                    |
       | sync-before-ship                        | down enter                                    |
+      | ship-strategy                           | enter                                         |
       | save config to Git metadata             | down enter                                    |
 
   Scenario: result
@@ -81,6 +86,8 @@ Feature: remove existing configuration in Git metadata
     And local Git Town setting "sync-perennial-strategy" is now "merge"
     And local Git Town setting "sync-upstream" is now "false"
     And local Git Town setting "perennial-regex" is now ""
+    And local Git Town setting "contribution-regex" now doesn't exist
+    And local Git Town setting "observed-regex" now doesn't exist
     And local Git Town setting "push-new-branches" is now "false"
     And local Git Town setting "push-hook" is now "false"
     And local Git Town setting "ship-delete-tracking-branch" is now "true"
@@ -103,6 +110,8 @@ Feature: remove existing configuration in Git metadata
     And global Git setting "alias.sync" is now "town sync"
     And local Git Town setting "hosting-platform" is now "github"
     And local Git Town setting "perennial-regex" is now "qa.*"
+    And local Git Town setting "contribution-regex" is now "co.*"
+    And local Git Town setting "observed-regex" is now "ob.*"
     And local Git Town setting "push-new-branches" is now "true"
     And local Git Town setting "push-hook" is now "true"
     And local Git Town setting "hosting-origin-hostname" is now "code"
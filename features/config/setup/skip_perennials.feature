@@ -9,6 +9,8 @@ Feature: don't ask for perennial branches if no branches that could be perennial
       | main development branch     | down enter |                                             |
       | perennial branches          |            | no input here since the dialog doesn't show |
       | perennial regex             | enter      |                                             |
+      | contribution regex          | enter      |                                             |
+      | observed regex              | enter      |                                             |
       | hosting platform            | enter      |                                             |
       | origin hostname             | enter      |                                             |
       | sync-feature-strategy       | enter      |                                             |
@@ -18,6 +20,7 @@ Feature: don't ask for perennial branches if no branches that could be perennial
       | push-hook                   | enter      |                                             |
       | ship-delete-tracking-branch | enter      |                                             |
       | sync-before-ship            | enter      |                                             |
+      | ship-strategy               | enter      |                                             |
       | save config to Git metadata | down enter |                                             |
 
   Scenario: result
@@ -16,14 +16,20 @@ Feature: show the configuration
         perennial branches: qa, staging
         perennial regex: release-.*
         parked branches: parked-1, parked-2
+        prototype branches: (none)
         contribution branches: contribution-1, contribution-2
+        contribution regex: (not set)
         observed branches: observed-1, observed-2
+        observed regex: (not set)
+        branch type rules: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
@@ -31,6 +37,13 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        external hosting command: (not set)
+        proposal remote: origin
+        proposals show lineage: no
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Forgejo token: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
@@ -41,16 +54,24 @@ Feature: show the configuration
       """
       push-new-branches = true
       ship-delete-tracking-branch = true
+      ship-strategy = "fast-forward"
       sync-upstream = true
 
       [branches]
       main = "main"
       perennials = [ "public", "staging" ]
       perennial-regex = "release-.*"
+      prototypes = [ "spike" ]
+      observed-regex = "dependabot/.*"
+      type-rules = [
+        { regex = "renovate/.*", type = "observed" },
+        { regex = "release/.*", type = "perennial" },
+      ]
 
       [hosting]
       platform = "github"
       origin-hostname = "github.com"
+      proposals-show-lineage = true
 
       [sync-strategy]
       feature-branches = "rebase"
@@ -64,14 +85,20 @@ Feature: show the configuration
         perennial branches: public, staging
         perennial regex: release-.*
         parked branches: (none)
+        prototype branches: spike
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: dependabot/.*
+        branch type rules: renovate/.*=observed, release/.*=perennial
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: yes
+        push remote: origin
         ship deletes the tracking branch: yes
+        ship strategy: fast-forward
         sync-feature strategy: rebase
         sync-perennial strategy: merge
         sync with upstream: yes
@@ -79,6 +106,13 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: github
+        external hosting command: (not set)
+        proposal remote: origin
+        proposals show lineage: yes
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Forgejo token: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
@@ -91,21 +125,27 @@ Feature: show the configuration
     And the contribution branches "contribution-1" and "contribution-2"
     And the parked branches "parked-1" and "parked-2"
     And Git Town setting "perennial-regex" is "git-perennial-.*"
+    And Git Town setting "branch-type-rules" is "git/.*=parked"
     And Git Town setting "push-new-branches" is "false"
     And Git Town setting "ship-delete-tracking-branch" is "false"
     And Git Town setting "sync-upstream" is "false"
+    And Git Town setting "ship-strategy" is "merge"
     And Git Town setting "sync-perennial-strategy" is "merge"
     And Git Town setting "sync-feature-strategy" is "merge"
     And the configuration file:
       """
       push-new-branches = true
       ship-delete-tracking-branch = true
+      ship-strategy = "fast-forward"
       sync-upstream = true
 
       [branches]
       main = "config-main"
       perennials = [ "config-perennial-1", "config-perennial-2" ]
       perennial-regex = "config-perennial-.*"
+      type-rules = [
+        { regex = "config/.*", type = "prototype" },
+      ]
 
       [hosting]
       platform = "github"
@@ -123,14 +163,20 @@ Feature: show the configuration
         perennial branches: config-perennial-1, config-perennial-2, git-perennial-1, git-perennial-2
         perennial regex: git-perennial-.*
         parked branches: parked-1, parked-2
+        prototype branches: (none)
         contribution branches: contribution-1, contribution-2
+        contribution regex: (not set)
         observed branches: observed-1, observed-2
+        observed regex: (not set)
+        branch type rules: git/.*=parked, config/.*=prototype
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
         ship deletes the tracking branch: no
+        ship strategy: merge
         sync-feature strategy: merge
         sync-perennial strategy: merge
         sync with upstream: no
@@ -138,6 +184,13 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: github
+        external hosting command: (not set)
+        proposal remote: origin
+        proposals show lineage: no
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Forgejo token: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
@@ -156,14 +209,20 @@ Feature: show the configuration
         perennial branches: qa, staging
         perennial regex: (not set)
         parked branches: (none)
+        prototype branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        branch type rules: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
@@ -171,6 +230,13 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        external hosting command: (not set)
+        proposal remote: origin
+        proposals show lineage: no
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Forgejo token: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
@@ -195,14 +261,20 @@ Feature: show the configuration
         perennial branches: (none)
         perennial regex: (not set)
         parked branches: (none)
+        prototype branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        branch type rules: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
@@ -210,7 +282,29 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        external hosting command: (not set)
+        proposal remote: origin
+        proposals show lineage: no
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Forgejo token: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
       """
+
+  Scenario: hooks
+    Given Git Town setting "hook.pre-sync" is "make generate"
+    And the configuration file:
+      """
+      [hooks]
+      post-ship = "make deploy"
+      """
+    When I run "git-town config"
+    Then it prints:
+      """
+      Hooks:
+        pre-sync: make generate
+        post-ship: make deploy
+      """
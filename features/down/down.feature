@@ -0,0 +1,31 @@
+Feature: switch to the parent branch
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+
+  Scenario: feature branch
+    Given the current branch is "beta"
+    When I run "git-town down"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: child of the main branch
+    Given the current branch is "alpha"
+    When I run "git-town down"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout main |
+    And the current branch is now "main"
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town down"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "main" has no parent branch
+      """
+    And the current branch is still "main"
@@ -0,0 +1,20 @@
+Feature: making a prototype branch a feature branch
+
+  Background:
+    Given a prototype branch "prototype"
+    When I run "git-town hack prototype"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints:
+      """
+      branch "prototype" is now a feature branch
+      """
+    And branch "prototype" is now a feature branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And branch "prototype" is still a prototype branch
@@ -0,0 +1,37 @@
+Feature: push new branches to a separate push remote
+
+  Background:
+    Given an upstream repo
+    And Git Town setting "push-new-branches" is "true"
+    And Git Town setting "push-remote" is "upstream"
+    And Git Town setting "sync-upstream" is "false"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | main   | origin   | origin commit |
+    And the current branch is "main"
+    When I run "git-town hack new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                    |
+      | main   | git fetch --prune --tags   |
+      |        | git fetch --prune upstream |
+      |        | git rebase origin/main     |
+      |        | git checkout -b new        |
+      | new    | git push -u upstream new   |
+    And the current branch is now "new"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | new    | git push upstream :new                      |
+      |        | git checkout main                           |
+      | main   | git reset --hard {{ sha 'initial commit' }} |
+      |        | git branch -D new                           |
+    And the current branch is now "main"
+    And the initial commits exist
+    And the initial branches and lineage exist
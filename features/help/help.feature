@@ -12,20 +12,30 @@ Feature: help for commands
     Examples:
       | COMMAND       |
       | append        |
+      | bottom        |
+      | branch        |
       | completions   |
       | config        |
       | diff-parent   |
+      | down          |
       | hack          |
       | help          |
+      | history       |
       | kill          |
+      | merge         |
       | offline       |
       | prepend       |
+      | proposals     |
       | propose       |
+      | push-pending  |
       | rename-branch |
       | repo          |
       | set-parent    |
       | ship          |
       | sync          |
+      | top           |
+      | up            |
+      | walk          |
 
   Scenario Outline: outside a Git repository
     Given I am outside a Git repo
@@ -0,0 +1,22 @@
+Feature: display the undo history
+
+  Scenario: commands ran
+    Given the current branch is a feature branch "alpha"
+    And I run "git-town hack beta"
+    And I run "git-town park"
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1. park \(.+\)
+         added local setting "git-town.parked-branches"
+      2. hack \(.+\)
+         created branch "beta"
+         added local setting "git-town-branch.beta.parent"
+      """
+
+  Scenario: no commands ran
+    When I run "git-town history"
+    Then it prints:
+      """
+      nothing to undo
+      """
@@ -0,0 +1,36 @@
+Feature: a failing hook stops the command in a continuable state
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And Git Town setting "hook.pre-sync" is "echo broken hook && exit 1"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | sh -c "echo broken hook && exit 1" |
+    And it prints the error:
+      """
+      the pre-sync hook failed: exit status 1
+      """
+    And the current branch is still "feature"
+
+  Scenario: continue after fixing the hook
+    Given Git Town setting "hook.pre-sync" is "echo fixed hook"
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      |         | sh -c "echo fixed hook"                 |
+      | feature | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+    And the current branch is still "feature"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
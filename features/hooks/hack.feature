@@ -0,0 +1,31 @@
+Feature: run hooks when creating a new branch
+
+  Background:
+    Given the current branch is "main"
+    And Git Town setting "hook.post-hack" is "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"
+    When I run "git-town hack new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                         |
+      | main   | git fetch --prune --tags                                                        |
+      |        | git rebase origin/main                                                          |
+      |        | git checkout -b new                                                             |
+      | <none> | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT" |
+    And it prints:
+      """
+      post-hack hack new main
+      """
+    And the current branch is now "new"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | new    | git checkout main |
+      | main   | git branch -D new |
+    And the current branch is now "main"
+    And no lineage exists now
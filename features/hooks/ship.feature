@@ -0,0 +1,35 @@
+Feature: run hooks when shipping
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "hook.pre-ship" is "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"
+    And Git Town setting "hook.post-ship" is "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                         |
+      | feature | git fetch --prune --tags                                                        |
+      | <none>  | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT" |
+      | feature | git checkout main                                                               |
+      | main    | git merge --squash --ff feature                                                 |
+      |         | git commit -m done                                                              |
+      |         | git push                                                                        |
+      |         | git push origin :feature                                                        |
+      |         | git branch -D feature                                                           |
+      | <none>  | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"                   |
+    And it prints:
+      """
+      pre-ship ship feature main
+      """
+    And it prints:
+      """
+      post-ship feature main
+      """
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | main   | local, origin | done    |
@@ -0,0 +1,45 @@
+Feature: run hooks when syncing
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And Git Town setting "hook.pre-sync" is "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"
+    And Git Town setting "hook.post-sync-branch" is "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                         |
+      | beta   | git fetch --prune --tags                                                        |
+      | <none> | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_COMMAND $GIT_TOWN_BRANCH $GIT_TOWN_PARENT" |
+      | beta   | git checkout main                                                               |
+      | main   | git rebase origin/main                                                          |
+      | <none> | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"                   |
+      | main   | git checkout alpha                                                              |
+      | alpha  | git merge --no-edit --ff origin/alpha                                           |
+      |        | git merge --no-edit --ff main                                                   |
+      | <none> | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"                   |
+      | alpha  | git checkout beta                                                               |
+      | beta   | git merge --no-edit --ff origin/beta                                            |
+      |        | git merge --no-edit --ff alpha                                                  |
+      | <none> | sh -c "echo $GIT_TOWN_HOOK $GIT_TOWN_BRANCH $GIT_TOWN_PARENT"                   |
+    And it prints:
+      """
+      pre-sync sync beta alpha
+      """
+    And it prints:
+      """
+      post-sync-branch alpha main
+      """
+    And it prints:
+      """
+      post-sync-branch beta alpha
+      """
+    And the current branch is still "beta"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
@@ -18,7 +18,7 @@ Feature: delete a branch that is active in another worktree
       | good   | git fetch --prune --tags |
     And it prints the error:
       """
-      branch "dead" is active in another worktree
+      branch "dead" is active in worktree "../development_worktree"
       """
     And the uncommitted file still exists
 
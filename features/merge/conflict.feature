@@ -0,0 +1,58 @@
+Feature: conflict while merging a branch into its parent
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE            | FILE NAME        | FILE CONTENT  |
+      | alpha  | local, origin | conflicting commit | conflicting_file | alpha content |
+      | beta   | local, origin | beta commit        | conflicting_file | beta content  |
+    And the current branch is "beta"
+    When I run "git-town merge"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | beta   | git fetch --prune --tags      |
+      |        | git checkout alpha            |
+      | alpha  | git merge --no-edit --ff beta |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And the current branch is now "alpha"
+    And a merge is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git merge --abort |
+      |        | git checkout beta |
+    And the current branch is now "beta"
+    And no merge is in progress
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND               |
+      | alpha  | git commit --no-edit  |
+      |        | git push              |
+      |        | git push origin :beta |
+      |        | git branch -D beta    |
+    And the current branch is now "alpha"
+    And no merge is in progress
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, alpha |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
@@ -0,0 +1,60 @@
+Feature: does not merge branches that cannot be merged
+
+  Scenario: parent is the main branch
+    Given a feature branch "alpha"
+    And the current branch is "alpha"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot merge into branch "main" because it is a main branch, please use "git town ship" to ship into it
+      """
+    And the current branch is still "alpha"
+
+  Scenario: current branch is a contribution branch
+    Given a feature branch "alpha"
+    And a contribution branch "beta"
+    And the current branch is "beta"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot merge branch "beta" into its parent because it is a contribution branch
+      """
+    And the current branch is still "beta"
+
+  Scenario: branch is not in sync
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | beta   | origin   | beta commit |
+    And the current branch is "beta"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "beta" is not in sync with its tracking branch, please run "git town sync" first
+      """
+    And the current branch is still "beta"
+    And the initial commits exist
+
+  Scenario: uncommitted changes
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And an uncommitted file
+    When I run "git-town merge"
+    Then it runs no commands
+    And it prints the error:
+      """
+      you have uncommitted changes
+      """
+    And the current branch is still "beta"
+    And the uncommitted file still exists
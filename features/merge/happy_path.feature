@@ -0,0 +1,64 @@
+Feature: merge a branch into its parent
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | alpha  | local, origin | alpha commit | alpha_file |
+      | beta   | local, origin | beta commit  | beta_file  |
+      | gamma  | local, origin | gamma commit | gamma_file |
+    And the current branch is "beta"
+    And the origin is "git@review.example.com:git-town/git-town.git"
+    And Git Town setting "hosting-platform" is "external"
+    And the external hosting command responds to these requests:
+      | OPERATION     | BRANCH | RESPONSE                                                         |
+      | find-proposal | beta   | {"proposal": {"number": 1, "branch": "beta", "target": "alpha"}} |
+      | find-proposal | gamma  | {"proposal": {"number": 2, "branch": "gamma", "target": "beta"}} |
+    When I run "git-town merge"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                           |
+      | beta   | git fetch --prune --tags                                                          |
+      |        | git checkout alpha                                                                |
+      | alpha  | git merge --no-edit --ff beta                                                     |
+      |        | git push                                                                          |
+      | <none> | External hosting command: updating target branch of proposal #2 to "alpha" ... ok |
+      |        | External hosting command: closing proposal #1 ... ok                              |
+      | alpha  | git push origin :beta                                                             |
+      |        | git branch -D beta                                                                |
+    And it prints:
+      """
+      branch "gamma" is now a child of "alpha"
+      """
+    And the current branch is now "alpha"
+    And the branches are now
+      | REPOSITORY    | BRANCHES           |
+      | local, origin | main, alpha, gamma |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | beta commit                    |
+      |        |               | Merge branch 'beta' into alpha |
+      | gamma  | local, origin | gamma commit                   |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | gamma  | alpha  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                          |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}                                        |
+      |        | git push --force-with-lease --force-if-includes                                  |
+      |        | git branch beta {{ sha 'beta commit' }}                                          |
+      |        | git push -u origin beta                                                          |
+      |        | git checkout beta                                                                |
+      | <none> | External hosting command: updating target branch of proposal #2 to "beta" ... ok |
+      |        | External hosting command: reopening proposal #1 ... ok                           |
+    And the current branch is now "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,9 @@
+Feature: offline mode
+
+  Scenario: try to list proposals in offline mode
+    Given offline mode is enabled
+    When I run "git-town proposals"
+    Then it prints the error:
+      """
+      this command requires an active internet connection
+      """
@@ -0,0 +1,14 @@
+Feature: unsupported hosting platform
+
+  Scenario:
+    When I run "git-town proposals"
+    Then it prints the error:
+      """
+      unsupported hosting platform
+
+      This command requires hosting on one of these services:
+      * Bitbucket
+      * GitHub
+      * GitLab
+      * Gitea
+      """
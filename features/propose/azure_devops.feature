@@ -0,0 +1,47 @@
+@skipWindows
+Feature: Azure DevOps support
+
+  Background:
+    Given tool "open" is installed
+
+  Scenario Outline: normal origin
+    Given the current branch is a feature branch "feature"
+    And the origin is "<ORIGIN>"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://dev.azure.com/git-town/git-town/_git/docs/pullrequestcreate?sourceRef=feature&targetRef=main
+      """
+
+    Examples:
+      | ORIGIN                                                     |
+      | https://dev.azure.com/git-town/git-town/_git/docs          |
+      | https://git-town@dev.azure.com/git-town/git-town/_git/docs |
+      | git@ssh.dev.azure.com:v3/git-town/git-town/docs            |
+      | ssh://git@ssh.dev.azure.com/v3/git-town/git-town/docs      |
+
+  Scenario Outline: proper URL encoding
+    Given the current branch is a feature branch "<BRANCH_NAME>"
+    And the origin is "https://dev.azure.com/git-town/git-town/_git/docs"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      <URL>
+      """
+
+    Examples:
+      | BRANCH_NAME    | URL                                                                                                         |
+      | feature-branch | https://dev.azure.com/git-town/git-town/_git/docs/pullrequestcreate?sourceRef=feature-branch&targetRef=main |
+      | fix-#2         | https://dev.azure.com/git-town/git-town/_git/docs/pullrequestcreate?sourceRef=fix-%232&targetRef=main       |
+      | test/feature   | https://dev.azure.com/git-town/git-town/_git/docs/pullrequestcreate?sourceRef=test%2Ffeature&targetRef=main |
+
+  Scenario: stacked change with known parent
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the origin is "git@ssh.dev.azure.com:v3/git-town/git-town/docs"
+    And the current branch is "child"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://dev.azure.com/git-town/git-town/_git/docs/pullrequestcreate?sourceRef=child&targetRef=parent
+      """
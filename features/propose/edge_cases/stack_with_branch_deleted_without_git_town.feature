@@ -0,0 +1,28 @@
+@skipWindows
+Feature: propose a stack whose lineage contains a branch that no longer exists
+
+  Background:
+    Given tool "open" is installed
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And Git Town parent setting for branch "deleted" is "child"
+    And the origin is "git@gitlab.com:kadu/kadu.git"
+    And the current branch is "parent"
+    When I run "git-town propose --stack --dry-run"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                                               |
+      | parent | git fetch --prune --tags                                                                                                              |
+      |        | git checkout main                                                                                                                     |
+      | main   | git rebase origin/main                                                                                                                |
+      |        | git checkout parent                                                                                                                   |
+      | parent | git merge --no-edit --ff origin/parent                                                                                                |
+      |        | git merge --no-edit --ff main                                                                                                         |
+      |        | git checkout child                                                                                                                    |
+      | child  | git merge --no-edit --ff origin/child                                                                                                 |
+      |        | git merge --no-edit --ff parent                                                                                                       |
+      |        | git checkout parent                                                                                                                   |
+      | <none> | open https://gitlab.com/kadu/kadu/-/merge_requests/new?merge_request%5Bsource_branch%5D=parent&merge_request%5Btarget_branch%5D=main  |
+      |        | open https://gitlab.com/kadu/kadu/-/merge_requests/new?merge_request%5Bsource_branch%5D=child&merge_request%5Btarget_branch%5D=parent |
+    And the current branch is still "parent"
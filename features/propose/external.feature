@@ -0,0 +1,48 @@
+@skipWindows
+Feature: external hosting command
+
+  Background:
+    Given tool "open" is installed
+    And the current branch is a feature branch "feature"
+    And the origin is "git@review.example.com:git-town/git-town.git"
+    And Git Town setting "hosting-platform" is "external"
+
+  Scenario: the external hosting command provides the proposal URL
+    Given the external hosting command responds with:
+      """
+      {"url": "https://review.example.com/git-town/git-town/new?source=feature"}
+      """
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://review.example.com/git-town/git-town/new?source=feature
+      """
+
+  Scenario: the external hosting command reports an error
+    Given the external hosting command responds with:
+      """
+      {"error": "access denied"}
+      """
+    When I run "git-town propose"
+    Then it prints the error:
+      """
+      the external hosting command cannot new-proposal-url: access denied
+      """
+
+  Scenario: no external hosting command configured
+    When I run "git-town propose"
+    Then it prints the error:
+      """
+      the "external" hosting platform requires the shell command to run, please configure it via "git config git-town.hosting-external-command <command>"
+      """
+
+  Scenario: undo closes the proposal created via the API
+    Given the external hosting command responds to these requests:
+      | OPERATION       | BRANCH  | RESPONSE                                                                                  |
+      | create-proposal | feature | {"proposal": {"number": 1, "url": "https://review.example.com/git-town/git-town/pull/1"}} |
+    And I ran "git-town propose --no-browser"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      |        | External hosting command: closing proposal #1 ... ok |
+    And the current branch is still "feature"
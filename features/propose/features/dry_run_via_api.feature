@@ -0,0 +1,18 @@
+@skipWindows
+Feature: dry-run creating a proposal via the API
+
+  Scenario: proposing changes with a title
+    Given tool "open" is installed
+    And the current branch is a feature branch "feature"
+    And the origin is "git@github.com:git-town/git-town.git"
+    When I run "git-town propose --title 'my title' --body 'my body' --no-browser --dry-run"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
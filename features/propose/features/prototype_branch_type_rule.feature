@@ -0,0 +1,36 @@
+@skipWindows
+Feature: proposing a branch that a branch type rule makes a prototype makes it a feature branch
+
+  Background:
+    Given Git Town setting "branch-type-rules" is "^spike-=prototype"
+    And the local feature branch "spike-1"
+    And the current branch is "spike-1"
+    And tool "open" is installed
+    And the origin is "git@github.com:git-town/git-town.git"
+    When I run "git-town propose"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                            |
+      | spike-1 | git fetch --prune --tags                                           |
+      |         | git checkout main                                                  |
+      | main    | git rebase origin/main                                             |
+      |         | git checkout spike-1                                               |
+      | spike-1 | git merge --no-edit --ff main                                      |
+      |         | git push -u origin spike-1                                         |
+      | <none>  | open https://github.com/git-town/git-town/compare/spike-1?expand=1 |
+    And "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/spike-1?expand=1
+      """
+    And branch "spike-1" is now a feature branch
+    And local Git Town setting "feature-branches" is now "spike-1"
+    And the current branch is still "spike-1"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | spike-1 | git push origin :spike-1 |
+    And the current branch is still "spike-1"
+    And branch "spike-1" is still a prototype branch
@@ -0,0 +1,52 @@
+@skipWindows
+Feature: propose all branches in a stack
+
+  Background:
+    Given tool "open" is installed
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the origin is "git@gitlab.com:kadu/kadu.git"
+    And the current branch is "parent"
+
+  Scenario: dry-run
+    When I run "git-town propose --stack --dry-run"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                                               |
+      | parent | git fetch --prune --tags                                                                                                              |
+      |        | git checkout main                                                                                                                     |
+      | main   | git rebase origin/main                                                                                                                |
+      |        | git checkout parent                                                                                                                   |
+      | parent | git merge --no-edit --ff origin/parent                                                                                                |
+      |        | git merge --no-edit --ff main                                                                                                         |
+      |        | git checkout child                                                                                                                    |
+      | child  | git merge --no-edit --ff origin/child                                                                                                 |
+      |        | git merge --no-edit --ff parent                                                                                                       |
+      |        | git checkout parent                                                                                                                   |
+      | <none> | open https://gitlab.com/kadu/kadu/-/merge_requests/new?merge_request%5Bsource_branch%5D=parent&merge_request%5Btarget_branch%5D=main  |
+      |        | open https://gitlab.com/kadu/kadu/-/merge_requests/new?merge_request%5Bsource_branch%5D=child&merge_request%5Btarget_branch%5D=parent |
+    And the current branch is still "parent"
+    And the initial branches and lineage exist
+
+  Scenario: with a title
+    When I run "git-town propose --stack --title 'my title'"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --title and --body flags cannot be used together with --stack
+      """
+    And the current branch is still "parent"
+
+  Scenario: undo closes the proposals created via the API
+    Given Git Town setting "hosting-platform" is "external"
+    And the external hosting command responds to these requests:
+      | OPERATION       | BRANCH | RESPONSE                                                                              |
+      | create-proposal | parent | {"proposal": {"number": 1, "url": "https://gitlab.com/kadu/kadu/-/merge_requests/1"}} |
+      | create-proposal | child  | {"proposal": {"number": 2, "url": "https://gitlab.com/kadu/kadu/-/merge_requests/2"}} |
+    And I ran "git-town propose --stack"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      |        | External hosting command: closing proposal #1 ... ok |
+      |        | External hosting command: closing proposal #2 ... ok |
+    And the current branch is still "parent"
+    And the initial branches and lineage exist
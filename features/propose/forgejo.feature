@@ -0,0 +1,42 @@
+@skipWindows
+Feature: Forgejo support
+
+  Background:
+    Given tool "open" is installed
+
+  Scenario Outline: Codeberg origin
+    Given the current branch is a feature branch "feature"
+    And the origin is "<ORIGIN>"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://codeberg.org/git-town/git-town/compare/main...feature
+      """
+
+    Examples:
+      | ORIGIN                                       |
+      | https://codeberg.org/git-town/git-town.git   |
+      | https://codeberg.org/git-town/git-town       |
+      | git@codeberg.org:git-town/git-town.git       |
+      | ssh://git@codeberg.org/git-town/git-town.git |
+
+  Scenario: self-hosted Forgejo instance
+    Given the current branch is a feature branch "feature"
+    And the origin is "git@forgejo.example.com:git-town/git-town.git"
+    And Git Town setting "hosting-platform" is "forgejo"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://forgejo.example.com/git-town/git-town/compare/main...feature
+      """
+
+  Scenario: stacked change with known parent
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the origin is "git@codeberg.org:git-town/git-town.git"
+    And the current branch is "child"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://codeberg.org/git-town/git-town/compare/parent...child
+      """
@@ -0,0 +1,37 @@
+Feature: drop the pending pushes of branches that no longer exist
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a local feature branch "beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+      | beta   | local    | beta commit  |
+    And the origin is unreachable
+    And I run "git-town sync"
+    And I run "git checkout beta"
+    And I run "git-town sync"
+    And I run "git checkout alpha"
+    And I run "git branch -D beta"
+    And the origin is reachable again
+    When I run "git-town push-pending"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+      |        | git push                 |
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    When I run "git-town status"
+    Then it does not print "Pending pushes"
+
+  Scenario: no pending pushes left
+    When I run "git-town push-pending"
+    Then it runs no commands
+    And it prints:
+      """
+      there are no pending pushes
+      """
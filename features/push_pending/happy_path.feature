@@ -0,0 +1,39 @@
+Feature: push the branches that an offline sync couldn't push
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a local feature branch "beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+      | beta   | local    | beta commit  |
+    And the origin is unreachable
+    And I run "git-town sync"
+    And I run "git checkout beta"
+    And I run "git-town sync"
+    And the origin is reachable again
+    When I run "git-town push-pending"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+      |        | git checkout alpha       |
+      | alpha  | git push                 |
+      |        | git checkout beta        |
+      | beta   | git push -u origin beta  |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    When I run "git-town status"
+    Then it does not print "Pending pushes"
+
+  Scenario: no pending pushes left
+    When I run "git-town push-pending"
+    Then it runs no commands
+    And it prints:
+      """
+      there are no pending pushes
+      """
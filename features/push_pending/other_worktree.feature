@@ -0,0 +1,34 @@
+Feature: keep the pending pushes of branches that are active in another worktree
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a local feature branch "beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+      | beta   | local    | beta commit  |
+    And the origin is unreachable
+    And I run "git-town sync"
+    And I run "git checkout beta"
+    And I run "git-town sync"
+    And I run "git checkout alpha"
+    And branch "beta" is active in another worktree
+    And the origin is reachable again
+    When I run "git-town push-pending"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+      |        | git push                 |
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | worktree      | beta commit  |
+    When I run "git-town status"
+    Then it prints:
+      """
+      Pending pushes (run "git town push-pending" to push them):
+        - beta
+      """
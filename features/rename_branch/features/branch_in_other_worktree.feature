@@ -0,0 +1,31 @@
+Feature: rename a branch that is active in another worktree
+
+  Background:
+    Given the feature branches "old" and "other"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | old    | local, origin | old commit |
+    And branch "old" is active in another worktree
+    And the current branch is "other"
+    When I run "git-town rename-branch old new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | other  | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "old" is active in worktree "../development_worktree", please rename it there
+      """
+    And the current branch is still "other"
+    And the current branch in the other worktree is still "old"
+    And the initial branches and lineage exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to undo
+      """
+    And the initial branches and lineage exist
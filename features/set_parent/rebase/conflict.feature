@@ -0,0 +1,74 @@
+Feature: conflict while moving the commits of a feature branch onto its new parent
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   |
+      | parent | local, origin | parent commit | parent_file |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE                 | FILE NAME        | FILE CONTENT    |
+      | main   | local, origin | conflicting main commit | conflicting_file | main content    |
+      | child  | local, origin | conflicting commit      | conflicting_file | feature content |
+    And the current branch is "child"
+    When I run "git-town set-parent --rebase" and enter into the dialog:
+      | DIALOG                 | KEYS     |
+      | parent branch of child | up enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | child  | git rebase --onto main parent |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git rebase --abort |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                 |
+      | main   | local, origin | conflicting main commit |
+      | child  | local, origin | parent commit           |
+      |        |               | conflicting commit      |
+      | parent | local, origin | parent commit           |
+    And the initial branches and lineage exist
+
+  Scenario: continue with unresolved conflict
+    When I run "git-town continue"
+    Then it prints the error:
+      """
+      you must resolve the conflicts before continuing
+      """
+    And a rebase is now in progress
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                 |
+      | main   | local, origin | conflicting main commit |
+      | child  | local, origin | conflicting main commit |
+      |        |               | conflicting commit      |
+      | parent | local, origin | parent commit           |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | main   |
@@ -0,0 +1,44 @@
+Feature: move the commits of a feature branch onto its new parent
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   |
+      | parent | local, origin | parent commit | parent_file |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | child  | local, origin | child commit | child_file |
+    And the current branch is "child"
+    When I run "git-town set-parent --rebase" and enter into the dialog:
+      | DIALOG                 | KEYS     |
+      | parent branch of child | up enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git rebase --onto main parent                   |
+      |        | git push --force-with-lease --force-if-includes |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | child commit  |
+      | parent | local, origin | parent commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }} |
+      |        | git push --force-with-lease --force-if-includes      |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | parent commit |
+      |        |               | child commit  |
+      | parent | local, origin | parent commit |
+    And the initial branches and lineage exist
@@ -0,0 +1,18 @@
+Feature: settings that refer to a remote that doesn't exist
+
+  Scenario Outline:
+    Given the current branch is a feature branch "feature"
+    And Git Town setting "<SETTING>" is "fork"
+    When I run "git-town <COMMAND>"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the "git-town.<SETTING>" setting refers to the remote "fork", which doesn't exist in this repository, please add this remote via "git remote add" or change the setting
+      """
+
+    Examples:
+      | SETTING         | COMMAND  |
+      | push-remote     | sync     |
+      | push-remote     | hack new |
+      | proposal-remote | ship     |
+      | proposal-remote | propose  |
@@ -0,0 +1,33 @@
+Feature: ship into a branch that is active in another worktree
+
+  Background:
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | child  | local, origin | child commit |
+    And branch "parent" is active in another worktree
+    And the current branch is "child"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | child  | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot ship into branch "parent" because it is active in worktree "../development_worktree"
+      """
+    And the current branch is still "child"
+    And the current branch in the other worktree is still "parent"
+    And the initial commits exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to undo
+      """
+    And the current branch is still "child"
+    And the initial commits exist
@@ -0,0 +1,18 @@
+Feature: the API ship strategy requires a connector to the code hosting platform
+
+  Scenario: no connector
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "api"
+    When I run "git-town ship"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      the "api" ship strategy requires a connector to the API of your code hosting platform
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
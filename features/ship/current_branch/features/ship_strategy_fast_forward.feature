@@ -0,0 +1,56 @@
+Feature: ship the current feature branch by fast-forwarding the main branch
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    |
+      | feature | local, origin | feature commit | feature_file |
+    And Git Town setting "ship-strategy" is "fast-forward"
+
+  Scenario: result
+    When I run "git-town ship"
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git fetch --prune --tags    |
+      |         | git checkout main           |
+      | main    | git merge --ff-only feature |
+      |         | git push                    |
+      |         | git push origin :feature    |
+      |         | git branch -D feature       |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE        |
+      | main   | local, origin | feature commit |
+    And no lineage exists now
+
+  Scenario: main branch has diverged
+    Given the commits
+      | BRANCH | LOCATION | MESSAGE     | FILE NAME |
+      | main   | local    | main commit | main_file |
+    When I run "git-town ship"
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git fetch --prune --tags    |
+      |         | git checkout main           |
+      | main    | git merge --ff-only feature |
+      |         | git checkout feature        |
+    And it prints the error:
+      """
+      cannot fast-forward branch "feature" into "main", please rebase "feature" onto "main" first
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+
+  Scenario: commit message given
+    When I run "git-town ship -m 'feature done'"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      the fast-forward ship strategy does not create a commit and therefore cannot use a commit message
+      """
+    And the current branch is still "feature"
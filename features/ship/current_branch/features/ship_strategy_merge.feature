@@ -0,0 +1,43 @@
+Feature: ship the current feature branch using a merge commit
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "merge"
+    When I run "git-town ship -m 'feature done'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                     |
+      | feature | git fetch --prune --tags                    |
+      |         | git checkout main                           |
+      | main    | git merge --no-ff -m "feature done" feature |
+      |         | git push                                    |
+      |         | git push origin :feature                    |
+      |         | git branch -D feature                       |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE        |
+      | main   | local, origin | feature commit |
+      |        |               | feature done   |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | local, origin | feature commit |
+      |         |               | feature done   |
+      | feature | local, origin | feature commit |
+    And the initial lineage exists
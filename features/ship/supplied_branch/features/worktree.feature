@@ -16,7 +16,7 @@ Feature: ship a feature branch that is active in another worktree
       | other  | git fetch --prune --tags |
     And it prints the error:
       """
-      branch "feature" is active in another worktree
+      branch "feature" is active in worktree "../development_worktree"
       """
     And the current branch is still "other"
     And the uncommitted file still exists
@@ -0,0 +1,29 @@
+Feature: describe the status of the current/last Git Town command in JSON
+
+  Scenario: Git Town command in progress
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | conflicting_file | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And I run "git-town sync"
+    When I run "git-town status --format=json"
+    Then it prints something like:
+      """
+      "unfinished_command": \{
+          "command": "sync",
+          "end_branch": "feature",
+          "end_time": ".+",
+          "can_continue": true,
+          "can_skip": true,
+          "can_undo": true
+        \}
+      """
+
+  Scenario: no runstate exists
+    Given the current branch is a feature branch "feature"
+    When I run "git-town status --format=json"
+    Then it prints:
+      """
+      "unfinished_command": null
+      """
@@ -17,34 +17,48 @@ Feature: sync a branch whose parent is active in another worktree
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                                |
-      | child  | git fetch --prune --tags               |
-      |        | git checkout main                      |
-      | main   | git rebase origin/main                 |
-      |        | git push                               |
-      |        | git checkout child                     |
-      | child  | git merge --no-edit --ff origin/child  |
-      |        | git merge --no-edit --ff origin/parent |
-      |        | git push                               |
+      | BRANCH | COMMAND                                                           |
+      | child  | git fetch --prune --tags                                          |
+      |        | git checkout main                                                 |
+      | main   | git rebase origin/main                                            |
+      |        | git push                                                          |
+      |        | git -C ../development_worktree merge --no-edit --ff origin/parent |
+      |        | git -C ../development_worktree merge --no-edit --ff main          |
+      |        | git -C ../development_worktree push                               |
+      |        | git checkout child                                                |
+      | child  | git merge --no-edit --ff origin/child                             |
+      |        | git merge --no-edit --ff parent                                   |
+      |        | git push                                                          |
     And the current branch is still "child"
     And these commits exist now
-      | BRANCH | LOCATION                | MESSAGE                                                 |
-      | main   | local, origin, worktree | origin main commit                                      |
-      |        |                         | local main commit                                       |
-      | child  | local, origin           | local child commit                                      |
-      |        |                         | origin child commit                                     |
-      |        |                         | Merge remote-tracking branch 'origin/child' into child  |
-      |        |                         | origin parent commit                                    |
-      |        |                         | Merge remote-tracking branch 'origin/parent' into child |
-      | parent | origin                  | origin parent commit                                    |
-      |        | worktree                | local parent commit                                     |
+      | BRANCH | LOCATION                | MESSAGE                                                  |
+      | main   | local, origin, worktree | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      | child  | local, origin           | local child commit                                       |
+      |        |                         | origin child commit                                      |
+      |        |                         | Merge remote-tracking branch 'origin/child' into child   |
+      |        |                         | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
+      |        |                         | Merge branch 'parent' into child                         |
+      | parent | origin, worktree        | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
 
   Scenario: undo
     When I run "git-town undo"
     Then it runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha 'local child commit' }}                                    |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                              |
+      | child  | git reset --hard {{ sha 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child   |
+      |        | git -C ../development_worktree reset --hard {{ sha 'local parent commit' }}          |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin parent commit' }}:parent |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
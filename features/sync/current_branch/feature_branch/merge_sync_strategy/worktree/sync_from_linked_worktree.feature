@@ -17,34 +17,48 @@ Feature: sync a branch whose parent is active in another worktree
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                                |
-      | child  | git fetch --prune --tags               |
-      |        | git checkout main                      |
-      | main   | git rebase origin/main                 |
-      |        | git push                               |
-      |        | git checkout child                     |
-      | child  | git merge --no-edit --ff origin/child  |
-      |        | git merge --no-edit --ff origin/parent |
-      |        | git push                               |
+      | BRANCH | COMMAND                                                |
+      | child  | git fetch --prune --tags                               |
+      |        | git checkout main                                      |
+      | main   | git rebase origin/main                                 |
+      |        | git push                                               |
+      |        | git -C ../developer merge --no-edit --ff origin/parent |
+      |        | git -C ../developer merge --no-edit --ff main          |
+      |        | git -C ../developer push                               |
+      |        | git checkout child                                     |
+      | child  | git merge --no-edit --ff origin/child                  |
+      |        | git merge --no-edit --ff parent                        |
+      |        | git push                                               |
     And the current branch is still "parent"
     And these commits exist now
-      | BRANCH | LOCATION                | MESSAGE                                                 |
-      | main   | local, origin, worktree | origin main commit                                      |
-      |        |                         | local main commit                                       |
-      | child  | origin, worktree        | local child commit                                      |
-      |        |                         | origin child commit                                     |
-      |        |                         | Merge remote-tracking branch 'origin/child' into child  |
-      |        |                         | origin parent commit                                    |
-      |        |                         | Merge remote-tracking branch 'origin/parent' into child |
-      | parent | local                   | local parent commit                                     |
-      |        | origin                  | origin parent commit                                    |
+      | BRANCH | LOCATION                | MESSAGE                                                  |
+      | main   | local, origin, worktree | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      | child  | origin, worktree        | local child commit                                       |
+      |        |                         | origin child commit                                      |
+      |        |                         | Merge remote-tracking branch 'origin/child' into child   |
+      |        |                         | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
+      |        |                         | Merge branch 'parent' into child                         |
+      | parent | local, origin           | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
 
   Scenario: undo
     When I run "git-town undo" in the other worktree
     Then it runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha 'local child commit' }}                                    |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                              |
+      | child  | git reset --hard {{ sha 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child   |
+      |        | git -C ../developer reset --hard {{ sha 'local parent commit' }}                     |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin parent commit' }}:parent |
     And the current branch is still "parent"
     And the current branch in the other worktree is still "child"
     And these commits exist now
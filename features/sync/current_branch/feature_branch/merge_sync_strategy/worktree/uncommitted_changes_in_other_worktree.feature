@@ -0,0 +1,50 @@
+Feature: sync a branch whose parent is active in another worktree that has uncommitted changes
+
+  Background:
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE              |
+      | parent | local    | local parent commit  |
+      |        | origin   | origin parent commit |
+      | child  | local    | local child commit   |
+    And branch "parent" is active in another worktree
+    And an uncommitted file in the other worktree
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | child  | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git checkout child                    |
+      | child  | git merge --no-edit --ff origin/child |
+      |        | git merge --no-edit --ff parent       |
+      |        | git push                              |
+    And it prints:
+      """
+      skipped syncing branch "parent" because worktree "../development_worktree" has uncommitted changes
+      """
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                          |
+      | child  | local, origin | local child commit               |
+      |        |               | local parent commit              |
+      |        |               | Merge branch 'parent' into child |
+      | parent | origin        | origin parent commit             |
+      |        | worktree      | local parent commit              |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                       |
+      | child  | git reset --hard {{ sha 'local child commit' }}                               |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'initial commit' }}:child |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE              |
+      | child  | local    | local child commit   |
+      | parent | origin   | origin parent commit |
+      |        | worktree | local parent commit  |
@@ -16,16 +16,20 @@ Feature: sync a branch whose parent is active in another worktree
     And the current branch is "child"
     When I run "git-town sync"
     Then it runs the commands
-      | BRANCH | COMMAND                                         |
-      | child  | git fetch --prune --tags                        |
-      |        | git checkout main                               |
-      | main   | git rebase origin/main                          |
-      |        | git push                                        |
-      |        | git checkout child                              |
-      | child  | git rebase origin/parent                        |
-      |        | git push --force-with-lease --force-if-includes |
-      |        | git rebase origin/child                         |
-      |        | git push --force-with-lease --force-if-includes |
+      | BRANCH | COMMAND                                                                    |
+      | child  | git fetch --prune --tags                                                   |
+      |        | git checkout main                                                          |
+      | main   | git rebase origin/main                                                     |
+      |        | git push                                                                   |
+      |        | git -C ../development_worktree rebase main                                 |
+      |        | git -C ../development_worktree push --force-with-lease --force-if-includes |
+      |        | git -C ../development_worktree rebase origin/parent                        |
+      |        | git -C ../development_worktree push --force-with-lease --force-if-includes |
+      |        | git checkout child                                                         |
+      | child  | git rebase parent                                                          |
+      |        | git push --force-with-lease --force-if-includes                            |
+      |        | git rebase origin/child                                                    |
+      |        | git push --force-with-lease --force-if-includes                            |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
@@ -33,9 +37,14 @@ Feature: sync a branch whose parent is active in another worktree
       |        |                         | local main commit    |
       | child  | local, origin           | origin child commit  |
       |        |                         | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
       |        |                         | local child commit   |
-      | parent | origin                  | origin parent commit |
-      |        | worktree                | local parent commit  |
+      | parent | origin, worktree        | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
 
   Scenario: undo
     When I run "git-town undo"
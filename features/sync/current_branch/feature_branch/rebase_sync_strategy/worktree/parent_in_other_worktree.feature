@@ -18,16 +18,20 @@ Feature: sync a branch whose parent is active in another worktree
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                                         |
-      | child  | git fetch --prune --tags                        |
-      |        | git checkout main                               |
-      | main   | git rebase origin/main                          |
-      |        | git push                                        |
-      |        | git checkout child                              |
-      | child  | git rebase origin/parent                        |
-      |        | git push --force-with-lease --force-if-includes |
-      |        | git rebase origin/child                         |
-      |        | git push --force-with-lease --force-if-includes |
+      | BRANCH | COMMAND                                                                    |
+      | child  | git fetch --prune --tags                                                   |
+      |        | git checkout main                                                          |
+      | main   | git rebase origin/main                                                     |
+      |        | git push                                                                   |
+      |        | git -C ../development_worktree rebase main                                 |
+      |        | git -C ../development_worktree push --force-with-lease --force-if-includes |
+      |        | git -C ../development_worktree rebase origin/parent                        |
+      |        | git -C ../development_worktree push --force-with-lease --force-if-includes |
+      |        | git checkout child                                                         |
+      | child  | git rebase parent                                                          |
+      |        | git push --force-with-lease --force-if-includes                            |
+      |        | git rebase origin/child                                                    |
+      |        | git push --force-with-lease --force-if-includes                            |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
@@ -35,16 +39,23 @@ Feature: sync a branch whose parent is active in another worktree
       |        |                         | local main commit    |
       | child  | local, origin           | origin child commit  |
       |        |                         | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
       |        |                         | local child commit   |
-      | parent | origin                  | origin parent commit |
-      |        | worktree                | local parent commit  |
+      | parent | origin, worktree        | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
 
   Scenario: undo
     When I run "git-town undo"
     Then it runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha-before-run 'local child commit' }}                         |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                                         |
+      | child  | git reset --hard {{ sha-before-run 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child              |
+      |        | git -C ../development_worktree reset --hard {{ sha-before-run 'local parent commit' }}          |
+      |        | git push --force-with-lease origin {{ sha-in-origin-before-run 'origin parent commit' }}:parent |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
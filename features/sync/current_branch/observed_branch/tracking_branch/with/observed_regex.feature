@@ -0,0 +1,36 @@
+Feature: sync a branch that matches the observed regex
+
+  Background:
+    Given a feature branch "renovate/update-deps"
+    And local Git Town setting "observed-regex" is "^renovate/"
+    And the current branch is "renovate/update-deps"
+    And the commits
+      | BRANCH               | LOCATION      | MESSAGE       | FILE NAME   |
+      | main                 | local, origin | main commit   | main_file   |
+      | renovate/update-deps | local         | local commit  | local_file  |
+      |                      | origin        | origin commit | origin_file |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH               | COMMAND                                |
+      | renovate/update-deps | git fetch --prune --tags               |
+      |                      | git checkout main                      |
+      | main                 | git rebase origin/main                 |
+      |                      | git checkout renovate/update-deps      |
+      | renovate/update-deps | git rebase origin/renovate/update-deps |
+    And the current branch is still "renovate/update-deps"
+    And these commits exist now
+      | BRANCH               | LOCATION      | MESSAGE       |
+      | main                 | local, origin | main commit   |
+      | renovate/update-deps | local, origin | origin commit |
+      |                      | local         | local commit  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH               | COMMAND                                              |
+      | renovate/update-deps | git reset --hard {{ sha-before-run 'local commit' }} |
+    And the current branch is still "renovate/update-deps"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,43 @@
+Feature: sync a prototype branch without pushing it
+
+  Background:
+    Given the current branch is a local prototype branch "prototype"
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE                |
+      | main      | local    | local main commit      |
+      |           | origin   | origin main commit     |
+      | prototype | local    | local prototype commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                       |
+      | prototype | git fetch --prune --tags      |
+      |           | git checkout main             |
+      | main      | git rebase origin/main        |
+      |           | git push                      |
+      |           | git checkout prototype        |
+      | prototype | git merge --no-edit --ff main |
+    And the current branch is still "prototype"
+    And these commits exist now
+      | BRANCH    | LOCATION      | MESSAGE                            |
+      | main      | local, origin | origin main commit                 |
+      |           |               | local main commit                  |
+      | prototype | local         | local prototype commit             |
+      |           |               | origin main commit                 |
+      |           |               | local main commit                  |
+      |           |               | Merge branch 'main' into prototype |
+    And branch "prototype" is still a prototype branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                             |
+      | prototype | git reset --hard {{ sha 'local prototype commit' }} |
+    And the current branch is still "prototype"
+    And these commits exist now
+      | BRANCH    | LOCATION      | MESSAGE                |
+      | main      | local, origin | origin main commit     |
+      |           |               | local main commit      |
+      | prototype | local         | local prototype commit |
+    And the initial branches and lineage exist
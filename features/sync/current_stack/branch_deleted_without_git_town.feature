@@ -0,0 +1,34 @@
+Feature: sync a stack whose lineage contains a branch that no longer exists
+
+  Background:
+    Given feature branch "alpha" with these commits
+      | LOCATION      | MESSAGE      |
+      | local, origin | alpha commit |
+    And feature branch "beta" as a child of "alpha" has these commits
+      | LOCATION      | MESSAGE     |
+      | local, origin | beta commit |
+    And Git Town parent setting for branch "gamma" is "beta"
+    And the current branch is "alpha"
+    When I run "git-town sync --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | alpha  | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git checkout alpha                    |
+      | alpha  | git merge --no-edit --ff origin/alpha |
+      |        | git merge --no-edit --ff main         |
+      |        | git checkout beta                     |
+      | beta   | git merge --no-edit --ff origin/beta  |
+      |        | git merge --no-edit --ff alpha        |
+      |        | git checkout alpha                    |
+    And the current branch is still "alpha"
+    And the initial commits exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "alpha"
+    And the initial commits exist
@@ -0,0 +1,90 @@
+Feature: sync the stack of the current branch
+
+  Background:
+    Given feature branch "alpha" with these commits
+      | LOCATION      | MESSAGE      |
+      | local, origin | alpha commit |
+    And feature branch "beta" as a child of "alpha" has these commits
+      | LOCATION      | MESSAGE     |
+      | local, origin | beta commit |
+    And feature branch "gamma" as a child of "beta" has these commits
+      | LOCATION      | MESSAGE      |
+      | local, origin | gamma commit |
+    And feature branch "other" with these commits
+      | LOCATION      | MESSAGE      |
+      | local, origin | other commit |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And the current branch is "beta"
+    When I run "git-town sync --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | beta   | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git checkout alpha                    |
+      | alpha  | git merge --no-edit --ff origin/alpha |
+      |        | git merge --no-edit --ff main         |
+      |        | git push                              |
+      |        | git checkout beta                     |
+      | beta   | git merge --no-edit --ff origin/beta  |
+      |        | git merge --no-edit --ff alpha        |
+      |        | git push                              |
+      |        | git checkout gamma                    |
+      | gamma  | git merge --no-edit --ff origin/gamma |
+      |        | git merge --no-edit --ff beta         |
+      |        | git push                              |
+      |        | git checkout beta                     |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | alpha commit                   |
+      |        |               | beta commit                    |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      |        |               | Merge branch 'alpha' into beta |
+      | gamma  | local, origin | alpha commit                   |
+      |        |               | beta commit                    |
+      |        |               | gamma commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      |        |               | Merge branch 'alpha' into beta |
+      |        |               | Merge branch 'beta' into gamma |
+      | other  | local, origin | other commit                   |
+    And the initial branches and lineage exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha 'gamma commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout main                               |
+      | main   | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | origin        | main commit  |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | alpha commit |
+      |        |               | beta commit  |
+      | gamma  | local, origin | alpha commit |
+      |        |               | beta commit  |
+      |        |               | gamma commit |
+      | other  | local, origin | other commit |
+    And the initial branches and lineage exist
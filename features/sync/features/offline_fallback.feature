@@ -0,0 +1,57 @@
+Feature: sync offline when the origin remote is unreachable
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE              |
+      | feature | local    | local feature commit |
+    And the origin is unreachable
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+    And it prints:
+      """
+      Cannot reach the origin remote, synced offline. Run "git town push-pending" to push the skipped branches once you are online again.
+      """
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION | MESSAGE              |
+      | feature | local    | local feature commit |
+    When I run "git-town status"
+    Then it prints:
+      """
+      Pending pushes (run "git town push-pending" to push them):
+        - main
+        - feature
+      """
+
+  Scenario: sync again once the origin is reachable
+    Given the origin is reachable again
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+      |         | git push                                |
+    And all branches are now synchronized
+    When I run "git-town status"
+    Then it does not print "Pending pushes"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
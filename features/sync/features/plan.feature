@@ -0,0 +1,93 @@
+Feature: display the plan before syncing
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | local    | local main commit     |
+      |         | origin   | origin main commit    |
+      | feature | local    | local feature commit  |
+      |         | origin   | origin feature commit |
+
+  Scenario: run the plan
+    When I run "git-town sync --plan" and enter into the dialog:
+      | DIALOG       | KEYS  |
+      | confirm plan | enter |
+    Then it prints:
+      """
+      Git Town plans to run these operations:
+
+      main
+        1. Checkout Branch=main
+        2. RebaseBranch Branch=origin/main
+        3. PushCurrentBranch CurrentBranch=main
+
+      feature
+        4. Checkout Branch=feature
+        5. Merge Branch=origin/feature
+        6. MergeParent CurrentBranch=feature
+        7. PushCurrentBranch CurrentBranch=feature
+        8. CheckoutFirstExisting Branches="feature main" MainBranch=main
+        9. PreserveCheckoutHistory PreviousBranchCandidates=main
+      """
+    And it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git push                                |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+      |         | git push                                |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                                                    |
+      | main    | local, origin | origin main commit                                         |
+      |         |               | local main commit                                          |
+      | feature | local, origin | local feature commit                                       |
+      |         |               | origin feature commit                                      |
+      |         |               | Merge remote-tracking branch 'origin/feature' into feature |
+      |         |               | origin main commit                                         |
+      |         |               | local main commit                                          |
+      |         |               | Merge branch 'main' into feature                           |
+
+  Scenario: decline the plan
+    When I run "git-town sync --plan" and enter into the dialog:
+      | DIALOG       | KEYS       |
+      | confirm plan | down enter |
+    Then it prints:
+      """
+      Run the plan: no
+      """
+    And it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: display the plan as JSON
+    When I run "git-town sync --plan=json" and enter into the dialog:
+      | DIALOG       | KEYS       |
+      | confirm plan | down enter |
+    Then it prints:
+      """
+      [
+        {
+          "data": {
+            "Branch": "main"
+          },
+          "type": "Checkout"
+        },
+        {
+          "data": {
+            "Branch": "origin/main"
+          },
+          "type": "RebaseBranch"
+        },
+      """
+    And it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And the initial commits exist
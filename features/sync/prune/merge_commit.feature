@@ -0,0 +1,61 @@
+Feature: prune branches that were merged into their parent through a merge commit
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | alpha  | local, origin | alpha commit | alpha_file |
+      | beta   | local, origin | beta commit  | beta_file  |
+    And origin merges the "alpha" branch into "main"
+    And the current branch is "beta"
+    When I run "git-town sync --all --prune"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | beta   | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git push origin :alpha                |
+      |        | git branch -D alpha                   |
+      |        | git checkout beta                     |
+      | beta   | git merge --no-edit --ff origin/beta  |
+      |        | git merge --no-edit --ff main         |
+      |        | git push                              |
+      |        | git checkout gamma                    |
+      | gamma  | git merge --no-edit --ff origin/gamma |
+      |        | git merge --no-edit --ff main         |
+      |        | git push                              |
+      |        | git checkout beta                     |
+      | beta   | git push --tags                       |
+    And it prints:
+      """
+      deleted branch "alpha" because it was merged into its parent branch
+      """
+    And the current branch is still "beta"
+    And the branches are now
+      | REPOSITORY    | BRANCHES          |
+      | local, origin | main, beta, gamma |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | beta   | main   |
+      | gamma  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git branch alpha {{ sha 'alpha commit' }}       |
+      |        | git push -u origin alpha                        |
+      |        | git checkout main                               |
+      | main   | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
@@ -0,0 +1,46 @@
+Feature: prune the current branch after its commits were rebased onto its parent
+
+  Background:
+    Given a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | main   | origin        | alpha (#1)   | alpha_file | alpha content |
+    And the current branch is "alpha"
+    When I run "git-town sync --prune"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+      |        | git checkout main        |
+      | main   | git rebase origin/main   |
+      |        | git push origin :alpha   |
+      |        | git branch -D alpha      |
+    And it prints:
+      """
+      deleted branch "alpha" because it was merged into its parent branch
+      """
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | main   | git branch alpha {{ sha 'alpha commit' }}   |
+      |        | git push -u origin alpha                    |
+      |        | git reset --hard {{ sha 'initial commit' }} |
+      |        | git checkout alpha                          |
+    And the current branch is now "alpha"
+    And the initial branches and lineage exist
+
+  Scenario: without the prune flag
+    Given I ran "git-town undo"
+    When I run "git-town sync"
+    Then the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, alpha |
@@ -0,0 +1,35 @@
+Feature: switch to the youngest branch of the stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+
+  Scenario: stack with one youngest branch
+    Given the current branch is "alpha"
+    When I run "git-town top"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: stack with several youngest branches
+    Given a feature branch "delta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town top" and enter into the dialogs:
+      | KEYS       |
+      | down enter |
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout delta |
+    And the current branch is now "delta"
+
+  Scenario: already at the top
+    Given the current branch is "gamma"
+    When I run "git-town top"
+    Then it runs no commands
+    And it prints:
+      """
+      branch "gamma" is already at the top of its stack
+      """
+    And the current branch is still "gamma"
@@ -0,0 +1,50 @@
+Feature: undo several commands
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And I run "git-town hack beta"
+    And I run "git-town hack gamma"
+
+  Scenario: undo the two most recent commands
+    When I run "git-town undo --steps 2"
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | gamma  | git checkout beta   |
+      | beta   | git branch -D gamma |
+      |        | git checkout alpha  |
+      | alpha  | git branch -D beta  |
+    And the current branch is now "alpha"
+    And the initial branches and lineage exist
+
+  Scenario: undo one command at a time
+    When I run "git-town undo"
+    And I run "git-town undo"
+    Then the current branch is now "alpha"
+    And the initial branches and lineage exist
+
+  Scenario: undo after a dry run
+    When I run "git-town hack delta --dry-run"
+    And I run "git-town undo --steps 2"
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | gamma  | git checkout beta   |
+      | beta   | git branch -D gamma |
+      |        | git checkout alpha  |
+      | alpha  | git branch -D beta  |
+    And the current branch is now "alpha"
+    And the initial branches and lineage exist
+
+  Scenario: undo more commands than recorded
+    When I run "git-town undo --steps 3"
+    Then it prints the error:
+      """
+      cannot undo 3 commands, the undo history contains only 2
+      """
+    And the current branch is still "gamma"
+
+  Scenario: undo nothing
+    When I run "git-town undo --steps 0"
+    Then it prints the error:
+      """
+      please provide a positive number of steps to undo
+      """
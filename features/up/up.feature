@@ -0,0 +1,34 @@
+Feature: switch to the child branch
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+
+  Scenario: branch has one child
+    Given the current branch is "alpha"
+    When I run "git-town up"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+    And the current branch is now "beta"
+
+  Scenario: branch has several children
+    Given a feature branch "gamma" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town up" and enter into the dialogs:
+      | KEYS       |
+      | down enter |
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: branch has no children
+    Given the current branch is "beta"
+    When I run "git-town up"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "beta" has no child branches
+      """
+    And the current branch is still "beta"
@@ -0,0 +1,36 @@
+Feature: commit the changes made by the shell command
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town walk --commit touch walked"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                      |
+      |        | sh -c "touch walked"         |
+      | alpha  | git add -A                   |
+      |        | git commit -m "touch walked" |
+      |        | git checkout beta            |
+      | <none> | sh -c "touch walked"         |
+      | beta   | git add -A                   |
+      |        | git commit -m "touch walked" |
+      |        | git checkout alpha           |
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | touch walked |
+      | beta   | local    | touch walked |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | alpha  | git reset --hard {{ sha 'initial commit' }} |
+      |        | git checkout beta                           |
+      | beta   | git reset --hard {{ sha 'initial commit' }} |
+      |        | git checkout alpha                          |
+    And the current branch is still "alpha"
+    And the initial branches and lineage exist
+    And the initial commits exist
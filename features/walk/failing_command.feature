@@ -0,0 +1,51 @@
+Feature: a failing shell command stops the walk in a continuable state
+
+  Background:
+    Given feature branch "alpha" with these commits
+      | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT |
+      | local, origin | alpha commit | alpha_file | alpha        |
+    And feature branch "beta" as a child of "alpha" has these commits
+      | LOCATION      | MESSAGE     | FILE NAME | FILE CONTENT |
+      | local, origin | beta commit | beta_file | beta         |
+    And the current branch is "beta"
+    When I run "git-town walk test -f beta_file"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                   |
+      | beta   | git checkout alpha        |
+      | <none> | sh -c "test -f beta_file" |
+    And it prints the error:
+      """
+      command "test -f beta_file" failed: exit status 1
+      """
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      """
+    And the current branch is now "alpha"
+
+  Scenario: continue
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND                   |
+      | alpha  | git checkout beta         |
+      | <none> | sh -c "test -f beta_file" |
+    And the current branch is now "beta"
+
+  Scenario: skip
+    When I run "git-town skip"
+    Then it runs the commands
+      | BRANCH | COMMAND                   |
+      | alpha  | git checkout beta         |
+      | <none> | sh -c "test -f beta_file" |
+    And the current branch is now "beta"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+    And the current branch is now "beta"
+    And the initial branches and lineage exist
+    And the initial commits exist
@@ -0,0 +1,28 @@
+Feature: run a shell command on every branch in a stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other"
+    And the current branch is "beta"
+    When I run "git-town walk echo hello"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+      | <none> | sh -c "echo hello" |
+      | alpha  | git checkout beta  |
+      | <none> | sh -c "echo hello" |
+      | beta   | git checkout gamma |
+      | <none> | sh -c "echo hello" |
+      | gamma  | git checkout beta  |
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
@@ -0,0 +1,24 @@
+Feature: the shell command preserves the quoting of its arguments
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town walk --commit touch 'a|b'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                      |
+      |        | sh -c "touch 'a\|b'"         |
+      | alpha  | git add -A                   |
+      |        | git commit -m "touch 'a\|b'" |
+      |        | git checkout beta            |
+      | <none> | sh -c "touch 'a\|b'"         |
+      | beta   | git add -A                   |
+      |        | git commit -m "touch 'a\|b'" |
+      |        | git checkout alpha           |
+    And the current branch is still "alpha"
+    And these committed files exist now
+      | BRANCH | NAME | CONTENT |
+      | alpha  | a\|b |         |
+      | beta   | a\|b |         |
@@ -0,0 +1,24 @@
+Feature: the shell command can use shell features
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town walk --commit 'echo hello | tr a-z A-Z > walked'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                           |
+      |        | sh -c "echo hello \| tr a-z A-Z > walked"         |
+      | alpha  | git add -A                                        |
+      |        | git commit -m "echo hello \| tr a-z A-Z > walked" |
+      |        | git checkout beta                                 |
+      | <none> | sh -c "echo hello \| tr a-z A-Z > walked"         |
+      | beta   | git add -A                                        |
+      |        | git commit -m "echo hello \| tr a-z A-Z > walked" |
+      |        | git checkout alpha                                |
+    And the current branch is still "alpha"
+    And these committed files exist now
+      | BRANCH | NAME   | CONTENT |
+      | alpha  | walked | HELLO   |
+      | beta   | walked | HELLO   |
@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	azureDevOpsTokenTitle = `Azure DevOps personal access token`
+	azureDevOpsTokenHelp  = `
+If you have a personal access token for Azure DevOps
+with the "Code (Read & Write)" scope,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// AzureDevOpsToken lets the user enter the Azure DevOps personal access token.
+func AzureDevOpsToken(oldValue Option[configdomain.AzureDevOpsToken], inputs components.TestInput) (Option[configdomain.AzureDevOpsToken], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          azureDevOpsTokenHelp,
+		Prompt:        "Your Azure DevOps personal access token: ",
+		TestInput:     inputs,
+		Title:         azureDevOpsTokenTitle,
+	})
+	fmt.Printf(messages.AzureDevOpsToken, components.FormattedSecret(text, aborted))
+	return configdomain.NewAzureDevOpsTokenOption(text), aborted, err
+}
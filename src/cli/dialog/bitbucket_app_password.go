@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	bitbucketAppPasswordTitle = `Bitbucket app password`
+	bitbucketAppPasswordHelp  = `
+If you have an app password for Bitbucket,
+and want to ship branches from the CLI,
+please enter it now.
+It needs the "pullrequest:write" permission.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketAppPassword lets the user enter the Bitbucket app password.
+func BitbucketAppPassword(oldValue Option[configdomain.BitbucketAppPassword], inputs components.TestInput) (Option[configdomain.BitbucketAppPassword], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketAppPasswordHelp,
+		Prompt:        "Your Bitbucket app password: ",
+		TestInput:     inputs,
+		Title:         bitbucketAppPasswordTitle,
+	})
+	fmt.Printf(messages.BitbucketAppPassword, components.FormattedSecret(text, aborted))
+	return configdomain.NewBitbucketAppPasswordOption(text), aborted, err
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	bitbucketUsernameTitle = `Bitbucket username`
+	bitbucketUsernameHelp  = `
+If you have an app password for Bitbucket,
+and want to ship branches from the CLI,
+please enter the username it belongs to.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketUsername lets the user enter the Bitbucket username.
+func BitbucketUsername(oldValue Option[configdomain.BitbucketUsername], inputs components.TestInput) (Option[configdomain.BitbucketUsername], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketUsernameHelp,
+		Prompt:        "Your Bitbucket username: ",
+		TestInput:     inputs,
+		Title:         bitbucketUsernameTitle,
+	})
+	fmt.Printf(messages.BitbucketUsername, components.FormattedToken(text, aborted))
+	return configdomain.NewBitbucketUsernameOption(text), aborted, err
+}
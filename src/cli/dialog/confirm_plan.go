@@ -0,0 +1,49 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/dialog/components/list"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	confirmPlanTitle = `Run this plan?`
+	confirmPlanHelp  = `
+Git Town has displayed the operations it is about to run.
+Should it run them now?
+
+`
+)
+
+const (
+	ConfirmPlanEntryYes confirmPlanEntry = `yes, run the plan`
+	ConfirmPlanEntryNo  confirmPlanEntry = `no, exit without changing anything`
+)
+
+// ConfirmPlan asks the user whether to run the plan that was displayed to them.
+func ConfirmPlan(inputs components.TestInput) (bool, bool, error) {
+	entries := list.NewEntries(
+		ConfirmPlanEntryYes,
+		ConfirmPlanEntryNo,
+	)
+	selection, aborted, err := components.RadioList(entries, 0, confirmPlanTitle, confirmPlanHelp, inputs)
+	if err != nil || aborted {
+		return false, aborted, err
+	}
+	fmt.Printf(messages.PlanConfirm, components.FormattedSelection(selection.Short(), aborted))
+	return selection == ConfirmPlanEntryYes, aborted, err
+}
+
+type confirmPlanEntry string
+
+func (self confirmPlanEntry) Short() string {
+	start, _, _ := strings.Cut(self.String(), ",")
+	return start
+}
+
+func (self confirmPlanEntry) String() string {
+	return string(self)
+}
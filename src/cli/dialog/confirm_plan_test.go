@@ -0,0 +1,21 @@
+package dialog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/shoenig/test/must"
+)
+
+func TestConfirmPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ConfirmPlanEntry", func(t *testing.T) {
+		t.Parallel()
+		t.Run("Short", func(t *testing.T) {
+			t.Parallel()
+			must.Eq(t, "yes", dialog.ConfirmPlanEntryYes.Short())
+			must.Eq(t, "no", dialog.ConfirmPlanEntryNo.Short())
+		})
+	})
+}
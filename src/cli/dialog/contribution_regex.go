@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	contributionRegexTitle = `Regular expression for contribution branches`
+	ContributionRegexHelp  = `
+All branches whose names match this regular expression
+are considered contribution branches.
+Git Town pushes your commits to these branches
+but does not propose or ship them.
+
+If you are not sure, leave this empty.
+
+`
+)
+
+// ContributionRegex lets the user enter the regular expression for contribution branches.
+func ContributionRegex(oldValue Option[configdomain.ContributionRegex], inputs components.TestInput) (Option[configdomain.ContributionRegex], bool, error) {
+	value, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          ContributionRegexHelp,
+		Prompt:        "Contribution regex: ",
+		TestInput:     inputs,
+		Title:         contributionRegexTitle,
+	})
+	fmt.Printf(messages.ContributionRegex, components.FormattedSelection(value, aborted))
+	return configdomain.NewContributionRegexOption(value), aborted, err
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	forgejoTokenTitle = `Forgejo API token`
+	forgejoTokenHelp  = `
+If you have an API token for Forgejo,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// ForgejoToken lets the user enter the Forgejo API token.
+func ForgejoToken(oldValue Option[configdomain.ForgejoToken], inputs components.TestInput) (Option[configdomain.ForgejoToken], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          forgejoTokenHelp,
+		Prompt:        "Your Forgejo API token: ",
+		TestInput:     inputs,
+		Title:         forgejoTokenTitle,
+	})
+	fmt.Printf(messages.ForgejoToken, components.FormattedSecret(text, aborted))
+	return configdomain.NewForgejoTokenOption(text), aborted, err
+}
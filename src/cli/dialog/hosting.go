@@ -24,10 +24,13 @@ Only change this if your code hosting server uses as custom URL.
 func HostingPlatform(existingValue Option[configdomain.HostingPlatform], inputs components.TestInput) (Option[configdomain.HostingPlatform], bool, error) {
 	entries := hostingPlatformEntries{
 		hostingPlatformAutoDetect,
+		hostingPlatformAzureDevOps,
 		hostingPlatformBitBucket,
+		hostingPlatformForgejo,
 		hostingPlatformGitea,
 		hostingPlatformGitHub,
 		hostingPlatformGitLab,
+		hostingPlatformExternal,
 	}
 	cursor := entries.IndexOfHostingPlatformOrStart(existingValue.GetOrDefault())
 	newValue, aborted, err := components.RadioList(list.NewEntries(entries...), cursor, hostingPlatformTitle, HostingPlatformHelp, inputs)
@@ -38,19 +41,28 @@ func HostingPlatform(existingValue Option[configdomain.HostingPlatform], inputs
 type hostingPlatformEntry string
 
 const (
-	hostingPlatformAutoDetect hostingPlatformEntry = "auto-detect"
-	hostingPlatformBitBucket  hostingPlatformEntry = "BitBucket"
-	hostingPlatformGitea      hostingPlatformEntry = "Gitea"
-	hostingPlatformGitHub     hostingPlatformEntry = "GitHub"
-	hostingPlatformGitLab     hostingPlatformEntry = "GitLab"
+	hostingPlatformAutoDetect  hostingPlatformEntry = "auto-detect"
+	hostingPlatformAzureDevOps hostingPlatformEntry = "Azure DevOps"
+	hostingPlatformBitBucket   hostingPlatformEntry = "BitBucket"
+	hostingPlatformExternal    hostingPlatformEntry = "external hosting command"
+	hostingPlatformForgejo     hostingPlatformEntry = "Forgejo"
+	hostingPlatformGitea       hostingPlatformEntry = "Gitea"
+	hostingPlatformGitHub      hostingPlatformEntry = "GitHub"
+	hostingPlatformGitLab      hostingPlatformEntry = "GitLab"
 )
 
 func (entry hostingPlatformEntry) HostingPlatform() Option[configdomain.HostingPlatform] {
 	switch entry {
 	case hostingPlatformAutoDetect:
 		return None[configdomain.HostingPlatform]()
+	case hostingPlatformAzureDevOps:
+		return Some(configdomain.HostingPlatformAzureDevOps)
 	case hostingPlatformBitBucket:
 		return Some(configdomain.HostingPlatformBitbucket)
+	case hostingPlatformExternal:
+		return Some(configdomain.HostingPlatformExternal)
+	case hostingPlatformForgejo:
+		return Some(configdomain.HostingPlatformForgejo)
 	case hostingPlatformGitea:
 		return Some(configdomain.HostingPlatformGitea)
 	case hostingPlatformGitHub:
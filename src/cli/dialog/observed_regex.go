@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	observedRegexTitle = `Regular expression for observed branches`
+	ObservedRegexHelp  = `
+All branches whose names match this regular expression
+are considered observed branches.
+Git Town pulls updates into these branches
+but never pushes to them.
+
+If you are not sure, leave this empty.
+
+`
+)
+
+// ObservedRegex lets the user enter the regular expression for observed branches.
+func ObservedRegex(oldValue Option[configdomain.ObservedRegex], inputs components.TestInput) (Option[configdomain.ObservedRegex], bool, error) {
+	value, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          ObservedRegexHelp,
+		Prompt:        "Observed regex: ",
+		TestInput:     inputs,
+		Title:         observedRegexTitle,
+	})
+	fmt.Printf(messages.ObservedRegex, components.FormattedSelection(value, aborted))
+	return configdomain.NewObservedRegexOption(value), aborted, err
+}
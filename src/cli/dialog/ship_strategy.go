@@ -0,0 +1,89 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/dialog/components/list"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	shipStrategyTitle = `Ship strategy`
+	ShipStrategyHelp  = `
+How should "git ship" merge branches into their parent?
+
+squash-merge: combine all commits on the shipped branch
+into a single commit on the parent branch.
+If a proposal exists, ship via the code hosting API.
+
+merge: merge the shipped branch into its parent
+using a merge commit.
+If a proposal exists, ship via the code hosting API.
+
+fast-forward: fast-forward the parent branch
+to the shipped branch. This keeps the existing commits
+and does not create new ones.
+
+api: squash-merge the proposal via the code hosting API only.
+Shipping fails if there is no proposal.
+
+`
+)
+
+const (
+	shipStrategyEntrySquashMerge shipStrategyEntry = `squash-merge the branch into a single commit`
+	shipStrategyEntryMerge       shipStrategyEntry = `merge the branch using a merge commit`
+	shipStrategyEntryFastForward shipStrategyEntry = `fast-forward the parent branch to the branch`
+	shipStrategyEntryAPI         shipStrategyEntry = `api: ship via the code hosting API only`
+)
+
+func ShipStrategy(existing configdomain.ShipStrategy, inputs components.TestInput) (configdomain.ShipStrategy, bool, error) {
+	entries := list.NewEntries(
+		shipStrategyEntrySquashMerge,
+		shipStrategyEntryMerge,
+		shipStrategyEntryFastForward,
+		shipStrategyEntryAPI,
+	)
+	var defaultPos int
+	switch existing {
+	case configdomain.ShipStrategySquashMerge:
+		defaultPos = 0
+	case configdomain.ShipStrategyMerge:
+		defaultPos = 1
+	case configdomain.ShipStrategyFastForward:
+		defaultPos = 2
+	case configdomain.ShipStrategyAPI:
+		defaultPos = 3
+	default:
+		panic("unknown ship-strategy: " + existing.String())
+	}
+	selection, aborted, err := components.RadioList(list.NewEntries(entries...), defaultPos, shipStrategyTitle, ShipStrategyHelp, inputs)
+	if err != nil || aborted {
+		return configdomain.ShipStrategySquashMerge, aborted, err
+	}
+	shipStrategy := selection.Data.ShipStrategy()
+	fmt.Printf(messages.ShipStrategy, components.FormattedSelection(shipStrategy.String(), aborted))
+	return shipStrategy, aborted, err
+}
+
+type shipStrategyEntry string
+
+func (self shipStrategyEntry) String() string {
+	return string(self)
+}
+
+func (self shipStrategyEntry) ShipStrategy() configdomain.ShipStrategy {
+	switch self {
+	case shipStrategyEntrySquashMerge:
+		return configdomain.ShipStrategySquashMerge
+	case shipStrategyEntryMerge:
+		return configdomain.ShipStrategyMerge
+	case shipStrategyEntryFastForward:
+		return configdomain.ShipStrategyFastForward
+	case shipStrategyEntryAPI:
+		return configdomain.ShipStrategyAPI
+	}
+	panic("unhandled shipStrategyEntry: " + self)
+}
@@ -1,6 +1,7 @@
 package dialog
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 
@@ -10,23 +11,37 @@ import (
 	"github.com/git-town/git-town/v14/src/cli/dialog/components/list"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/gohacks/slice"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/muesli/termenv"
 )
 
 type SwitchBranchEntry struct {
-	Branch        gitdomain.LocalBranchName
-	Indentation   string
-	OtherWorktree bool
+	Branch      gitdomain.LocalBranchName
+	Indentation string
+	OutOfSync   bool                           // whether the local branch is out of sync with its tracking branch
+	Proposal    Option[hostingdomain.Proposal] // the open proposal for this branch, if known
+	Worktree    Option[string]                 // location of the other worktree that has this branch checked out
 }
 
 func (sbe SwitchBranchEntry) String() string {
-	return sbe.Indentation + sbe.Branch.String()
+	result := sbe.Indentation + sbe.Branch.String()
+	if proposal, hasProposal := sbe.Proposal.Get(); hasProposal {
+		result += "  " + proposal.Summary()
+	}
+	if sbe.OutOfSync {
+		result += "  " + messages.SwitchBranchOutOfSync
+	}
+	if worktree, hasWorktree := sbe.Worktree.Get(); hasWorktree {
+		result += "  " + fmt.Sprintf(messages.SwitchBranchOtherWorktree, worktree)
+	}
+	return result
 }
 
-func SwitchBranch(localBranches gitdomain.LocalBranchNames, initialBranch gitdomain.LocalBranchName, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos, uncommittedChanges bool, inputs components.TestInput) (gitdomain.LocalBranchName, bool, error) {
-	entries := SwitchBranchEntries(localBranches, lineage, allBranches)
+func SwitchBranch(localBranches gitdomain.LocalBranchNames, initialBranch gitdomain.LocalBranchName, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos, worktrees gitdomain.Worktrees, pushRemote gitdomain.Remote, proposals map[gitdomain.LocalBranchName]hostingdomain.Proposal, uncommittedChanges bool, inputs components.TestInput) (gitdomain.LocalBranchName, bool, error) {
+	entries := SwitchBranchEntries(localBranches, lineage, allBranches, worktrees, pushRemote, proposals)
 	cursor := SwitchBranchCursorPos(entries, initialBranch)
 	dialogProgram := tea.NewProgram(SwitchModel{
 		InitialBranchPos:   cursor,
@@ -94,21 +109,21 @@ func (self SwitchModel) View() string {
 		switch {
 		case isSelected:
 			color := self.Colors.Selection
-			if entry.Data.OtherWorktree {
+			if entry.Data.Worktree.IsSome() {
 				color = color.Faint()
 			}
 			s.WriteString(color.Styled("> " + entry.Text))
 		case isInitial:
 			color := self.Colors.Initial
-			if entry.Data.OtherWorktree {
+			if entry.Data.Worktree.IsSome() {
 				color = color.Faint()
 			}
 			s.WriteString(color.Styled("* " + entry.Text))
-		case entry.Data.OtherWorktree:
+		case entry.Data.Worktree.IsSome():
 			s.WriteString(colors.Faint().Styled("+ " + entry.Text))
 		default:
 			color := termenv.String()
-			if entry.Data.OtherWorktree {
+			if entry.Data.Worktree.IsSome() {
 				color = color.Faint()
 			}
 			s.WriteString(color.Styled("  " + entry.Text))
@@ -162,12 +177,12 @@ func SwitchBranchCursorPos(entries []SwitchBranchEntry, initialBranch gitdomain.
 }
 
 // SwitchBranchEntries provides the entries for the "switch branch" components.
-func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos) []SwitchBranchEntry {
+func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos, worktrees gitdomain.Worktrees, pushRemote gitdomain.Remote, proposals map[gitdomain.LocalBranchName]hostingdomain.Proposal) []SwitchBranchEntry {
 	entries := make([]SwitchBranchEntry, 0, lineage.Len())
 	roots := lineage.Roots()
 	// add all entries from the lineage
 	for _, root := range roots {
-		layoutBranches(&entries, root, "", lineage, allBranches)
+		layoutBranches(&entries, root, "", lineage, allBranches, worktrees, pushRemote, proposals)
 	}
 	// add missing local branches
 	branchesInLineage := lineage.Branches()
@@ -178,31 +193,38 @@ func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage confi
 		if slices.Contains(branchesInLineage, localBranch) {
 			continue
 		}
-		var otherWorktree bool
-		if branchInfo, hasBranchInfo := allBranches.FindByLocalName(localBranch).Get(); hasBranchInfo {
-			otherWorktree = branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
-		} else {
-			otherWorktree = false
-		}
-		entries = append(entries, SwitchBranchEntry{Branch: localBranch, Indentation: "", OtherWorktree: otherWorktree})
+		entries = append(entries, newSwitchBranchEntry(localBranch, "", allBranches, worktrees, proposals))
 	}
 	return entries
 }
 
 // layoutBranches adds entries for the given branch and its children to the given entry list.
 // The entries are indented according to their position in the given lineage.
-func layoutBranches(result *[]SwitchBranchEntry, branch gitdomain.LocalBranchName, indentation string, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos) {
-	if allBranches.HasLocalBranch(branch) || allBranches.HasMatchingTrackingBranchFor(branch) {
-		var otherWorktree bool
-		if branchInfo, hasBranchInfo := allBranches.FindByLocalName(branch).Get(); hasBranchInfo {
-			otherWorktree = branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
-		} else {
-			otherWorktree = false
-		}
-		*result = append(*result, SwitchBranchEntry{Branch: branch, Indentation: indentation, OtherWorktree: otherWorktree})
+func layoutBranches(result *[]SwitchBranchEntry, branch gitdomain.LocalBranchName, indentation string, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos, worktrees gitdomain.Worktrees, pushRemote gitdomain.Remote, proposals map[gitdomain.LocalBranchName]hostingdomain.Proposal) {
+	if allBranches.HasLocalBranch(branch) || allBranches.HasMatchingTrackingBranchFor(branch, pushRemote) {
+		*result = append(*result, newSwitchBranchEntry(branch, indentation, allBranches, worktrees, proposals))
 	}
 	for _, child := range lineage.Children(branch) {
-		layoutBranches(result, child, indentation+"  ", lineage, allBranches)
+		layoutBranches(result, child, indentation+"  ", lineage, allBranches, worktrees, pushRemote, proposals)
+	}
+}
+
+// newSwitchBranchEntry provides the entry for the given branch in the "switch branch" components.
+func newSwitchBranchEntry(branch gitdomain.LocalBranchName, indentation string, allBranches gitdomain.BranchInfos, worktrees gitdomain.Worktrees, proposals map[gitdomain.LocalBranchName]hostingdomain.Proposal) SwitchBranchEntry {
+	outOfSync := false
+	if branchInfo, hasBranchInfo := allBranches.FindByLocalName(branch).Get(); hasBranchInfo {
+		outOfSync = branchInfo.SyncStatus == gitdomain.SyncStatusNotInSync
+	}
+	proposal := None[hostingdomain.Proposal]()
+	if branchProposal, hasProposal := proposals[branch]; hasProposal {
+		proposal = Some(branchProposal)
+	}
+	return SwitchBranchEntry{
+		Branch:      branch,
+		Indentation: indentation,
+		OutOfSync:   outOfSync,
+		Proposal:    proposal,
+		Worktree:    worktrees.PathFor(branch),
 	}
 }
 
@@ -211,7 +233,7 @@ func newSwitchBranchListEntries(switchBranchEntries []SwitchBranchEntry) list.En
 	for e, entry := range switchBranchEntries {
 		result[e] = list.Entry[SwitchBranchEntry]{
 			Data:    entry,
-			Enabled: !entry.OtherWorktree,
+			Enabled: entry.Worktree.IsNone(),
 			Text:    entry.String(),
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
 )
 
@@ -19,10 +20,10 @@ func TestSwitchBranch(t *testing.T) {
 		t.Run("initialBranch is in the entry list", func(t *testing.T) {
 			t.Parallel()
 			entries := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha1", Indentation: "", OtherWorktree: false},
-				{Branch: "beta", Indentation: "", OtherWorktree: false},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha1", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 			}
 			initialBranch := gitdomain.NewLocalBranchName("alpha1")
 			have := dialog.SwitchBranchCursorPos(entries, initialBranch)
@@ -32,9 +33,9 @@ func TestSwitchBranch(t *testing.T) {
 		t.Run("initialBranch is not in the entry list", func(t *testing.T) {
 			t.Parallel()
 			entries := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha", Indentation: "", OtherWorktree: false},
-				{Branch: "beta", Indentation: "", OtherWorktree: false},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 			}
 			initialBranch := gitdomain.NewLocalBranchName("other")
 			have := dialog.SwitchBranchCursorPos(entries, initialBranch)
@@ -59,11 +60,11 @@ func TestSwitchBranch(t *testing.T) {
 				gitdomain.BranchInfo{LocalName: Some(beta), SyncStatus: gitdomain.SyncStatusLocalOnly},
 				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusLocalOnly},
 			}
-			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches)
+			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches, gitdomain.Worktrees{}, gitdomain.RemoteOrigin, map[gitdomain.LocalBranchName]hostingdomain.Proposal{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha", Indentation: "  ", OtherWorktree: false},
-				{Branch: "beta", Indentation: "  ", OtherWorktree: false},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 			}
 			must.Eq(t, want, have)
 		})
@@ -81,11 +82,12 @@ func TestSwitchBranch(t *testing.T) {
 				gitdomain.BranchInfo{LocalName: Some(beta), SyncStatus: gitdomain.SyncStatusOtherWorktree},
 				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusLocalOnly},
 			}
-			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches)
+			worktrees := gitdomain.Worktrees{{Branch: beta, Path: "../beta"}}
+			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches, worktrees, gitdomain.RemoteOrigin, map[gitdomain.LocalBranchName]hostingdomain.Proposal{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha", Indentation: "  ", OtherWorktree: false},
-				{Branch: "beta", Indentation: "  ", OtherWorktree: true},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: Some("../beta")},
 			}
 			must.Eq(t, want, have)
 		})
@@ -105,12 +107,12 @@ func TestSwitchBranch(t *testing.T) {
 				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusLocalOnly},
 				gitdomain.BranchInfo{LocalName: Some(perennial1), SyncStatus: gitdomain.SyncStatusLocalOnly},
 			}
-			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches)
+			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches, gitdomain.Worktrees{}, gitdomain.RemoteOrigin, map[gitdomain.LocalBranchName]hostingdomain.Proposal{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "alpha", Indentation: "  ", OtherWorktree: false},
-				{Branch: "beta", Indentation: "  ", OtherWorktree: false},
-				{Branch: "perennial-1", Indentation: "", OtherWorktree: false},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "perennial-1", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 			}
 			must.Eq(t, want, have)
 		})
@@ -128,14 +130,50 @@ func TestSwitchBranch(t *testing.T) {
 				gitdomain.BranchInfo{LocalName: Some(grandchild), SyncStatus: gitdomain.SyncStatusLocalOnly},
 				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusLocalOnly},
 			}
-			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches)
+			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches, gitdomain.Worktrees{}, gitdomain.RemoteOrigin, map[gitdomain.LocalBranchName]hostingdomain.Proposal{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: "", OtherWorktree: false},
-				{Branch: "child", Indentation: "  ", OtherWorktree: false},
-				{Branch: "grandchild", Indentation: "    ", OtherWorktree: false},
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "child", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "grandchild", Indentation: "    ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 			}
 			must.Eq(t, want, have)
 		})
+		t.Run("proposals and out of sync branches", func(t *testing.T) {
+			t.Parallel()
+			alpha := gitdomain.NewLocalBranchName("alpha")
+			beta := gitdomain.NewLocalBranchName("beta")
+			main := gitdomain.NewLocalBranchName("main")
+			lineage := configdomain.NewLineage()
+			lineage.Add(alpha, main)
+			lineage.Add(beta, main)
+			localBranches := gitdomain.LocalBranchNames{alpha, beta, main}
+			allBranches := gitdomain.BranchInfos{
+				gitdomain.BranchInfo{LocalName: Some(alpha), SyncStatus: gitdomain.SyncStatusUpToDate},
+				gitdomain.BranchInfo{LocalName: Some(beta), SyncStatus: gitdomain.SyncStatusNotInSync},
+				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusUpToDate},
+			}
+			proposal := hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStateSuccess,
+				Draft:        false,
+				MergeWithAPI: true,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateApproved,
+				Target:       main,
+				Title:        "alpha",
+				URL:          "https://github.com/org/repo/pull/12",
+			}
+			proposals := map[gitdomain.LocalBranchName]hostingdomain.Proposal{alpha: proposal}
+			have := dialog.SwitchBranchEntries(localBranches, lineage, allBranches, gitdomain.Worktrees{}, gitdomain.RemoteOrigin, proposals)
+			want := []dialog.SwitchBranchEntry{
+				{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+				{Branch: "alpha", Indentation: "  ", OutOfSync: false, Proposal: Some(proposal), Worktree: None[string]()},
+				{Branch: "beta", Indentation: "  ", OutOfSync: true, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+			}
+			must.Eq(t, want, have)
+			must.EqOp(t, "  alpha  #12 (approved, CI passed)", have[1].String())
+			must.EqOp(t, "  beta  [out of sync]", have[2].String())
+		})
 	})
 
 	t.Run("View", func(t *testing.T) {
@@ -144,7 +182,7 @@ func TestSwitchBranch(t *testing.T) {
 			model := dialog.SwitchModel{
 				List: list.List[dialog.SwitchBranchEntry]{
 					Cursor:       0,
-					Entries:      newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{{Branch: "main", Indentation: "", OtherWorktree: false}}),
+					Entries:      newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()}}),
 					MaxDigits:    1,
 					NumberFormat: "%d",
 				},
@@ -166,9 +204,9 @@ func TestSwitchBranch(t *testing.T) {
 				List: list.List[dialog.SwitchBranchEntry]{
 					Cursor: 0,
 					Entries: newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: "", OtherWorktree: false},
-						{Branch: "one", Indentation: "", OtherWorktree: false},
-						{Branch: "two", Indentation: "", OtherWorktree: true},
+						{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "one", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "two", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: Some("../two")},
 					}),
 					MaxDigits:    1,
 					NumberFormat: "%d",
@@ -182,7 +220,7 @@ func TestSwitchBranch(t *testing.T) {
 			want := `
 > main
   one
-` + dim + `+ two` + reset + `
+` + dim + `+ two  [worktree ../two]` + reset + `
 
 
   ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`
@@ -196,13 +234,13 @@ func TestSwitchBranch(t *testing.T) {
 				List: list.List[dialog.SwitchBranchEntry]{
 					Cursor: 0,
 					Entries: newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: "", OtherWorktree: false},
-						{Branch: "alpha", Indentation: "  ", OtherWorktree: false},
-						{Branch: "alpha1", Indentation: "    ", OtherWorktree: false},
-						{Branch: "alpha2", Indentation: "    ", OtherWorktree: true},
-						{Branch: "beta", Indentation: "  ", OtherWorktree: false},
-						{Branch: "beta1", Indentation: "    ", OtherWorktree: false},
-						{Branch: "other", Indentation: "", OtherWorktree: false},
+						{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "alpha", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "alpha1", Indentation: "    ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "alpha2", Indentation: "    ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: Some("../alpha2")},
+						{Branch: "beta", Indentation: "  ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "beta1", Indentation: "    ", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
+						{Branch: "other", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()},
 					}),
 					MaxDigits:    1,
 					NumberFormat: "%d",
@@ -217,7 +255,7 @@ func TestSwitchBranch(t *testing.T) {
 > main
     alpha
       alpha1
-` + dim + `+     alpha2` + reset + `
+` + dim + `+     alpha2  [worktree ../alpha2]` + reset + `
     beta
       beta1
   other
@@ -233,7 +271,7 @@ func TestSwitchBranch(t *testing.T) {
 			model := dialog.SwitchModel{
 				List: list.List[dialog.SwitchBranchEntry]{
 					Cursor:       0,
-					Entries:      newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{{Branch: "main", Indentation: "", OtherWorktree: false}}),
+					Entries:      newSwitchBranchBubbleListEntries([]dialog.SwitchBranchEntry{{Branch: "main", Indentation: "", OutOfSync: false, Proposal: None[hostingdomain.Proposal](), Worktree: None[string]()}}),
 					MaxDigits:    1,
 					NumberFormat: "%d",
 				},
@@ -263,7 +301,7 @@ func newSwitchBranchBubbleListEntries(entries []dialog.SwitchBranchEntry) []list
 	for e, entry := range entries {
 		result[e] = list.Entry[dialog.SwitchBranchEntry]{
 			Data:    entry,
-			Enabled: !entry.OtherWorktree,
+			Enabled: entry.Worktree.IsNone(),
 			Text:    entry.String(),
 		}
 	}
@@ -0,0 +1,49 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Format()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		have, err := readFlag(&cmd)
+		must.NoError(t, err)
+		must.EqOp(t, report.FormatText, have)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Format()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--format=json"})
+		must.NoError(t, err)
+		have, err := readFlag(&cmd)
+		must.NoError(t, err)
+		must.EqOp(t, report.FormatJSON, have)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Format()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--format=zonk"})
+		must.NoError(t, err)
+		_, err = readFlag(&cmd)
+		must.Error(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/report"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/spf13/cobra"
+)
+
+const planLong = "plan" // long form of the "plan" CLI flag
+
+// Plan provides type-safe access to the CLI flag that displays the program to run before running it.
+func Plan() (AddFunc, ReadPlanFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().String(planLong, "", `display the planned Git Town operations and ask for confirmation before running them, format is "text" or "json"`)
+		cmd.Flags().Lookup(planLong).NoOptDefVal = report.FormatText.String()
+	}
+	readFlag := func(cmd *cobra.Command) (Option[report.Format], error) {
+		value, err := cmd.Flags().GetString(planLong)
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have a string %q flag", cmd.Name(), planLong))
+		}
+		if !cmd.Flags().Changed(planLong) {
+			return None[report.Format](), nil
+		}
+		format, err := report.NewFormat(value)
+		if err != nil {
+			return None[report.Format](), err
+		}
+		return Some(format), nil
+	}
+	return addFlag, readFlag
+}
+
+// ReadPlanFlagFunc defines the type signature for helper functions that provide the value of the "plan" CLI flag associated with a Cobra command.
+type ReadPlanFlagFunc func(*cobra.Command) (Option[report.Format], error)
@@ -0,0 +1,62 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not given", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Plan()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		have, err := readFlag(&cmd)
+		must.NoError(t, err)
+		must.Eq(t, None[report.Format](), have)
+	})
+
+	t.Run("without value", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Plan()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--plan"})
+		must.NoError(t, err)
+		have, err := readFlag(&cmd)
+		must.NoError(t, err)
+		must.Eq(t, Some(report.FormatText), have)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Plan()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--plan=json"})
+		must.NoError(t, err)
+		have, err := readFlag(&cmd)
+		must.NoError(t, err)
+		must.Eq(t, Some(report.FormatJSON), have)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Plan()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--plan=zonk"})
+		must.NoError(t, err)
+		_, err = readFlag(&cmd)
+		must.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const stepsLong = "steps" // long form of the "steps" CLI flag
+
+// Steps provides type-safe access to the CLI flag that defines how many Git Town commands to undo.
+func Steps() (AddFunc, ReadStepsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Uint(stepsLong, 1, "number of Git Town commands to undo")
+	}
+	readFlag := func(cmd *cobra.Command) uint {
+		value, err := cmd.Flags().GetUint(stepsLong)
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have an unsigned integer %q flag", cmd.Name(), stepsLong))
+		}
+		return value
+	}
+	return addFlag, readFlag
+}
+
+// ReadStepsFlagFunc defines the type signature for helper functions that provide the value of the "steps" CLI flag associated with a Cobra command.
+type ReadStepsFlagFunc func(*cobra.Command) uint
@@ -0,0 +1,33 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestSteps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Steps()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		must.EqOp(t, uint(1), readFlag(&cmd))
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Steps()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--steps", "3"})
+		must.NoError(t, err)
+		must.EqOp(t, uint(3), readFlag(&cmd))
+	})
+}
@@ -0,0 +1,34 @@
+package flags
+
+import (
+	"fmt"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/spf13/cobra"
+)
+
+// String provides mistake-safe access to string Cobra command-line flags.
+func String(name, short, desc string, persistent FlagType) (AddFunc, ReadStringFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		switch persistent {
+		case FlagTypePersistent:
+			cmd.PersistentFlags().StringP(name, short, "", desc)
+		case FlagTypeNonPersistent:
+			cmd.Flags().StringP(name, short, "", desc)
+		}
+	}
+	readFlag := func(cmd *cobra.Command) Option[string] {
+		value, err := cmd.Flags().GetString(name)
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have a string %q flag", cmd.Name(), name))
+		}
+		if value == "" {
+			return None[string]()
+		}
+		return Some(value)
+	}
+	return addFlag, readFlag
+}
+
+// ReadStringFlagFunc defines the type signature for helper functions that provide the value of a string CLI flag associated with a Cobra command.
+type ReadStringFlagFunc func(*cobra.Command) Option[string]
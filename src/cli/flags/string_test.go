@@ -0,0 +1,44 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("long version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--myflag", "my-value"})
+		must.NoError(t, err)
+		must.Eq(t, Some("my-value"), readFlag(&cmd))
+	})
+
+	t.Run("short version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"-m", "my-value"})
+		must.NoError(t, err)
+		must.Eq(t, Some("my-value"), readFlag(&cmd))
+	})
+
+	t.Run("not provided", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		must.Eq(t, None[string](), readFlag(&cmd))
+	})
+}
@@ -0,0 +1,29 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// Format defines legal values for the "--format" CLI flag
+// of commands that display information about the repository.
+type Format string
+
+func (self Format) String() string { return string(self) }
+
+const (
+	FormatJSON = Format("json") // machine-readable output
+	FormatText = Format("text") // human-readable output
+)
+
+func NewFormat(text string) (Format, error) {
+	switch text {
+	case "text", "":
+		return FormatText, nil
+	case "json":
+		return FormatJSON, nil
+	default:
+		return FormatText, fmt.Errorf(messages.OutputFormatUnknown, text)
+	}
+}
@@ -0,0 +1,157 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+)
+
+// Repo is the machine-readable description of the branches in a repository,
+// the Git Town command that is currently suspended in it,
+// and the pushes that an offline sync had to skip.
+// Scripts and editor integrations parse this data,
+// hence the JSON field names and values must stay stable.
+type Repo struct { //nolint:tagliatelle // the documented JSON output of status and branch defines these field names
+	CurrentBranch     Option[string]            `json:"current_branch"`
+	Branches          []Branch                  `json:"branches"`
+	UnfinishedCommand Option[UnfinishedCommand] `json:"unfinished_command"`
+	PendingPushes     []string                  `json:"pending_pushes"`
+}
+
+// Branch describes a branch and, recursively, the branches that have it as their parent.
+type Branch struct { //nolint:tagliatelle // the documented JSON output of status and branch defines these field names
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Parent     Option[string] `json:"parent"`
+	SyncStatus string         `json:"sync_status"`
+	Children   []Branch       `json:"children"`
+}
+
+// UnfinishedCommand describes a Git Town command that hit a problem and waits for the user to resolve it.
+type UnfinishedCommand struct { //nolint:tagliatelle // the documented JSON output of status and branch defines these field names
+	Command     string    `json:"command"`
+	EndBranch   string    `json:"end_branch"`
+	EndTime     time.Time `json:"end_time"`
+	CanContinue bool      `json:"can_continue"`
+	CanSkip     bool      `json:"can_skip"`
+	CanUndo     bool      `json:"can_undo"`
+}
+
+type NewRepoArgs struct {
+	Branches      gitdomain.BranchesSnapshot
+	Config        configdomain.UnvalidatedConfig
+	PendingPushes gitdomain.LocalBranchNames
+	RunState      Option[runstate.RunState]
+}
+
+// NewRepo provides the Repo report for the given repository data.
+func NewRepo(args NewRepoArgs) Repo {
+	syncStatuses := map[gitdomain.LocalBranchName]gitdomain.SyncStatus{}
+	names := gitdomain.LocalBranchNames{}
+	for _, branchInfo := range args.Branches.Branches {
+		name, hasLocalName := branchInfo.LocalName.Get()
+		if !hasLocalName {
+			remoteName, hasRemoteName := branchInfo.RemoteName.Get()
+			if !hasRemoteName {
+				continue
+			}
+			name = remoteName.LocalBranchName()
+		}
+		names = append(names, name)
+		syncStatuses[name] = branchInfo.SyncStatus
+	}
+	branches := []Branch{}
+	for _, name := range names {
+		parent, hasParent := args.Config.Lineage.Parent(name).Get()
+		if !hasParent || !names.Contains(parent) {
+			branches = append(branches, newBranch(name, names, syncStatuses, args.Config))
+		}
+	}
+	return Repo{
+		CurrentBranch:     stringOption(args.Branches.Active),
+		Branches:          branches,
+		UnfinishedCommand: newUnfinishedCommand(args.RunState),
+		PendingPushes:     args.PendingPushes.Strings(),
+	}
+}
+
+// Print writes the given report as JSON to STDOUT.
+func Print(repo Repo) error {
+	content, err := json.MarshalIndent(repo, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
+
+// BranchTypeName provides the stable name of the given branch type.
+func BranchTypeName(branchType configdomain.BranchType) string {
+	return branchType.Name()
+}
+
+// SyncStatusName provides the stable name of the given sync status.
+func SyncStatusName(syncStatus gitdomain.SyncStatus) string {
+	switch syncStatus {
+	case gitdomain.SyncStatusUpToDate:
+		return "up_to_date"
+	case gitdomain.SyncStatusNotInSync:
+		return "not_in_sync"
+	case gitdomain.SyncStatusLocalOnly:
+		return "local_only"
+	case gitdomain.SyncStatusRemoteOnly:
+		return "remote_only"
+	case gitdomain.SyncStatusDeletedAtRemote:
+		return "deleted_at_remote"
+	case gitdomain.SyncStatusOtherWorktree:
+		return "other_worktree"
+	}
+	panic(fmt.Sprintf("unhandled sync status: %q", syncStatus))
+}
+
+func newBranch(name gitdomain.LocalBranchName, names gitdomain.LocalBranchNames, syncStatuses map[gitdomain.LocalBranchName]gitdomain.SyncStatus, config configdomain.UnvalidatedConfig) Branch {
+	children := []Branch{}
+	for _, child := range config.Lineage.Children(name) {
+		if names.Contains(child) {
+			children = append(children, newBranch(child, names, syncStatuses, config))
+		}
+	}
+	return Branch{
+		Name:       name.String(),
+		Type:       BranchTypeName(config.BranchType(name)),
+		Parent:     stringOption(config.Lineage.Parent(name)),
+		SyncStatus: SyncStatusName(syncStatuses[name]),
+		Children:   children,
+	}
+}
+
+func newUnfinishedCommand(runStateOpt Option[runstate.RunState]) Option[UnfinishedCommand] {
+	runState, hasRunState := runStateOpt.Get()
+	if !hasRunState {
+		return None[UnfinishedCommand]()
+	}
+	details, hasDetails := runState.UnfinishedDetails.Get()
+	if !hasDetails {
+		return None[UnfinishedCommand]()
+	}
+	return Some(UnfinishedCommand{
+		Command:     runState.Command,
+		EndBranch:   details.EndBranch.String(),
+		EndTime:     details.EndTime,
+		CanContinue: runState.HasRunProgram(),
+		CanSkip:     details.CanSkip,
+		CanUndo:     runState.HasAbortProgram(),
+	})
+}
+
+func stringOption(branchOpt Option[gitdomain.LocalBranchName]) Option[string] {
+	if branch, hasBranch := branchOpt.Get(); hasBranch {
+		return Some(branch.String())
+	}
+	return None[string]()
+}
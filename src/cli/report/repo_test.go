@@ -0,0 +1,117 @@
+package report_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewRepo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lineage tree", func(t *testing.T) {
+		t.Parallel()
+		main := gitdomain.NewLocalBranchName("main")
+		alpha := gitdomain.NewLocalBranchName("alpha")
+		beta := gitdomain.NewLocalBranchName("beta")
+		config := configdomain.DefaultConfig()
+		config.MainBranch = Some(main)
+		config.Lineage.Add(alpha, main)
+		config.Lineage.Add(beta, alpha)
+		have := report.NewRepo(report.NewRepoArgs{
+			Branches: gitdomain.BranchesSnapshot{
+				Active: Some(alpha),
+				Branches: gitdomain.BranchInfos{
+					gitdomain.BranchInfo{
+						LocalName:  Some(main),
+						LocalSHA:   Some(gitdomain.NewSHA("111111")),
+						RemoteName: Some(gitdomain.NewRemoteBranchName("origin/main")),
+						RemoteSHA:  Some(gitdomain.NewSHA("111111")),
+						SyncStatus: gitdomain.SyncStatusUpToDate,
+					},
+					gitdomain.BranchInfo{
+						LocalName:  Some(alpha),
+						LocalSHA:   Some(gitdomain.NewSHA("222222")),
+						RemoteName: None[gitdomain.RemoteBranchName](),
+						RemoteSHA:  None[gitdomain.SHA](),
+						SyncStatus: gitdomain.SyncStatusLocalOnly,
+					},
+					gitdomain.BranchInfo{
+						LocalName:  None[gitdomain.LocalBranchName](),
+						LocalSHA:   None[gitdomain.SHA](),
+						RemoteName: Some(gitdomain.NewRemoteBranchName("origin/beta")),
+						RemoteSHA:  Some(gitdomain.NewSHA("333333")),
+						SyncStatus: gitdomain.SyncStatusRemoteOnly,
+					},
+				},
+			},
+			Config:        config,
+			PendingPushes: gitdomain.NewLocalBranchNames("alpha"),
+			RunState:      None[runstate.RunState](),
+		})
+		want := report.Repo{
+			CurrentBranch: Some("alpha"),
+			Branches: []report.Branch{
+				{
+					Name:       "main",
+					Type:       "main",
+					Parent:     None[string](),
+					SyncStatus: "up_to_date",
+					Children: []report.Branch{
+						{
+							Name:       "alpha",
+							Type:       "feature",
+							Parent:     Some("main"),
+							SyncStatus: "local_only",
+							Children: []report.Branch{
+								{
+									Name:       "beta",
+									Type:       "feature",
+									Parent:     Some("alpha"),
+									SyncStatus: "remote_only",
+									Children:   []report.Branch{},
+								},
+							},
+						},
+					},
+				},
+			},
+			UnfinishedCommand: None[report.UnfinishedCommand](),
+			PendingPushes:     []string{"alpha"},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("unfinished command", func(t *testing.T) {
+		t.Parallel()
+		endTime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		runState := runstate.EmptyRunState()
+		runState.Command = "sync"
+		runState.UnfinishedDetails = SomeP(&runstate.UnfinishedRunStateDetails{
+			CanSkip:   true,
+			EndBranch: gitdomain.NewLocalBranchName("feature"),
+			EndTime:   endTime,
+		})
+		have := report.NewRepo(report.NewRepoArgs{
+			Branches:      gitdomain.EmptyBranchesSnapshot(),
+			Config:        configdomain.DefaultConfig(),
+			PendingPushes: gitdomain.LocalBranchNames{},
+			RunState:      Some(runState),
+		})
+		want := Some(report.UnfinishedCommand{
+			CanContinue: false,
+			CanSkip:     true,
+			CanUndo:     false,
+			Command:     "sync",
+			EndBranch:   "feature",
+			EndTime:     endTime,
+		})
+		must.Eq(t, want, have.UnfinishedCommand)
+	})
+}
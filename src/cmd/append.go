@@ -7,6 +7,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/execute"
@@ -34,6 +35,7 @@ See "sync" for information regarding upstream remotes.`
 func appendCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	cmd := cobra.Command{
 		Use:     "append <branch>",
 		GroupID: "lineage",
@@ -41,15 +43,20 @@ func appendCmd() *cobra.Command {
 		Short:   appendDesc,
 		Long:    cmdhelpers.Long(appendDesc, appendHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeAppend(args[0], readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeAppend(args[0], readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeAppend(arg string, dryRun, verbose bool) error {
+func executeAppend(arg string, dryRun bool, plan Option[report.Format], verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -90,6 +97,7 @@ func executeAppend(arg string, dryRun, verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -100,6 +108,7 @@ type appendData struct {
 	allBranches               gitdomain.BranchInfos
 	branchesSnapshot          gitdomain.BranchesSnapshot
 	branchesToSync            gitdomain.BranchInfos
+	command                   string // name of the Git Town command that creates the new branch
 	config                    config.ValidatedConfig
 	dialogTestInputs          components.TestInputs
 	dryRun                    bool
@@ -125,6 +134,7 @@ func determineAppendData(targetBranch gitdomain.LocalBranchName, repo execute.Op
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 !repoStatus.OpenChanges,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -145,7 +155,7 @@ func determineAppendData(targetBranch gitdomain.LocalBranchName, repo execute.Op
 	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
 		fc.Fail(messages.BranchAlreadyExistsLocally, targetBranch)
 	}
-	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch) {
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch, repo.UnvalidatedConfig.Config.TrackingRemote(targetBranch)) {
 		fc.Fail(messages.BranchAlreadyExistsRemotely, targetBranch)
 	}
 	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
@@ -175,6 +185,7 @@ func determineAppendData(targetBranch gitdomain.LocalBranchName, repo execute.Op
 		allBranches:               branchesSnapshot.Branches,
 		branchesSnapshot:          branchesSnapshot,
 		branchesToSync:            branchesToSync,
+		command:                   "append",
 		config:                    validatedConfig,
 		dialogTestInputs:          dialogTestInputs,
 		dryRun:                    dryRun,
@@ -194,12 +205,15 @@ func appendProgram(data appendData) program.Program {
 	if !data.hasOpenChanges {
 		for _, branch := range data.branchesToSync {
 			sync.BranchProgram(branch, sync.BranchProgramArgs{
-				BranchInfos:   data.allBranches,
-				Config:        data.config.Config,
-				InitialBranch: data.initialBranch,
-				Program:       &prog,
-				Remotes:       data.remotes,
-				PushBranch:    true,
+				BranchInfos:        data.allBranches,
+				Command:            data.command,
+				Config:             data.config.Config,
+				InitialBranch:      data.initialBranch,
+				Program:            &prog,
+				Remotes:            data.remotes,
+				PushBranch:         true,
+				QueueSkippedPushes: false,
+				Worktrees:          data.branchesSnapshot.Worktrees,
 			})
 		}
 	}
@@ -207,7 +221,7 @@ func appendProgram(data appendData) program.Program {
 		Ancestors: data.newBranchParentCandidates,
 		Branch:    data.targetBranch,
 	})
-	if data.remotes.HasOrigin() && data.config.Config.ShouldPushNewBranches() && data.config.Config.IsOnline() {
+	if data.remotes.HasRemote(data.config.Config.PushRemote) && data.config.Config.ShouldPushNewBranches() && data.config.Config.IsOnline() {
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: data.targetBranch})
 	}
 	prog.Add(&opcodes.SetExistingParent{
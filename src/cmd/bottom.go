@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const bottomDesc = "Switch to the oldest branch of the current stack"
+
+const bottomHelp = `
+Checks out the oldest ancestor of the current branch
+that is not the main branch or a perennial branch.`
+
+func bottomCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "bottom",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   bottomDesc,
+		Long:    cmdhelpers.Long(bottomDesc, bottomHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeBottom(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBottom(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineNavigateData(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	ancestors := data.existingBranches(data.lineage.AncestorsWithoutRoot(data.initialBranch))
+	if len(ancestors) == 0 {
+		fmt.Printf(messages.NavigateAlreadyAtBottom, data.initialBranch)
+		return nil
+	}
+	return navigateTo(repo, data, gitdomain.LocalBranchNames{ancestors[0]})
+}
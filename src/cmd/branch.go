@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/format"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const branchDesc = "Display the branch hierarchy"
+
+const branchHelp = `
+Displays the local branches and their lineage.
+
+With "--format=json", prints the branch hierarchy,
+the type and sync status of each branch,
+and details about a suspended Git Town command
+in a machine-readable format.`
+
+func branchCmd() *cobra.Command {
+	addFormatFlag, readFormatFlag := flags.Format()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "branch",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   branchDesc,
+		Long:    cmdhelpers.Long(branchDesc, branchHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format, err := readFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeBranch(format, readVerboseFlag(cmd))
+		},
+	}
+	addFormatFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBranch(outputFormat report.Format, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := determineBranchData(repo)
+	if err != nil {
+		return err
+	}
+	if outputFormat == report.FormatJSON {
+		return report.Print(report.NewRepo(report.NewRepoArgs{
+			Branches:      data.branchesSnapshot,
+			Config:        *repo.UnvalidatedConfig.Config,
+			PendingPushes: data.pendingPushes,
+			RunState:      data.runState,
+		}))
+	}
+	for _, root := range data.roots() {
+		fmt.Println(format.BranchTree(root, data.lineage))
+	}
+	print.Footer(verbose, repo.CommandsCounter.Count(), print.NoFinalMessages)
+	return nil
+}
+
+type branchData struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	lineage          configdomain.Lineage
+	pendingPushes    gitdomain.LocalBranchNames
+	runState         Option[runstate.RunState]
+}
+
+func emptyBranchData() branchData {
+	return branchData{} //exhaustruct:ignore
+}
+
+// provides the local branches that have no parent
+func (self branchData) roots() gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range self.branchesSnapshot.Branches.LocalBranches().Names() {
+		if self.lineage.Parent(branch).IsNone() {
+			result = append(result, branch)
+		}
+	}
+	return result
+}
+
+func determineBranchData(repo execute.OpenRepoResult) (branchData, error) {
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return emptyBranchData(), err
+	}
+	runState, err := statefile.Load(repo.RootDir)
+	if err != nil {
+		return emptyBranchData(), err
+	}
+	pendingPushes, err := statefile.LoadPendingPushes(repo.RootDir)
+	if err != nil {
+		return emptyBranchData(), err
+	}
+	return branchData{
+		branchesSnapshot: branchesSnapshot,
+		lineage:          repo.UnvalidatedConfig.Config.Lineage,
+		pendingPushes:    pendingPushes,
+		runState:         runState,
+	}, nil
+}
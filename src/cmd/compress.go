@@ -7,6 +7,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -43,6 +44,7 @@ You can provide a custom commit message with the -m switch.
 func compressCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	addMessageFlag, readMessageFlag := flags.CommitMessage("customize the commit message")
 	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Compress the entire stack", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
@@ -51,17 +53,22 @@ func compressCmd() *cobra.Command {
 		Short: compressDesc,
 		Long:  cmdhelpers.Long(compressDesc, compressHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeCompress(readDryRunFlag(cmd), readVerboseFlag(cmd), readMessageFlag(cmd), readStackFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeCompress(readDryRunFlag(cmd), plan, readVerboseFlag(cmd), readMessageFlag(cmd), readStackFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
 	addStackFlag(&cmd)
 	return &cmd
 }
 
-func executeCompress(dryRun, verbose bool, message Option[gitdomain.CommitMessage], stack bool) error {
+func executeCompress(dryRun bool, plan Option[report.Format], verbose bool, message Option[gitdomain.CommitMessage], stack bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -103,6 +110,7 @@ func executeCompress(dryRun, verbose bool, message Option[gitdomain.CommitMessag
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -143,6 +151,7 @@ func determineCompressBranchesData(repo execute.OpenRepoResult, dryRun, verbose
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 true,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -286,7 +295,7 @@ func shouldCompressBranch(branchName gitdomain.LocalBranchName, branchType confi
 
 func validateCanCompressBranchType(branchName gitdomain.LocalBranchName, branchType configdomain.BranchType) error {
 	switch branchType {
-	case configdomain.BranchTypeParkedBranch, configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeParkedBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.CompressIsPerennial)
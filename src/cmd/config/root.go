@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/git-town/git-town/v14/src/cli/flags"
 	"github.com/git-town/git-town/v14/src/cli/format"
@@ -55,14 +56,20 @@ func printConfig(config configdomain.UnvalidatedConfig) {
 	print.Entry("perennial branches", format.StringsSetting((config.PerennialBranches.Join(", "))))
 	print.Entry("perennial regex", format.StringSetting(config.PerennialRegex.String()))
 	print.Entry("parked branches", format.StringsSetting((config.ParkedBranches.Join(", "))))
+	print.Entry("prototype branches", format.StringsSetting((config.PrototypeBranches.Join(", "))))
 	print.Entry("contribution branches", format.StringsSetting((config.ContributionBranches.Join(", "))))
+	print.Entry("contribution regex", format.StringSetting(config.ContributionRegex.String()))
 	print.Entry("observed branches", format.StringsSetting((config.ObservedBranches.Join(", "))))
+	print.Entry("observed regex", format.StringSetting(config.ObservedRegex.String()))
+	print.Entry("branch type rules", format.StringsSetting(strings.Join(config.BranchTypeRules.Strings(), ", ")))
 	fmt.Println()
 	print.Header("Configuration")
 	print.Entry("offline", format.Bool(config.Offline.Bool()))
 	print.Entry("run pre-push hook", format.Bool(bool(config.PushHook)))
 	print.Entry("push new branches", format.Bool(config.ShouldPushNewBranches()))
+	print.Entry("push remote", config.PushRemote.String())
 	print.Entry("ship deletes the tracking branch", format.Bool(config.ShipDeleteTrackingBranch.Bool()))
+	print.Entry("ship strategy", config.ShipStrategy.String())
 	print.Entry("sync-feature strategy", config.SyncFeatureStrategy.String())
 	print.Entry("sync-perennial strategy", config.SyncPerennialStrategy.String())
 	print.Entry("sync with upstream", format.Bool(config.SyncUpstream.Bool()))
@@ -70,10 +77,26 @@ func printConfig(config configdomain.UnvalidatedConfig) {
 	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("hosting platform override", format.StringSetting(config.HostingPlatform.String()))
+	print.Entry("external hosting command", format.OptionalStringerSetting(config.HostingExternalCommand))
+	print.Entry("proposal remote", config.ProposalRemote.String())
+	print.Entry("proposals show lineage", format.Bool(config.ProposalsShowLineage.Bool()))
+	print.Entry("Azure DevOps token", format.OptionalStringerSetting(config.AzureDevOpsToken))
+	print.Entry("Bitbucket username", format.OptionalStringerSetting(config.BitbucketUsername))
+	print.Entry("Bitbucket app password", format.OptionalStringerSetting(config.BitbucketAppPassword))
+	print.Entry("Forgejo token", format.OptionalStringerSetting(config.ForgejoToken))
 	print.Entry("GitHub token", format.OptionalStringerSetting(config.GitHubToken))
 	print.Entry("GitLab token", format.OptionalStringerSetting(config.GitLabToken))
 	print.Entry("Gitea token", format.OptionalStringerSetting(config.GiteaToken))
 	fmt.Println()
+	if len(config.Hooks) > 0 {
+		print.Header("Hooks")
+		for _, hook := range configdomain.AllHooks() {
+			if command, hasCommand := config.Hooks.Command(hook).Get(); hasCommand {
+				print.Entry(hook.String(), command)
+			}
+		}
+		fmt.Println()
+	}
 	if config.Lineage.Len() > 0 {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage))
 	}
@@ -103,7 +103,7 @@ func determineHostingPlatform(config config.UnvalidatedConfig, userChoice Option
 	if userChoice.IsSome() {
 		return userChoice
 	}
-	if originURL, hasOriginURL := config.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := config.ProposalRemoteURL().Get(); hasOriginURL {
 		return hosting.Detect(originURL, userChoice)
 	}
 	return None[configdomain.HostingPlatform]()
@@ -138,14 +138,43 @@ func enterData(config config.UnvalidatedConfig, gitCommands git.Commands, backen
 	if err != nil || aborted {
 		return aborted, err
 	}
+	data.userInput.config.ContributionRegex, aborted, err = dialog.ContributionRegex(config.Config.ContributionRegex, data.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
+	data.userInput.config.ObservedRegex, aborted, err = dialog.ObservedRegex(config.Config.ObservedRegex, data.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
+	// branch type rules have no dialog, keep the existing ones so that saving to the config file retains them
+	data.userInput.config.BranchTypeRules = config.Config.BranchTypeRules
 	data.userInput.config.HostingPlatform, aborted, err = dialog.HostingPlatform(config.Config.HostingPlatform, data.dialogInputs.Next())
 	if err != nil || aborted {
 		return aborted, err
 	}
 	if platform, has := determineHostingPlatform(config, data.userInput.config.HostingPlatform).Get(); has {
 		switch platform {
+		case configdomain.HostingPlatformAzureDevOps:
+			data.userInput.config.AzureDevOpsToken, aborted, err = dialog.AzureDevOpsToken(config.Config.AzureDevOpsToken, data.dialogInputs.Next())
+			if err != nil || aborted {
+				return aborted, err
+			}
 		case configdomain.HostingPlatformBitbucket:
-			// BitBucket API isn't supported yet
+			data.userInput.config.BitbucketUsername, aborted, err = dialog.BitbucketUsername(config.Config.BitbucketUsername, data.dialogInputs.Next())
+			if err != nil || aborted {
+				return aborted, err
+			}
+			data.userInput.config.BitbucketAppPassword, aborted, err = dialog.BitbucketAppPassword(config.Config.BitbucketAppPassword, data.dialogInputs.Next())
+			if err != nil || aborted {
+				return aborted, err
+			}
+		case configdomain.HostingPlatformExternal:
+			// the external hosting command is configured via "git config"
+		case configdomain.HostingPlatformForgejo:
+			data.userInput.config.ForgejoToken, aborted, err = dialog.ForgejoToken(config.Config.ForgejoToken, data.dialogInputs.Next())
+			if err != nil || aborted {
+				return aborted, err
+			}
 		case configdomain.HostingPlatformGitea:
 			data.userInput.config.GiteaToken, aborted, err = dialog.GiteaToken(config.Config.GiteaToken, data.dialogInputs.Next())
 			if err != nil || aborted {
@@ -195,6 +224,10 @@ func enterData(config config.UnvalidatedConfig, gitCommands git.Commands, backen
 	if err != nil || aborted {
 		return aborted, err
 	}
+	data.userInput.config.ShipStrategy, aborted, err = dialog.ShipStrategy(config.Config.ShipStrategy, data.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
 	data.userInput.configStorage, aborted, err = dialog.ConfigStorage(data.hasConfigFile, data.dialogInputs.Next())
 	if err != nil || aborted {
 		return aborted, err
@@ -213,6 +246,7 @@ func loadSetupData(repo execute.OpenRepoResult, verbose bool) (setupData, bool,
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -239,6 +273,22 @@ func saveAll(userInput userInput, oldConfig config.UnvalidatedConfig, gitCommand
 	if err != nil {
 		return err
 	}
+	err = saveAzureDevOpsToken(oldConfig.Config.AzureDevOpsToken, userInput.config.AzureDevOpsToken, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketUsername(oldConfig.Config.BitbucketUsername, userInput.config.BitbucketUsername, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketAppPassword(oldConfig.Config.BitbucketAppPassword, userInput.config.BitbucketAppPassword, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
+	err = saveForgejoToken(oldConfig.Config.ForgejoToken, userInput.config.ForgejoToken, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
 	err = saveGiteaToken(oldConfig.Config.GiteaToken, userInput.config.GiteaToken, gitCommands, frontend)
 	if err != nil {
 		return err
@@ -262,14 +312,17 @@ func saveAll(userInput userInput, oldConfig config.UnvalidatedConfig, gitCommand
 
 func saveToGit(userInput userInput, oldConfig config.UnvalidatedConfig, gitCommands git.Commands, frontend gitdomain.Runner) error {
 	fc := execute.FailureCollector{}
+	fc.Check(saveContributionRegex(oldConfig.Config.ContributionRegex, userInput.config.ContributionRegex, oldConfig))
 	fc.Check(saveHostingPlatform(oldConfig.Config.HostingPlatform, userInput.config.HostingPlatform, gitCommands, frontend))
 	fc.Check(saveOriginHostname(oldConfig.Config.HostingOriginHostname, userInput.config.HostingOriginHostname, gitCommands, frontend))
 	fc.Check(saveMainBranch(oldConfig.Config.MainBranch, userInput.config.MainBranch.GetOrPanic(), oldConfig))
+	fc.Check(saveObservedRegex(oldConfig.Config.ObservedRegex, userInput.config.ObservedRegex, oldConfig))
 	fc.Check(savePerennialBranches(oldConfig.Config.PerennialBranches, userInput.config.PerennialBranches, oldConfig))
 	fc.Check(savePerennialRegex(oldConfig.Config.PerennialRegex, userInput.config.PerennialRegex, oldConfig))
 	fc.Check(savePushHook(oldConfig.Config.PushHook, userInput.config.PushHook, oldConfig))
 	fc.Check(savePushNewBranches(oldConfig.Config.PushNewBranches, userInput.config.PushNewBranches, oldConfig))
 	fc.Check(saveShipDeleteTrackingBranch(oldConfig.Config.ShipDeleteTrackingBranch, userInput.config.ShipDeleteTrackingBranch, oldConfig))
+	fc.Check(saveShipStrategy(oldConfig.Config.ShipStrategy, userInput.config.ShipStrategy, oldConfig))
 	fc.Check(saveSyncFeatureStrategy(oldConfig.Config.SyncFeatureStrategy, userInput.config.SyncFeatureStrategy, oldConfig))
 	fc.Check(saveSyncPerennialStrategy(oldConfig.Config.SyncPerennialStrategy, userInput.config.SyncPerennialStrategy, oldConfig))
 	fc.Check(saveSyncUpstream(oldConfig.Config.SyncUpstream, userInput.config.SyncUpstream, oldConfig))
@@ -294,6 +347,57 @@ func saveAliases(oldAliases, newAliases configdomain.Aliases, gitCommands git.Co
 	return nil
 }
 
+func saveAzureDevOpsToken(oldToken, newToken Option[configdomain.AzureDevOpsToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newToken == oldToken {
+		return nil
+	}
+	if value, has := newToken.Get(); has {
+		return gitCommands.SetAzureDevOpsToken(frontend, value)
+	}
+	return gitCommands.RemoveAzureDevOpsToken(frontend)
+}
+
+func saveBitbucketAppPassword(oldPassword, newPassword Option[configdomain.BitbucketAppPassword], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newPassword == oldPassword {
+		return nil
+	}
+	if value, has := newPassword.Get(); has {
+		return gitCommands.SetBitbucketAppPassword(frontend, value)
+	}
+	return gitCommands.RemoveBitbucketAppPassword(frontend)
+}
+
+func saveBitbucketUsername(oldValue, newValue Option[configdomain.BitbucketUsername], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newValue == oldValue {
+		return nil
+	}
+	if value, has := newValue.Get(); has {
+		return gitCommands.SetBitbucketUsername(frontend, value)
+	}
+	return gitCommands.RemoveBitbucketUsername(frontend)
+}
+
+func saveContributionRegex(oldValue, newValue Option[configdomain.ContributionRegex], config config.UnvalidatedConfig) error {
+	if newValue == oldValue {
+		return nil
+	}
+	if value, has := newValue.Get(); has {
+		return config.SetContributionRegexLocally(value)
+	}
+	config.RemoveContributionRegex()
+	return nil
+}
+
+func saveForgejoToken(oldToken, newToken Option[configdomain.ForgejoToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newToken == oldToken {
+		return nil
+	}
+	if value, has := newToken.Get(); has {
+		return gitCommands.SetForgejoToken(frontend, value)
+	}
+	return gitCommands.RemoveForgejoToken(frontend)
+}
+
 func saveGiteaToken(oldToken, newToken Option[configdomain.GiteaToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
 	if newToken == oldToken {
 		return nil
@@ -346,6 +450,17 @@ func saveMainBranch(oldValue Option[gitdomain.LocalBranchName], newValue gitdoma
 	return config.SetMainBranch(newValue)
 }
 
+func saveObservedRegex(oldValue, newValue Option[configdomain.ObservedRegex], config config.UnvalidatedConfig) error {
+	if newValue == oldValue {
+		return nil
+	}
+	if value, has := newValue.Get(); has {
+		return config.SetObservedRegexLocally(value)
+	}
+	config.RemoveObservedRegex()
+	return nil
+}
+
 func saveOriginHostname(oldValue, newValue Option[configdomain.HostingOriginHostname], gitCommands git.Commands, frontend gitdomain.Runner) error {
 	if newValue == oldValue {
 		return nil
@@ -395,6 +510,13 @@ func saveShipDeleteTrackingBranch(oldValue, newValue configdomain.ShipDeleteTrac
 	return config.SetShipDeleteTrackingBranch(newValue, false)
 }
 
+func saveShipStrategy(oldValue, newValue configdomain.ShipStrategy, config config.UnvalidatedConfig) error {
+	if newValue == oldValue {
+		return nil
+	}
+	return config.SetShipStrategy(newValue)
+}
+
 func saveSyncFeatureStrategy(oldValue, newValue configdomain.SyncFeatureStrategy, config config.UnvalidatedConfig) error {
 	if newValue == oldValue {
 		return nil
@@ -428,13 +550,17 @@ func saveToFile(userInput userInput, config config.UnvalidatedConfig) error {
 	if err != nil {
 		return err
 	}
+	config.RemoveBranchTypeRules()
+	config.RemoveContributionRegex()
 	config.RemoveMainBranch()
+	config.RemoveObservedRegex()
 	config.RemovePerennialBranches()
 	config.RemovePerennialRegex()
 	config.RemovePushNewBranches()
 	config.RemovePushHook()
 	config.RemoveSyncBeforeShip()
 	config.RemoveShipDeleteTrackingBranch()
+	config.RemoveShipStrategy()
 	config.RemoveSyncFeatureStrategy()
 	config.RemoveSyncPerennialStrategy()
 	config.RemoveSyncUpstream()
@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"os"
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
@@ -76,6 +77,7 @@ func executeContinue(verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    None[report.Format](),
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -93,6 +95,7 @@ func determineContinueData(repo execute.OpenRepoResult, verbose bool) (continueD
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -138,12 +141,13 @@ func determineContinueData(repo execute.OpenRepoResult, verbose bool) (continueD
 			return emptyContinueData(), false, errors.New(messages.CurrentBranchCannotDetermine)
 		}
 	}
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *repo.UnvalidatedConfig.Config,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
 		})
 	}
 	return continueData{
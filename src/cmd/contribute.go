@@ -119,6 +119,10 @@ func removeNonContributionBranchTypes(branches commandconfig.BranchesAndTypes, c
 			if err := config.RemoveFromParkedBranches(branchName); err != nil {
 				return err
 			}
+		case configdomain.BranchTypePrototypeBranch:
+			if err := config.RemoveFromPrototypeBranches(branchName); err != nil {
+				return err
+			}
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 	}
@@ -143,7 +147,7 @@ func determineContributeData(args []string, repo execute.OpenRepoResult) (contri
 	case 1:
 		branch := gitdomain.NewLocalBranchName(args[0])
 		branchesToMark.Add(branch, *repo.UnvalidatedConfig.Config)
-		branchInfo := branchesSnapshot.Branches.FindByRemoteName(branch.TrackingBranch())
+		branchInfo := branchesSnapshot.Branches.FindByRemoteName(branch.TrackingBranch(gitdomain.RemoteOrigin))
 		if branchInfo.SyncStatus == gitdomain.SyncStatusRemoteOnly {
 			branchToCheckout = Some(branch)
 		} else {
@@ -162,7 +166,7 @@ func determineContributeData(args []string, repo execute.OpenRepoResult) (contri
 
 func validateContributeData(data contributeData) error {
 	for branchName, branchType := range data.branchesToMark {
-		if !data.allBranches.HasLocalBranch(branchName) && !data.allBranches.HasMatchingTrackingBranchFor(branchName) {
+		if !data.allBranches.HasLocalBranch(branchName) && !data.allBranches.HasMatchingTrackingBranchFor(branchName, gitdomain.RemoteOrigin) {
 			return fmt.Errorf(messages.BranchDoesntExist, branchName)
 		}
 		switch branchType {
@@ -172,7 +176,7 @@ func validateContributeData(data contributeData) error {
 			return errors.New(messages.PerennialBranchCannotMakeContribution)
 		case configdomain.BranchTypeContributionBranch:
 			return fmt.Errorf(messages.BranchIsAlreadyContribution, branchName)
-		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		}
 	}
 	return nil
@@ -11,6 +11,8 @@ import (
 func Execute() error {
 	rootCmd := rootCmd()
 	rootCmd.AddCommand(appendCmd())
+	rootCmd.AddCommand(bottomCmd())
+	rootCmd.AddCommand(branchCmd())
 	rootCmd.AddCommand(completionsCmd(&rootCmd))
 	rootCmd.AddCommand(compressCmd())
 	rootCmd.AddCommand(config.RootCmd())
@@ -18,13 +20,19 @@ func Execute() error {
 	rootCmd.AddCommand(contributeCmd())
 	rootCmd.AddCommand(debug.RootCmd())
 	rootCmd.AddCommand(diffParentCommand())
+	rootCmd.AddCommand(downCmd())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(historyCmd())
 	rootCmd.AddCommand(killCommand())
+	rootCmd.AddCommand(mergeCmd())
 	rootCmd.AddCommand(newPullRequestCommand())
 	rootCmd.AddCommand(observeCmd())
 	rootCmd.AddCommand(offlineCmd())
 	rootCmd.AddCommand(parkCmd())
+	rootCmd.AddCommand(proposalsCmd())
 	rootCmd.AddCommand(proposeCommand())
+	rootCmd.AddCommand(prototypeCmd())
+	rootCmd.AddCommand(pushPendingCmd())
 	rootCmd.AddCommand(prependCommand())
 	rootCmd.AddCommand(renameBranchCommand())
 	rootCmd.AddCommand(repoCommand())
@@ -34,6 +42,9 @@ func Execute() error {
 	rootCmd.AddCommand(skipCmd())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(syncCmd())
+	rootCmd.AddCommand(topCmd())
 	rootCmd.AddCommand(undoCmd())
+	rootCmd.AddCommand(upCmd())
+	rootCmd.AddCommand(walkCmd())
 	return rootCmd.Execute()
 }
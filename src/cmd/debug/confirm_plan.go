@@ -0,0 +1,20 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/spf13/cobra"
+)
+
+func confirmPlan() *cobra.Command {
+	return &cobra.Command{
+		Use: "confirm-plan",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogTestInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.ConfirmPlan(dialogTestInputs.Next())
+			return err
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/spf13/cobra"
+)
+
+func enterAzureDevOpsToken() *cobra.Command {
+	return &cobra.Command{
+		Use: "azure-devops-token",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.AzureDevOpsToken(None[configdomain.AzureDevOpsToken](), dialogInputs.Next())
+			return err
+		},
+	}
+}
@@ -10,13 +10,20 @@ func RootCmd() *cobra.Command {
 		Short:  "Displays dialogs to help debug them.",
 		Hidden: true,
 	}
+	debugCommand.AddCommand(confirmPlan())
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterAzureDevOpsToken())
+	debugCommand.AddCommand(enterBitbucketAppPassword())
+	debugCommand.AddCommand(enterBitbucketUsername())
+	debugCommand.AddCommand(enterContributionRegex())
+	debugCommand.AddCommand(enterForgejoToken())
 	debugCommand.AddCommand(enterHostingPlatform())
 	debugCommand.AddCommand(enterGiteaToken())
 	debugCommand.AddCommand(enterGitHubToken())
 	debugCommand.AddCommand(enterGitLabToken())
 	debugCommand.AddCommand(enterMainBranchCmd())
 	debugCommand.AddCommand(enterParentCmd())
+	debugCommand.AddCommand(enterObservedRegex())
 	debugCommand.AddCommand(enterOriginHostname())
 	debugCommand.AddCommand(enterPerennialBranches())
 	debugCommand.AddCommand(enterPerennialRegex())
@@ -26,6 +33,7 @@ func RootCmd() *cobra.Command {
 	debugCommand.AddCommand(enterPushHookCmd())
 	debugCommand.AddCommand(enterPushNewBranches())
 	debugCommand.AddCommand(enterShipDeleteTrackingBranch())
+	debugCommand.AddCommand(enterShipStrategy())
 	debugCommand.AddCommand(enterSyncBeforeShip())
 	debugCommand.AddCommand(selectCommitAuthorCmd())
 	debugCommand.AddCommand(switchBranch())
@@ -10,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/spf13/cobra"
 )
 
@@ -31,7 +32,7 @@ func switchBranch() *cobra.Command {
 			}
 			lineage := configdomain.Lineage{}
 			dialogTestInputs := components.LoadTestInputs(os.Environ())
-			_, _, err = dialog.SwitchBranch(localBranches, gitdomain.NewLocalBranchName("branch-2"), lineage, branchInfos, true, dialogTestInputs.Next())
+			_, _, err = dialog.SwitchBranch(localBranches, gitdomain.NewLocalBranchName("branch-2"), lineage, branchInfos, gitdomain.Worktrees{}, gitdomain.RemoteOrigin, map[gitdomain.LocalBranchName]hostingdomain.Proposal{}, true, dialogTestInputs.Next())
 			return err
 		},
 	}
@@ -81,6 +81,7 @@ func determineDiffParentData(args []string, repo execute.OpenRepoResult, verbose
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const downDesc = "Switch to the parent branch of the current branch"
+
+func downCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "down",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   downDesc,
+		Long:    cmdhelpers.Long(downDesc),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeDown(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeDown(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineNavigateData(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	parent, hasParent := data.lineage.Parent(data.initialBranch).Get()
+	if !hasParent {
+		return fmt.Errorf(messages.NavigateNoParent, data.initialBranch)
+	}
+	return navigateTo(repo, data, gitdomain.LocalBranchNames{parent})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/commandconfig"
@@ -20,6 +21,7 @@ import (
 	"github.com/git-town/git-town/v14/src/gohacks/stringslice"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/sync"
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
 	"github.com/git-town/git-town/v14/src/validate"
 	configInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/config"
@@ -38,6 +40,7 @@ See "sync" for information regarding upstream remotes.`
 func hackCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	cmd := cobra.Command{
 		Use:     "hack <branch>",
 		GroupID: "basic",
@@ -45,15 +48,20 @@ func hackCmd() *cobra.Command {
 		Short:   hackDesc,
 		Long:    cmdhelpers.Long(hackDesc, hackHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeHack(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeHack(args, readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeHack(args []string, dryRun, verbose bool) error {
+func executeHack(args []string, dryRun bool, plan Option[report.Format], verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -82,6 +90,7 @@ func executeHack(args []string, dryRun, verbose bool) error {
 			finalMessages:         repo.FinalMessages,
 			frontend:              repo.Frontend,
 			git:                   repo.Git,
+			plan:                  plan,
 			rootDir:               repo.RootDir,
 			verbose:               verbose,
 		})
@@ -103,13 +112,22 @@ func executeHack(args []string, dryRun, verbose bool) error {
 // If set to makeFeatureData, the user wants to make an existing branch a feature branch.
 type hackData = Either[appendData, makeFeatureData]
 
-// this configuration is for when "git hack" is used to make contribution, observed, or parked branches feature branches
+// this configuration is for when "git hack" is used to make contribution, observed, parked, or prototype branches feature branches
 type makeFeatureData struct {
 	config         config.ValidatedConfig
 	targetBranches commandconfig.BranchesAndTypes
 }
 
 func createBranch(args createBranchArgs) error {
+	runProgram := appendProgram(args.appendData)
+	sync.AddHook(sync.AddHookArgs{
+		Branch:  args.appendData.targetBranch,
+		Command: args.appendData.command,
+		Hook:    configdomain.HookPostHack,
+		Hooks:   args.appendData.config.Config.Hooks,
+		Parent:  Some(args.appendData.parentBranch),
+		Program: &runProgram,
+	})
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: args.beginBranchesSnapshot,
 		BeginConfigSnapshot:   args.beginConfigSnapshot,
@@ -119,7 +137,7 @@ func createBranch(args createBranchArgs) error {
 		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
 		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
 		EndStashSize:          None[gitdomain.StashSize](),
-		RunProgram:            appendProgram(args.appendData),
+		RunProgram:            runProgram,
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
 		Backend:                 args.backend,
@@ -135,6 +153,7 @@ func createBranch(args createBranchArgs) error {
 		InitialBranchesSnapshot: args.beginBranchesSnapshot,
 		InitialConfigSnapshot:   args.beginConfigSnapshot,
 		InitialStashSize:        args.beginStashSize,
+		Plan:                    args.plan,
 		RootDir:                 args.rootDir,
 		RunState:                runState,
 		Verbose:                 args.verbose,
@@ -152,6 +171,7 @@ type createBranchArgs struct {
 	finalMessages         stringslice.Collector
 	frontend              gitdomain.Runner
 	git                   git.Commands
+	plan                  Option[report.Format]
 	rootDir               gitdomain.RepoRootDir
 	verbose               bool
 }
@@ -170,6 +190,7 @@ func determineHackData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 len(args) == 1 && !repoStatus.OpenChanges,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -238,7 +259,7 @@ func determineHackData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 		err = fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
 		return
 	}
-	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch) {
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch, repo.UnvalidatedConfig.Config.TrackingRemote(targetBranch)) {
 		err = fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
 		return
 	}
@@ -249,6 +270,7 @@ func determineHackData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 		allBranches:               branchesSnapshot.Branches,
 		branchesSnapshot:          branchesSnapshot,
 		branchesToSync:            branchesToSync,
+		command:                   "hack",
 		config:                    validatedConfig,
 		dialogTestInputs:          dialogTestInputs,
 		dryRun:                    dryRun,
@@ -277,6 +299,8 @@ func makeFeatureBranch(args makeFeatureBranchArgs) error {
 			err = args.config.RemoveFromObservedBranches(branchName)
 		case configdomain.BranchTypeParkedBranch:
 			err = args.config.RemoveFromParkedBranches(branchName)
+		case configdomain.BranchTypePrototypeBranch:
+			err = args.config.RemoveFromPrototypeBranches(branchName)
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 			panic(fmt.Sprintf("unchecked branch type: %s", branchType))
 		}
@@ -309,7 +333,7 @@ type makeFeatureBranchArgs struct {
 func validateMakeFeatureData(data makeFeatureData) error {
 	for branchName, branchType := range data.targetBranches {
 		switch branchType {
-		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 			return nil
 		case configdomain.BranchTypeFeatureBranch:
 			return fmt.Errorf(messages.HackBranchIsAlreadyFeature, branchName)
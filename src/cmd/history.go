@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/gohacks/slice"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const historyDesc = "Display the Git Town commands that can be undone"
+
+const historyHelp = `
+Lists the most recent Git Town commands in this repository, newest first,
+together with the changes they made to branches and configuration.
+"git town undo --steps <n>" undoes the <n> topmost commands.`
+
+func historyCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "history",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   historyDesc,
+		Long:    cmdhelpers.Long(historyDesc, historyHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeHistory(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeHistory(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	undoable, err := statefile.Undoable(repo.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	if len(undoable) == 0 {
+		fmt.Println(messages.UndoNothingToDo)
+		return nil
+	}
+	for e, entry := range undoable {
+		fmt.Print(historyEntryHeader(e+1, entry))
+		for _, change := range historyBranchChanges(entry.RunState) {
+			fmt.Println("   " + change)
+		}
+		for _, change := range historyConfigChanges(entry.RunState) {
+			fmt.Println("   " + change)
+		}
+	}
+	print.Footer(verbose, repo.CommandsCounter.Count(), print.NoFinalMessages)
+	return nil
+}
+
+// describes the changes that the given runstate made to the branches
+func historyBranchChanges(runState runstate.RunState) []string {
+	endBranchesSnapshot, hasEndBranchesSnapshot := runState.EndBranchesSnapshot.Get()
+	if !hasEndBranchesSnapshot {
+		return []string{}
+	}
+	before := historyBranchSHAs(runState.BeginBranchesSnapshot)
+	after := historyBranchSHAs(endBranchesSnapshot)
+	result := []string{}
+	for _, branch := range historySortedKeys(before, after) {
+		beforeSHA, hasBefore := before[branch]
+		afterSHA, hasAfter := after[branch]
+		switch {
+		case !hasBefore:
+			result = append(result, fmt.Sprintf(messages.HistoryBranchCreated, branch))
+		case !hasAfter:
+			result = append(result, fmt.Sprintf(messages.HistoryBranchDeleted, branch))
+		case beforeSHA != afterSHA:
+			result = append(result, fmt.Sprintf(messages.HistoryBranchUpdated, branch, beforeSHA.TruncateTo(7), afterSHA.TruncateTo(7)))
+		}
+	}
+	return result
+}
+
+// provides the SHAs of all local and remote branches in the given snapshot
+func historyBranchSHAs(snapshot gitdomain.BranchesSnapshot) map[string]gitdomain.SHA {
+	result := map[string]gitdomain.SHA{}
+	for _, branch := range snapshot.Branches {
+		if hasLocal, name, sha := branch.HasLocalBranch(); hasLocal {
+			result[name.String()] = sha
+		}
+		if hasRemote, name, sha := branch.HasRemoteBranch(); hasRemote {
+			result[name.String()] = sha
+		}
+	}
+	return result
+}
+
+// describes the changes that the given runstate made to the Git configuration
+func historyConfigChanges(runState runstate.RunState) []string {
+	endConfigSnapshot, hasEndConfigSnapshot := runState.EndConfigSnapshot.Get()
+	if !hasEndConfigSnapshot {
+		return []string{}
+	}
+	diffs := undoconfig.NewConfigDiffs(runState.BeginConfigSnapshot, endConfigSnapshot)
+	return append(historyConfigDiff("global", diffs.Global), historyConfigDiff("local", diffs.Local)...)
+}
+
+func historyConfigDiff(scope string, diff undoconfig.ConfigDiff) []string {
+	result := []string{}
+	for _, key := range diff.Added {
+		result = append(result, fmt.Sprintf(messages.HistoryConfigAdded, scope, key))
+	}
+	for key := range diff.Changed {
+		result = append(result, fmt.Sprintf(messages.HistoryConfigChanged, scope, key, diff.Changed[key].Before, diff.Changed[key].After))
+	}
+	for key := range diff.Removed {
+		result = append(result, fmt.Sprintf(messages.HistoryConfigRemoved, scope, key))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func historyEntryHeader(number int, entry runstate.HistoryEntry) string {
+	switch {
+	case entry.RunState.DryRun:
+		return fmt.Sprintf(messages.HistoryEntryDryRun, number, entry.RunState.Command)
+	case !entry.RunState.IsFinished():
+		return fmt.Sprintf(messages.HistoryEntryUnfinished, number, entry.RunState.Command, entry.EndTime.Format(time.DateTime))
+	case entry.EndTime.IsZero():
+		return fmt.Sprintf(messages.HistoryEntryUnknownTime, number, entry.RunState.Command)
+	}
+	return fmt.Sprintf(messages.HistoryEntry, number, entry.RunState.Command, entry.EndTime.Format(time.DateTime))
+}
+
+// provides the keys of the given maps, sorted alphabetically
+func historySortedKeys(maps ...map[string]gitdomain.SHA) []string {
+	result := []string{}
+	for _, aMap := range maps {
+		for key := range aMap {
+			if !slice.Contains(result, key) {
+				result = append(result, key)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
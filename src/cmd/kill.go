@@ -7,6 +7,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -34,21 +35,27 @@ Deletes the current or provided branch from the local and origin repositories. D
 func killCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	cmd := cobra.Command{
 		Use:   "kill [<branch>]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: killDesc,
 		Long:  cmdhelpers.Long(killDesc, killHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeKill(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeKill(args, readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeKill(args []string, dryRun, verbose bool) error {
+func executeKill(args []string, dryRun bool, plan Option[report.Format], verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -95,6 +102,7 @@ func executeKill(args []string, dryRun, verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -127,6 +135,7 @@ func determineKillData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 true,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -147,8 +156,8 @@ func determineKillData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	if !hasBranchToKill {
 		return nil, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
 	}
-	if branchToKill.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return nil, exit, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill)
+	if worktree, inOtherWorktree := branchesSnapshot.Worktrees.ForBranch(branchNameToKill).Get(); inOtherWorktree {
+		return nil, exit, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill, worktree.Path)
 	}
 	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
 	branchesToKill := gitdomain.LocalBranchNames{branchNameToKill}
@@ -169,6 +178,10 @@ func determineKillData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	}
 	branchTypeToKill := validatedConfig.Config.BranchType(branchNameToKill)
 	previousBranch, hasPreviousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend).Get()
+	if hasPreviousBranch && branchesSnapshot.Worktrees.ForBranch(previousBranch).IsSome() {
+		// Git Town cannot check out branches that are active in another worktree
+		previousBranch, hasPreviousBranch = "", false
+	}
 	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
 	if !hasInitialBranch {
 		return nil, exit, errors.New(messages.CurrentBranchCannotDetermine)
@@ -183,6 +196,9 @@ func determineKillData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	} else {
 		branchWhenDone = initialBranch
 	}
+	if worktree, inOtherWorktree := branchesSnapshot.Worktrees.ForBranch(branchWhenDone).Get(); inOtherWorktree {
+		return nil, false, fmt.Errorf(messages.KillBranchWhenDoneOtherWorktree, branchWhenDone, worktree.Path)
+	}
 	localBranchToKill, hasLocalBranchToKill := branchToKill.LocalName.Get()
 	var parentBranch Option[gitdomain.LocalBranchName]
 	if hasLocalBranchToKill {
@@ -209,7 +225,7 @@ func determineKillData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 func killProgram(data *killData) (runProgram, finalUndoProgram program.Program) {
 	prog := program.Program{}
 	switch data.branchToKillType {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		killFeatureBranch(&prog, &finalUndoProgram, data)
 	case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
 		killLocalBranch(&prog, &finalUndoProgram, data)
@@ -263,7 +279,7 @@ func killLocalBranch(prog, finalUndoProgram *program.Program, data *killData) {
 
 func validateKillData(data *killData) error {
 	switch data.branchToKillType {
-	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.KillCannotKillMainBranch)
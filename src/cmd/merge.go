@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/sync"
+	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	"github.com/git-town/git-town/v14/src/validate"
+	fullInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const mergeCommand = "merge"
+
+const mergeDesc = "Merge the current branch into its parent branch"
+
+const mergeHelp = `
+Collapses the current branch into its parent branch in a stack:
+
+- merges the current branch into its parent branch
+- pushes the parent branch to the origin repository
+- makes the children of the current branch children of the parent branch
+- deletes the current branch from the local and origin repositories
+
+If you use a code hosting platform with API access, this command also updates the proposals of the child branches to target the parent branch and closes the proposal of the current branch.
+
+Both branches must be in sync with their tracking branches. Does not merge into the main branch or perennial branches, use "git town ship" for that.`
+
+func mergeCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
+	cmd := cobra.Command{
+		Use:     mergeCommand,
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   mergeDesc,
+		Long:    cmdhelpers.Long(mergeDesc, mergeHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeMerge(readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeMerge(dryRun bool, plan Option[report.Format], verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineMergeData(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	err = validateMergeData(data)
+	if err != nil {
+		return err
+	}
+	runProgram, finalUndoProgram := mergeProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               mergeCommand,
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		FinalUndoProgram:      finalUndoProgram,
+		RunProgram:            runProgram,
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               data.connector,
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type mergeData struct {
+	branchesSnapshot         gitdomain.BranchesSnapshot
+	config                   config.ValidatedConfig
+	connector                Option[hostingdomain.Connector]
+	dialogTestInputs         components.TestInputs
+	dryRun                   bool
+	hasOpenChanges           bool
+	initialBranch            gitdomain.LocalBranchName
+	initialBranchInfo        gitdomain.BranchInfo
+	parentBranch             gitdomain.LocalBranchName
+	parentBranchInfo         gitdomain.BranchInfo
+	previousBranch           Option[gitdomain.LocalBranchName]
+	proposal                 Option[hostingdomain.Proposal]
+	proposalsOfChildBranches []hostingdomain.Proposal
+	stashSize                gitdomain.StashSize
+}
+
+func determineMergeData(repo execute.OpenRepoResult, dryRun, verbose bool) (*mergeData, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return nil, false, err
+	}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return nil, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	initialBranchInfo, hasInitialBranchInfo := branchesSnapshot.Branches.FindByLocalName(initialBranch).Get()
+	if !hasInitialBranchInfo {
+		return nil, false, fmt.Errorf(messages.BranchDoesntExist, initialBranch)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	if err = validateMergeBranchType(messages.MergeBranchWrongType, initialBranch, validatedConfig.Config.BranchType(initialBranch)); err != nil {
+		return nil, false, err
+	}
+	parentBranch, hasParentBranch := validatedConfig.Config.Lineage.Parent(initialBranch).Get()
+	if !hasParentBranch {
+		return nil, false, fmt.Errorf(messages.MergeNoParent, initialBranch)
+	}
+	parentBranchInfo, hasParentBranchInfo := branchesSnapshot.Branches.FindByLocalName(parentBranch).Get()
+	if !hasParentBranchInfo {
+		return nil, false, fmt.Errorf(messages.BranchDoesntExist, parentBranch)
+	}
+	if err = validateMergeBranchType(messages.MergeParentWrongType, parentBranch, validatedConfig.Config.BranchType(parentBranch)); err != nil {
+		return nil, false, err
+	}
+	childBranches := validatedConfig.Config.Lineage.Children(initialBranch)
+	proposalOpt := None[hostingdomain.Proposal]()
+	proposalsOfChildBranches := []hostingdomain.Proposal{}
+	var connectorOpt Option[hostingdomain.Connector]
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
+		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			HostingPlatform: validatedConfig.Config.HostingPlatform,
+			Log:             print.Logger{},
+			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if connector, hasConnector := connectorOpt.Get(); hasConnector && !repo.IsOffline.Bool() {
+		if initialBranchInfo.HasTrackingBranch() {
+			proposalOpt, err = connector.FindProposal(initialBranch, parentBranch)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		for _, childBranch := range childBranches {
+			childProposalOpt, err := connector.FindProposal(childBranch, initialBranch)
+			if err != nil {
+				return nil, false, fmt.Errorf(messages.ProposalNotFoundForBranch, childBranch, err)
+			}
+			if childProposal, hasChildProposal := childProposalOpt.Get(); hasChildProposal {
+				proposalsOfChildBranches = append(proposalsOfChildBranches, childProposal)
+			}
+		}
+	}
+	return &mergeData{
+		branchesSnapshot:         branchesSnapshot,
+		config:                   validatedConfig,
+		connector:                connectorOpt,
+		dialogTestInputs:         dialogTestInputs,
+		dryRun:                   dryRun,
+		hasOpenChanges:           repoStatus.OpenChanges,
+		initialBranch:            initialBranch,
+		initialBranchInfo:        initialBranchInfo,
+		parentBranch:             parentBranch,
+		parentBranchInfo:         parentBranchInfo,
+		previousBranch:           previousBranch,
+		proposal:                 proposalOpt,
+		proposalsOfChildBranches: proposalsOfChildBranches,
+		stashSize:                stashSize,
+	}, false, nil
+}
+
+func mergeProgram(data *mergeData) (runProgram, finalUndoProgram program.Program) {
+	prog := program.Program{}
+	prog.Add(&opcodes.Checkout{Branch: data.parentBranch})
+	prog.Add(&opcodes.Merge{Branch: data.initialBranch.BranchName()})
+	if data.parentBranchInfo.HasTrackingBranch() && data.config.Config.IsOnline() {
+		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: data.parentBranch})
+	}
+	for _, childProposal := range data.proposalsOfChildBranches {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      data.parentBranch,
+			ProposalNumber: childProposal.Number,
+		})
+		// undo retargets the proposals of the child branches back to the restored branch
+		finalUndoProgram.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      data.initialBranch,
+			ProposalNumber: childProposal.Number,
+		})
+	}
+	if proposal, hasProposal := data.proposal.Get(); hasProposal {
+		prog.Add(&opcodes.CloseProposal{ProposalNumber: proposal.Number})
+		// undo reopens the proposal after it has restored the branch
+		finalUndoProgram.Add(&opcodes.ReopenProposal{ProposalNumber: proposal.Number})
+	}
+	if trackingBranch, hasTrackingBranch := data.initialBranchInfo.RemoteName.Get(); hasTrackingBranch && data.initialBranchInfo.HasTrackingBranch() && data.config.Config.IsOnline() {
+		prog.Add(&opcodes.DeleteTrackingBranch{Branch: trackingBranch})
+	}
+	prog.Add(&opcodes.DeleteLocalBranch{Branch: data.initialBranch})
+	if !data.dryRun {
+		sync.RemoveBranchFromLineage(sync.RemoveBranchFromLineageArgs{
+			Branch:  data.initialBranch,
+			Lineage: data.config.Config.Lineage,
+			Parent:  data.parentBranch,
+			Program: &prog,
+		})
+	}
+	previousBranchCandidates := gitdomain.LocalBranchNames{}
+	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch && previousBranch != data.initialBranch {
+		previousBranchCandidates = append(previousBranchCandidates, previousBranch)
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return prog, finalUndoProgram
+}
+
+// validateMergeBranchType ensures that the given branch is a branch that Git Town owns,
+// i.e. a branch whose commits can be merged into another branch and that can be deleted afterwards.
+func validateMergeBranchType(errorTemplate string, branch gitdomain.LocalBranchName, branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		return nil
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+		return fmt.Errorf(errorTemplate, branch, branchType)
+	}
+	panic(fmt.Sprintf("unhandled branch type: %s", branchType))
+}
+
+func validateMergeData(data *mergeData) error {
+	for _, branchInfo := range []gitdomain.BranchInfo{data.initialBranchInfo, data.parentBranchInfo} {
+		localName := branchInfo.LocalName.GetOrDefault()
+		switch branchInfo.SyncStatus {
+		case gitdomain.SyncStatusNotInSync:
+			return fmt.Errorf(messages.MergeBranchNotInSync, localName)
+		case gitdomain.SyncStatusOtherWorktree:
+			return fmt.Errorf(messages.MergeBranchOtherWorktree, localName)
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusRemoteOnly, gitdomain.SyncStatusUpToDate:
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/validate"
+)
+
+// navigateData contains the data that the "up", "down", "top", and "bottom" commands need
+// to move through the branches of a stack.
+type navigateData struct {
+	allBranches        gitdomain.BranchInfos
+	dialogInputs       components.TestInputs
+	initialBranch      gitdomain.LocalBranchName
+	lineage            configdomain.Lineage
+	pushRemote         gitdomain.Remote
+	uncommittedChanges bool
+	worktrees          gitdomain.Worktrees
+}
+
+func emptyNavigateData() navigateData {
+	return navigateData{} //exhaustruct:ignore
+}
+
+func determineNavigateData(repo execute.OpenRepoResult, verbose bool) (navigateData, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyNavigateData(), false, err
+	}
+	branchesSnapshot, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return emptyNavigateData(), exit, err
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyNavigateData(), false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      branchesSnapshot.Branches.LocalBranches().Names(),
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return emptyNavigateData(), exit, err
+	}
+	return navigateData{
+		allBranches:        branchesSnapshot.Branches,
+		dialogInputs:       dialogTestInputs,
+		initialBranch:      initialBranch,
+		lineage:            validatedConfig.Config.Lineage,
+		pushRemote:         validatedConfig.Config.PushRemote,
+		uncommittedChanges: repoStatus.UntrackedChanges,
+		worktrees:          branchesSnapshot.Worktrees,
+	}, false, nil
+}
+
+// existingBranches provides the given branches that exist locally or at the tracking remote.
+func (self navigateData) existingBranches(branches gitdomain.LocalBranchNames) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branches {
+		if self.allBranches.HasLocalBranch(branch) || self.allBranches.HasMatchingTrackingBranchFor(branch, self.pushRemote) {
+			result = append(result, branch)
+		}
+	}
+	return result
+}
+
+// navigateTo checks out the given branch.
+// If there are several candidates, it asks the user which one to check out.
+func navigateTo(repo execute.OpenRepoResult, data navigateData, candidates gitdomain.LocalBranchNames) error {
+	branchToCheckout := candidates[0]
+	if len(candidates) > 1 {
+		var exit bool
+		var err error
+		branchToCheckout, exit, err = dialog.SwitchBranch(candidates, data.initialBranch, configdomain.NewLineage(), data.allBranches, data.worktrees, data.pushRemote, map[gitdomain.LocalBranchName]hostingdomain.Proposal{}, data.uncommittedChanges, data.dialogInputs.Next())
+		if err != nil || exit {
+			return err
+		}
+	}
+	if branchToCheckout == data.initialBranch {
+		return nil
+	}
+	if worktree, inOtherWorktree := data.worktrees.ForBranch(branchToCheckout).Get(); inOtherWorktree {
+		return fmt.Errorf(messages.BranchOtherWorktree, branchToCheckout, worktree.Path)
+	}
+	return repo.Git.CheckoutBranch(repo.Frontend, branchToCheckout, false)
+}
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config/gitconfig"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/spf13/cobra"
 )
@@ -23,7 +25,14 @@ func newPullRequestCommand() *cobra.Command {
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			printDeprecationNotice()
-			result := executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			args := proposeArgs{
+				body:      None[string](),
+				draft:     false,
+				noBrowser: false,
+				stack:     false,
+				title:     None[string](),
+			}
+			result := executePropose(args, readDryRunFlag(cmd), None[report.Format](), readVerboseFlag(cmd))
 			printDeprecationNotice()
 			return result
 		},
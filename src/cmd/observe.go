@@ -118,6 +118,10 @@ func removeNonObserveBranchTypes(branches map[gitdomain.LocalBranchName]configdo
 			if err := config.RemoveFromParkedBranches(branchName); err != nil {
 				return err
 			}
+		case configdomain.BranchTypePrototypeBranch:
+			if err := config.RemoveFromPrototypeBranches(branchName); err != nil {
+				return err
+			}
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 	}
@@ -141,7 +145,7 @@ func determineObserveData(args []string, repo execute.OpenRepoResult) (observeDa
 	case 1:
 		branch := gitdomain.NewLocalBranchName(args[0])
 		branchesToObserve.Add(branch, *repo.UnvalidatedConfig.Config)
-		branchInfo := branchesSnapshot.Branches.FindByRemoteName(branch.TrackingBranch())
+		branchInfo := branchesSnapshot.Branches.FindByRemoteName(branch.TrackingBranch(gitdomain.RemoteOrigin))
 		if branchInfo.SyncStatus == gitdomain.SyncStatusRemoteOnly {
 			checkout = Some(branch)
 		}
@@ -157,7 +161,7 @@ func determineObserveData(args []string, repo execute.OpenRepoResult) (observeDa
 
 func validateObserveData(data observeData) error {
 	for branchName, branchType := range data.branchesToObserve {
-		if !data.allBranches.HasLocalBranch(branchName) && !data.allBranches.HasMatchingTrackingBranchFor(branchName) {
+		if !data.allBranches.HasLocalBranch(branchName) && !data.allBranches.HasMatchingTrackingBranchFor(branchName, gitdomain.RemoteOrigin) {
 			return fmt.Errorf(messages.BranchDoesntExist, branchName)
 		}
 		switch branchType {
@@ -167,7 +171,7 @@ func validateObserveData(data observeData) error {
 			return errors.New(messages.PerennialBranchCannotObserve)
 		case configdomain.BranchTypeObservedBranch:
 			return fmt.Errorf(messages.BranchIsAlreadyObserved, branchName)
-		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		}
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/execute"
@@ -35,6 +36,7 @@ See "sync" for upstream remote options.`
 func prependCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	cmd := cobra.Command{
 		Use:     "prepend <branch>",
 		GroupID: "lineage",
@@ -42,15 +44,20 @@ func prependCommand() *cobra.Command {
 		Short:   prependDesc,
 		Long:    cmdhelpers.Long(prependDesc, prependHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePrepend(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executePrepend(args, readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePrepend(args []string, dryRun, verbose bool) error {
+func executePrepend(args []string, dryRun bool, plan Option[report.Format], verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -91,6 +98,7 @@ func executePrepend(args []string, dryRun, verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -130,6 +138,7 @@ func determinePrependData(args []string, repo execute.OpenRepoResult, dryRun, ve
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 !repoStatus.OpenChanges,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -151,7 +160,7 @@ func determinePrependData(args []string, repo execute.OpenRepoResult, dryRun, ve
 	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
 		return emptyPrependData(), false, fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
 	}
-	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch) {
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch, repo.UnvalidatedConfig.Config.TrackingRemote(targetBranch)) {
 		return emptyPrependData(), false, fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
 	}
 	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
@@ -204,12 +213,15 @@ func prependProgram(data prependData) program.Program {
 	prog := program.Program{}
 	for _, branchToSync := range data.branchesToSync {
 		sync.BranchProgram(branchToSync, sync.BranchProgramArgs{
-			BranchInfos:   data.allBranches,
-			Config:        data.config.Config,
-			InitialBranch: data.initialBranch,
-			Program:       &prog,
-			PushBranch:    true,
-			Remotes:       data.remotes,
+			BranchInfos:        data.allBranches,
+			Command:            "prepend",
+			Config:             data.config.Config,
+			InitialBranch:      data.initialBranch,
+			Program:            &prog,
+			PushBranch:         true,
+			QueueSkippedPushes: false,
+			Remotes:            data.remotes,
+			Worktrees:          data.branchesSnapshot.Worktrees,
 		})
 	}
 	prog.Add(&opcodes.CreateAndCheckoutBranchExistingParent{
@@ -226,7 +238,7 @@ func prependProgram(data prependData) program.Program {
 		Branch: data.initialBranch,
 		Parent: data.targetBranch,
 	})
-	if data.remotes.HasOrigin() && data.config.Config.ShouldPushNewBranches() && data.config.Config.IsOnline() {
+	if data.remotes.HasRemote(data.config.Config.PushRemote) && data.config.Config.ShouldPushNewBranches() && data.config.Config.IsOnline() {
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: data.targetBranch})
 	}
 	previousBranchCandidates := gitdomain.LocalBranchNames{}
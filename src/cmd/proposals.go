@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const proposalsDesc = "Display the open proposals of all local branches"
+
+const proposalsHelp = `
+Lists the open proposals of your local branches in the order of the branch lineage,
+together with their review state, CI state, and URL.
+
+Supported for repositories hosted on GitHub, GitLab, Gitea, Forgejo, Bitbucket, and Azure DevOps.`
+
+func proposalsCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "proposals",
+		GroupID: "basic",
+		Args:    cobra.NoArgs,
+		Short:   proposalsDesc,
+		Long:    cmdhelpers.Long(proposalsDesc, proposalsHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeProposals(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeProposals(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := determineProposalsData(repo)
+	if err != nil {
+		return err
+	}
+	proposals, err := data.connector.FindProposals(data.localBranches)
+	if err != nil {
+		return fmt.Errorf(messages.ProposalsLoadProblem, err)
+	}
+	printProposals(data.localBranches, data.lineage, data.allBranches, data.pushRemote, proposals)
+	print.Footer(verbose, repo.CommandsCounter.Count(), repo.FinalMessages.Result())
+	return nil
+}
+
+type proposalsData struct {
+	allBranches   gitdomain.BranchInfos
+	connector     hostingdomain.Connector
+	lineage       configdomain.Lineage
+	localBranches gitdomain.LocalBranchNames
+	pushRemote    gitdomain.Remote
+}
+
+func emptyProposalsData() proposalsData {
+	return proposalsData{} //exhaustruct:ignore
+}
+
+func determineProposalsData(repo execute.OpenRepoResult) (proposalsData, error) {
+	var err error
+	var connectorOpt Option[hostingdomain.Connector]
+	if originURL, hasOriginURL := repo.UnvalidatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
+		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Config:          *repo.UnvalidatedConfig.Config,
+			HostingPlatform: repo.UnvalidatedConfig.Config.HostingPlatform,
+			Log:             print.Logger{},
+			OriginURL:       originURL,
+			PushURL:         repo.UnvalidatedConfig.PushRemoteURL(),
+		})
+		if err != nil {
+			return emptyProposalsData(), err
+		}
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return emptyProposalsData(), hostingdomain.UnsupportedServiceError()
+	}
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return emptyProposalsData(), err
+	}
+	return proposalsData{
+		allBranches:   branchesSnapshot.Branches,
+		connector:     connector,
+		lineage:       repo.UnvalidatedConfig.Config.Lineage,
+		localBranches: branchesSnapshot.Branches.LocalBranches().Names(),
+		pushRemote:    repo.UnvalidatedConfig.Config.PushRemote,
+	}, nil
+}
+
+// printProposals prints the given proposals in the order of the given lineage.
+func printProposals(localBranches gitdomain.LocalBranchNames, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos, pushRemote gitdomain.Remote, proposals map[gitdomain.LocalBranchName]hostingdomain.Proposal) {
+	if len(proposals) == 0 {
+		fmt.Println(messages.ProposalsNone)
+		return
+	}
+	for _, entry := range dialog.SwitchBranchEntries(localBranches, lineage, allBranches, gitdomain.Worktrees{}, pushRemote, proposals) {
+		proposal, hasProposal := entry.Proposal.Get()
+		if !hasProposal {
+			continue
+		}
+		fmt.Printf("%s%s  %s  %s\n", entry.Indentation, entry.Branch, proposal.Summary(), proposal.URL)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
 	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -15,6 +16,7 @@ import (
 	"github.com/git-town/git-town/v14/src/execute"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/gohacks/slice"
 	"github.com/git-town/git-town/v14/src/hosting"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
@@ -37,11 +39,21 @@ Syncs the current branch and opens a browser window to the new proposal page of
 
 The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
 
-Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
+When called with --title, --body, --draft, or --no-browser, creates the proposal via the API of your hosting platform instead and prints its URL. Unless --no-browser is given, it then opens the created proposal in the browser.
+
+The "--stack" flag syncs all branches in the stack of the current branch and creates a proposal for each of them that doesn't have one yet. Each proposal targets the parent of its branch. If an API token for your hosting platform is configured, this creates the proposals via the API.
+
+Supported only for repositories hosted on GitHub, GitLab, Gitea, Forgejo, Bitbucket, and Azure DevOps. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", "forgejo", "bitbucket", or "azure-devops". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
+	addTitleFlag, readTitleFlag := flags.String("title", "t", "Create the proposal via the API with the given title", flags.FlagTypeNonPersistent)
+	addBodyFlag, readBodyFlag := flags.String("body", "b", "Create the proposal via the API with the given body", flags.FlagTypeNonPersistent)
+	addDraftFlag, readDraftFlag := flags.Bool("draft", "", "Create the proposal via the API as a draft", flags.FlagTypeNonPersistent)
+	addNoBrowserFlag, readNoBrowserFlag := flags.Bool("no-browser", "", "Create the proposal via the API without opening a browser", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Propose all branches in the stack that the current branch belongs to", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     proposeCmd,
 		GroupID: "basic",
@@ -49,15 +61,43 @@ func proposeCommand() *cobra.Command {
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executePropose(proposeArgs{
+				body:      readBodyFlag(cmd),
+				draft:     readDraftFlag(cmd),
+				noBrowser: readNoBrowserFlag(cmd),
+				stack:     readStackFlag(cmd),
+				title:     readTitleFlag(cmd),
+			}, readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
+	addBodyFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addNoBrowserFlag(&cmd)
+	addPlanFlag(&cmd)
+	addStackFlag(&cmd)
+	addTitleFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(dryRun, verbose bool) error {
+// proposeArgs contains the details of the proposals to create.
+type proposeArgs struct {
+	body      Option[string]
+	draft     bool
+	noBrowser bool
+	stack     bool
+	title     Option[string]
+}
+
+func executePropose(args proposeArgs, dryRun bool, plan Option[report.Format], verbose bool) error {
+	if args.stack && (args.title.IsSome() || args.body.IsSome()) {
+		return errors.New(messages.ProposeStackTitleOrBody)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -69,7 +109,7 @@ func executePropose(dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineProposeData(repo, dryRun, verbose)
+	data, exit, err := determineProposeData(args, repo, dryRun, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -101,6 +141,7 @@ func executePropose(dryRun, verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -108,25 +149,28 @@ func executePropose(dryRun, verbose bool) error {
 }
 
 type proposeData struct {
-	allBranches      gitdomain.BranchInfos
-	branchesSnapshot gitdomain.BranchesSnapshot
-	branchesToSync   gitdomain.BranchInfos
-	config           config.ValidatedConfig
-	connector        Option[hostingdomain.Connector]
-	dialogTestInputs components.TestInputs
-	dryRun           bool
-	hasOpenChanges   bool
-	initialBranch    gitdomain.LocalBranchName
-	previousBranch   Option[gitdomain.LocalBranchName]
-	remotes          gitdomain.Remotes
-	stashSize        gitdomain.StashSize
+	allBranches       gitdomain.BranchInfos
+	branchesSnapshot  gitdomain.BranchesSnapshot
+	branchesToPropose gitdomain.LocalBranchNames
+	branchesToSync    gitdomain.BranchInfos
+	config            config.ValidatedConfig
+	connector         Option[hostingdomain.Connector]
+	dialogTestInputs  components.TestInputs
+	dryRun            bool
+	hasOpenChanges    bool
+	initialBranch     gitdomain.LocalBranchName
+	previousBranch    Option[gitdomain.LocalBranchName]
+	proposeArgs       proposeArgs
+	remotes           gitdomain.Remotes
+	stashSize         gitdomain.StashSize
+	viaAPI            bool
 }
 
 func emptyProposeData() proposeData {
 	return proposeData{} //exhaustruct:ignore
 }
 
-func determineProposeData(repo execute.OpenRepoResult, dryRun, verbose bool) (proposeData, bool, error) {
+func determineProposeData(args proposeArgs, repo execute.OpenRepoResult, dryRun, verbose bool) (proposeData, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
 	if err != nil {
@@ -137,6 +181,7 @@ func determineProposeData(repo execute.OpenRepoResult, dryRun, verbose bool) (pr
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 true,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -178,50 +223,84 @@ func determineProposeData(repo execute.OpenRepoResult, dryRun, verbose bool) (pr
 		return emptyProposeData(), exit, err
 	}
 	var connector Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	viaAPI := false
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
 		})
 		if err != nil {
 			return emptyProposeData(), false, err
 		}
+		if platform, hasPlatform := hosting.Detect(originURL, validatedConfig.Config.HostingPlatform).Get(); hasPlatform && args.stack {
+			viaAPI = hosting.HasAPIToken(*validatedConfig.Config.UnvalidatedConfig, platform)
+		}
 	}
 	if connector.IsNone() {
 		return emptyProposeData(), false, hostingdomain.UnsupportedServiceError()
 	}
+	branchesToPropose := gitdomain.LocalBranchNames{initialBranch}
 	branchNamesToSync := validatedConfig.Config.Lineage.BranchAndAncestors(initialBranch)
+	if args.stack {
+		// the lineage can contain branches that were deleted without Git Town
+		stack := validatedConfig.Config.Lineage.BranchLineage(initialBranch).KeepOnly(branchesSnapshot.Branches.LocalBranches().Names())
+		branchesToPropose = proposableBranches(stack, validatedConfig.Config)
+		branchNamesToSync = validatedConfig.Config.Lineage.BranchesAndAncestors(stack)
+	}
 	branchesToSync, err := branchesSnapshot.Branches.Select(branchNamesToSync...)
 	return proposeData{
-		allBranches:      branchesSnapshot.Branches,
-		branchesSnapshot: branchesSnapshot,
-		branchesToSync:   branchesToSync,
-		config:           validatedConfig,
-		connector:        connector,
-		dialogTestInputs: dialogTestInputs,
-		dryRun:           dryRun,
-		hasOpenChanges:   repoStatus.OpenChanges,
-		initialBranch:    initialBranch,
-		previousBranch:   previousBranch,
-		remotes:          remotes,
-		stashSize:        stashSize,
+		allBranches:       branchesSnapshot.Branches,
+		branchesSnapshot:  branchesSnapshot,
+		branchesToPropose: branchesToPropose,
+		branchesToSync:    branchesToSync,
+		config:            validatedConfig,
+		connector:         connector,
+		dialogTestInputs:  dialogTestInputs,
+		dryRun:            dryRun,
+		hasOpenChanges:    repoStatus.OpenChanges,
+		initialBranch:     initialBranch,
+		previousBranch:    previousBranch,
+		proposeArgs:       args,
+		remotes:           remotes,
+		stashSize:         stashSize,
+		viaAPI:            viaAPI,
 	}, false, err
 }
 
 func proposeProgram(data proposeData) program.Program {
 	prog := program.Program{}
+	for _, branch := range data.branchesToPropose {
+		if !data.config.Config.IsPrototypeBranch(branch) {
+			continue
+		}
+		// proposing a prototype branch turns it into a feature branch, which gets pushed
+		if slice.Contains(data.config.Config.PrototypeBranches, branch) {
+			data.config.Config.PrototypeBranches = slice.Remove(data.config.Config.PrototypeBranches, branch)
+			prog.Add(&opcodes.RemoveFromPrototypeBranches{Branch: branch})
+		} else {
+			// the branch is a prototype because of a branch type rule, which an explicit feature branch entry overrides
+			data.config.Config.FeatureBranches = append(data.config.Config.FeatureBranches, branch)
+			prog.Add(&opcodes.AddToFeatureBranches{Branch: branch})
+		}
+	}
 	for _, branch := range data.branchesToSync {
 		sync.BranchProgram(branch, sync.BranchProgramArgs{
-			BranchInfos:   data.allBranches,
-			Config:        data.config.Config,
-			InitialBranch: data.initialBranch,
-			Remotes:       data.remotes,
-			Program:       &prog,
-			PushBranch:    true,
+			BranchInfos:        data.allBranches,
+			Command:            proposeCmd,
+			Config:             data.config.Config,
+			InitialBranch:      data.initialBranch,
+			Remotes:            data.remotes,
+			Program:            &prog,
+			PushBranch:         true,
+			QueueSkippedPushes: false,
+			Worktrees:          data.branchesSnapshot.Worktrees,
 		})
 	}
+	// syncing the stack ends on its last branch
+	prog.Add(&opcodes.Checkout{Branch: data.initialBranch})
 	previousBranchCandidates := gitdomain.LocalBranchNames{}
 	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch {
 		previousBranchCandidates = append(previousBranchCandidates, previousBranch)
@@ -232,17 +311,41 @@ func proposeProgram(data proposeData) program.Program {
 		StashOpenChanges:         data.hasOpenChanges,
 		PreviousBranchCandidates: previousBranchCandidates,
 	})
-	prog.Add(&opcodes.CreateProposal{
-		Branch:     data.initialBranch,
-		MainBranch: data.config.Config.MainBranch,
-	})
+	for _, branch := range data.branchesToPropose {
+		prog.Add(&opcodes.CreateProposal{
+			Body:          data.proposeArgs.body,
+			Branch:        branch,
+			Draft:         data.proposeArgs.draft,
+			MainBranch:    data.config.Config.MainBranch,
+			NoBrowser:     data.proposeArgs.noBrowser,
+			OnlyIfMissing: data.proposeArgs.stack,
+			Title:         data.proposeArgs.title,
+			ViaAPI:        data.viaAPI,
+		})
+	}
+	if data.config.Config.ProposalsShowLineage.Bool() && len(data.branchesToPropose) > 0 {
+		prog.Add(&opcodes.UpdateProposalLineage{Branches: data.branchesToPropose})
+	}
 	return prog
 }
 
+// proposableBranches provides the branches among the given ones that can be proposed.
+func proposableBranches(branches gitdomain.LocalBranchNames, config configdomain.ValidatedConfig) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branches {
+		switch config.BranchType(branch) {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+			result = append(result, branch)
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+		}
+	}
+	return result
+}
+
 func validateProposeData(data proposeData) error {
 	initialBranchType := data.config.Config.BranchType(data.initialBranch)
 	switch initialBranchType {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotPropose)
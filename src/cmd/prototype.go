@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config"
+	"github.com/git-town/git-town/v14/src/config/commandconfig"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const prototypeDesc = "Make some feature branches local-only"
+
+const prototypeHelp = `
+Makes the given local branches prototype branches.
+If no branch is provided, makes the current branch a prototype branch.
+
+Git Town syncs prototype branches with their parent
+but never pushes them to the remote.
+Proposing a prototype branch converts it into a feature branch.
+`
+
+func prototypeCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "prototype [branches]",
+		Args:    cobra.ArbitraryArgs,
+		GroupID: "types",
+		Short:   prototypeDesc,
+		Long:    cmdhelpers.Long(prototypeDesc, prototypeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executePrototype(args, readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executePrototype(args []string, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := determinePrototypeData(args, repo)
+	if err != nil {
+		return err
+	}
+	err = validatePrototypeData(data)
+	if err != nil {
+		return err
+	}
+	branchNames := data.branchesToPrototype.Keys()
+	if err = repo.UnvalidatedConfig.AddToPrototypeBranches(branchNames...); err != nil {
+		return err
+	}
+	if err = removeNonPrototypeBranchTypes(data.branchesToPrototype, repo.UnvalidatedConfig); err != nil {
+		return err
+	}
+	printPrototypeBranches(branchNames)
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		Backend:             repo.Backend,
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "prototype",
+		CommandsCounter:     repo.CommandsCounter,
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		FinalMessages:       repo.FinalMessages,
+		RootDir:             repo.RootDir,
+		Verbose:             verbose,
+	})
+}
+
+type prototypeData struct {
+	allBranches         gitdomain.BranchInfos
+	branchesToPrototype commandconfig.BranchesAndTypes
+}
+
+func printPrototypeBranches(branches gitdomain.LocalBranchNames) {
+	for _, branch := range branches {
+		fmt.Printf(messages.PrototypeBranchIsNowPrototype, branch)
+	}
+}
+
+func removeNonPrototypeBranchTypes(branches map[gitdomain.LocalBranchName]configdomain.BranchType, config config.UnvalidatedConfig) error {
+	for branchName, branchType := range branches {
+		switch branchType {
+		case configdomain.BranchTypeContributionBranch:
+			if err := config.RemoveFromContributionBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeObservedBranch:
+			if err := config.RemoveFromObservedBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeParkedBranch:
+			if err := config.RemoveFromParkedBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+		}
+	}
+	return nil
+}
+
+func determinePrototypeData(args []string, repo execute.OpenRepoResult) (prototypeData, error) {
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return prototypeData{}, err
+	}
+	branchesToPrototype := commandconfig.BranchesAndTypes{}
+	currentBranch, hasCurrentBranch := branchesSnapshot.Active.Get()
+	if !hasCurrentBranch {
+		return prototypeData{}, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	if len(args) == 0 {
+		branchesToPrototype.Add(currentBranch, *repo.UnvalidatedConfig.Config)
+	} else {
+		branchesToPrototype.AddMany(gitdomain.NewLocalBranchNames(args...), *repo.UnvalidatedConfig.Config)
+	}
+	return prototypeData{
+		allBranches:         branchesSnapshot.Branches,
+		branchesToPrototype: branchesToPrototype,
+	}, nil
+}
+
+func validatePrototypeData(data prototypeData) error {
+	for branchName, branchType := range data.branchesToPrototype {
+		if !data.allBranches.HasLocalBranch(branchName) {
+			return fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		switch branchType {
+		case configdomain.BranchTypeMainBranch:
+			return errors.New(messages.MainBranchCannotPrototype)
+		case configdomain.BranchTypePerennialBranch:
+			return errors.New(messages.PerennialBranchCannotPrototype)
+		case configdomain.BranchTypePrototypeBranch:
+			return fmt.Errorf(messages.BranchIsAlreadyPrototype, branchName)
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		}
+	}
+	return nil
+}
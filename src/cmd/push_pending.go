@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/sync"
+	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	"github.com/git-town/git-town/v14/src/validate"
+	fullInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const pushPendingCommand = "push-pending"
+
+const pushPendingDesc = "Push the branches that an offline sync couldn't push"
+
+const pushPendingHelp = `
+When "git town sync" cannot reach the origin remote,
+it syncs the branches locally and remembers the pushes it had to skip.
+"git town status" lists these pending pushes.
+
+Once you are online again, this command pushes the branches with pending pushes.
+Running "git town sync" while online also pushes them.`
+
+func pushPendingCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
+	cmd := cobra.Command{
+		Use:     pushPendingCommand,
+		GroupID: "basic",
+		Args:    cobra.NoArgs,
+		Short:   pushPendingDesc,
+		Long:    cmdhelpers.Long(pushPendingDesc, pushPendingHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executePushPending(readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executePushPending(dryRun bool, plan Option[report.Format], verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determinePushPendingData(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               pushPendingCommand,
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            pushPendingProgram(data),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               None[hostingdomain.Connector](),
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type pushPendingData struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	branchesToPush   gitdomain.BranchInfos
+	config           config.ValidatedConfig
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   Option[gitdomain.LocalBranchName]
+	resolvedBranches gitdomain.LocalBranchNames
+	stashSize        gitdomain.StashSize
+}
+
+func determinePushPendingData(repo execute.OpenRepoResult, dryRun, verbose bool) (*pushPendingData, bool, error) {
+	pendingPushes, err := statefile.LoadPendingPushes(repo.RootDir)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(pendingPushes) == 0 {
+		fmt.Println(messages.PushPendingNone)
+		return nil, true, nil
+	}
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return nil, false, err
+	}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return nil, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	branchesToPush := gitdomain.BranchInfos{}
+	resolvedBranches := gitdomain.LocalBranchNames{}
+	for _, pendingPush := range pendingPushes {
+		branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(pendingPush).Get()
+		if !hasBranchInfo {
+			// the branch was deleted locally
+			resolvedBranches = append(resolvedBranches, pendingPush)
+			continue
+		}
+		switch branchInfo.SyncStatus {
+		case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusNotInSync:
+			branchesToPush = append(branchesToPush, branchInfo)
+			resolvedBranches = append(resolvedBranches, pendingPush)
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusRemoteOnly, gitdomain.SyncStatusUpToDate:
+			resolvedBranches = append(resolvedBranches, pendingPush)
+		case gitdomain.SyncStatusOtherWorktree:
+		}
+	}
+	return &pushPendingData{
+		branchesSnapshot: branchesSnapshot,
+		branchesToPush:   branchesToPush,
+		config:           validatedConfig,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		previousBranch:   previousBranch,
+		resolvedBranches: resolvedBranches,
+		stashSize:        stashSize,
+	}, false, nil
+}
+
+func pushPendingProgram(data *pushPendingData) program.Program {
+	prog := program.Program{}
+	for _, branch := range data.branchesToPush {
+		if localName, hasLocalName := branch.LocalName.Get(); hasLocalName {
+			prog.Add(&opcodes.Checkout{Branch: localName})
+			sync.PushBranchProgram(&prog, branch, data.config.Config)
+		}
+	}
+	prog.Add(&opcodes.RemovePendingPushes{Branches: data.resolvedBranches})
+	prog.Add(&opcodes.Checkout{Branch: data.initialBranch})
+	previousBranchCandidates := gitdomain.LocalBranchNames{}
+	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch {
+		previousBranchCandidates = append(previousBranchCandidates, previousBranch)
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges && len(data.branchesToPush) > 0,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return prog
+}
@@ -18,7 +18,7 @@ import (
 const repoDesc = "Open the repository homepage in the browser"
 
 const repoHelp = `
-Supported for repositories hosted on GitHub, GitLab, Gitea, and Bitbucket. Derives the Git provider from the "origin" remote. You can override this detection with "git config %s <DRIVER>" where DRIVER is "github", "gitlab", "gitea", or "bitbucket".
+Supported for repositories hosted on GitHub, GitLab, Gitea, Forgejo, Bitbucket, and Azure DevOps. Derives the Git provider from the "origin" remote. You can override this detection with "git config %s <DRIVER>" where DRIVER is "github", "gitlab", "gitea", "forgejo", "bitbucket", or "azure-devops".
 
 When using SSH identities, run "git config %s <HOSTNAME>" where HOSTNAME matches what is in your ssh config file.`
 
@@ -61,12 +61,13 @@ func executeRepo(verbose bool) error {
 func determineRepoData(repo execute.OpenRepoResult) (repoData, error) {
 	var err error
 	var connectorOpt Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := repo.UnvalidatedConfig.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := repo.UnvalidatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *repo.UnvalidatedConfig.Config,
 			HostingPlatform: repo.UnvalidatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         repo.UnvalidatedConfig.PushRemoteURL(),
 		})
 		if err != nil {
 			return emptyRepoData(), err
@@ -3,16 +3,20 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"os"
 
 	"github.com/git-town/git-town/v14/src/cli/dialog"
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/execute"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
@@ -28,23 +32,31 @@ const setParentCmd = "set-parent"
 
 const setParentDesc = "Prompt to set the parent branch for the current branch"
 
+const setParentHelp = `
+With the --rebase flag, also moves the commits of the current branch
+onto the new parent branch, removing the commits of the old parent branch,
+and updates the target of the proposal for the current branch.
+`
+
 func setParentCommand() *cobra.Command {
+	addRebaseFlag, readRebaseFlag := flags.Bool("rebase", "r", "Move the commits of the current branch onto the new parent", flags.FlagTypeNonPersistent)
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     setParentCmd,
 		GroupID: "lineage",
 		Args:    cobra.NoArgs,
 		Short:   setParentDesc,
-		Long:    cmdhelpers.Long(setParentDesc),
+		Long:    cmdhelpers.Long(setParentDesc, setParentHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSetParent(readVerboseFlag(cmd))
+			return executeSetParent(readRebaseFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addRebaseFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeSetParent(verbose bool) error {
+func executeSetParent(rebase, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -56,7 +68,7 @@ func executeSetParent(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineSetParentData(repo, verbose)
+	data, exit, err := determineSetParentData(repo, rebase, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -75,7 +87,11 @@ func executeSetParent(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	prog, aborted := setParentProgram(outcome, selectedBranch, data.initialBranch)
+	proposal, err := determineSetParentProposal(data, outcome, selectedBranch)
+	if err != nil {
+		return err
+	}
+	prog, finalUndoProgram, aborted := setParentProgram(outcome, selectedBranch, proposal, data)
 	if aborted {
 		return nil
 	}
@@ -88,13 +104,14 @@ func executeSetParent(verbose bool) error {
 		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
 		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
 		EndStashSize:          None[gitdomain.StashSize](),
+		FinalUndoProgram:      finalUndoProgram,
 		RunProgram:            prog,
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
 		Config:                  data.config,
-		Connector:               None[hostingdomain.Connector](),
+		Connector:               data.connector,
 		DialogTestInputs:        data.dialogTestInputs,
 		FinalMessages:           repo.FinalMessages,
 		Frontend:                repo.Frontend,
@@ -104,6 +121,7 @@ func executeSetParent(verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    None[report.Format](),
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -113,11 +131,16 @@ func executeSetParent(verbose bool) error {
 type setParentData struct {
 	branchesSnapshot gitdomain.BranchesSnapshot
 	config           config.ValidatedConfig
+	connector        Option[hostingdomain.Connector]
 	defaultChoice    gitdomain.LocalBranchName
 	dialogTestInputs components.TestInputs
+	existingParent   Option[gitdomain.LocalBranchName]
 	hasOpenChanges   bool
 	initialBranch    gitdomain.LocalBranchName
 	mainBranch       gitdomain.LocalBranchName
+	online           configdomain.Online
+	previousBranch   Option[gitdomain.LocalBranchName]
+	rebase           bool
 	stashSize        gitdomain.StashSize
 }
 
@@ -125,7 +148,7 @@ func emptySetParentData() setParentData {
 	return setParentData{} //exhaustruct:ignore
 }
 
-func determineSetParentData(repo execute.OpenRepoResult, verbose bool) (setParentData, bool, error) {
+func determineSetParentData(repo execute.OpenRepoResult, rebase, verbose bool) (setParentData, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
 	if err != nil {
@@ -136,6 +159,7 @@ func determineSetParentData(repo execute.OpenRepoResult, verbose bool) (setParen
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -172,21 +196,38 @@ func determineSetParentData(repo execute.OpenRepoResult, verbose bool) (setParen
 	if !hasInitialBranch {
 		return emptySetParentData(), exit, errors.New(messages.CurrentBranchCannotDetermine)
 	}
-	existingParent, hasParent := validatedConfig.Config.Lineage.Parent(initialBranch).Get()
-	var defaultChoice gitdomain.LocalBranchName
-	if hasParent {
-		defaultChoice = existingParent
-	} else {
-		defaultChoice = mainBranch
+	existingParentOpt := validatedConfig.Config.Lineage.Parent(initialBranch)
+	defaultChoice := existingParentOpt.GetOrElse(mainBranch)
+	var connector Option[hostingdomain.Connector]
+	var previousBranch Option[gitdomain.LocalBranchName]
+	if rebase {
+		previousBranch = repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+		if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
+			connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+				Config:          *validatedConfig.Config.UnvalidatedConfig,
+				HostingPlatform: validatedConfig.Config.HostingPlatform,
+				Log:             print.Logger{},
+				OriginURL:       originURL,
+				PushURL:         validatedConfig.PushRemoteURL(),
+			})
+			if err != nil {
+				return emptySetParentData(), false, err
+			}
+		}
 	}
 	return setParentData{
 		branchesSnapshot: branchesSnapshot,
 		config:           validatedConfig,
+		connector:        connector,
 		defaultChoice:    defaultChoice,
 		dialogTestInputs: dialogTestInputs,
+		existingParent:   existingParentOpt,
 		hasOpenChanges:   repoStatus.OpenChanges,
 		initialBranch:    initialBranch,
 		mainBranch:       mainBranch,
+		online:           repo.IsOffline.ToOnline(),
+		previousBranch:   previousBranch,
+		rebase:           rebase,
 		stashSize:        stashSize,
 	}, false, nil
 }
@@ -198,10 +239,30 @@ func verifySetParentData(data setParentData) error {
 	return nil
 }
 
-func setParentProgram(outcome dialog.ParentOutcome, selectedBranch, currentBranch gitdomain.LocalBranchName) (result program.Program, aborted bool) {
+// determineSetParentProposal provides the proposal of the current branch against its old parent,
+// which needs to target the new parent after moving the current branch.
+func determineSetParentProposal(data setParentData, outcome dialog.ParentOutcome, newParent gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	connector, hasConnector := data.connector.Get()
+	existingParent, hasExistingParent := data.existingParent.Get()
+	if !data.rebase || !hasConnector || !hasExistingParent || !data.online.Bool() || outcome != dialog.ParentOutcomeSelectedParent || newParent == existingParent {
+		return None[hostingdomain.Proposal](), nil
+	}
+	initialBranchInfo, hasInitialBranchInfo := data.branchesSnapshot.Branches.FindByLocalName(data.initialBranch).Get()
+	if !hasInitialBranchInfo || !initialBranchInfo.HasTrackingBranch() {
+		return None[hostingdomain.Proposal](), nil
+	}
+	proposal, err := connector.FindProposal(data.initialBranch, existingParent)
+	if err != nil {
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalNotFoundForBranch, data.initialBranch, err)
+	}
+	return proposal, nil
+}
+
+func setParentProgram(outcome dialog.ParentOutcome, selectedBranch gitdomain.LocalBranchName, proposal Option[hostingdomain.Proposal], data setParentData) (result, finalUndoProgram program.Program, aborted bool) {
+	currentBranch := data.initialBranch
 	switch outcome {
 	case dialog.ParentOutcomeAborted:
-		return result, true
+		return result, finalUndoProgram, true
 	case dialog.ParentOutcomePerennialBranch:
 		result.Add(&opcodes.AddToPerennialBranches{
 			Branch: currentBranch,
@@ -214,6 +275,56 @@ func setParentProgram(outcome dialog.ParentOutcome, selectedBranch, currentBranc
 			Branch: currentBranch,
 			Parent: selectedBranch,
 		})
+		if data.rebase {
+			setParentRebaseProgram(&result, &finalUndoProgram, selectedBranch, proposal, data)
+		}
 	}
-	return result, false
+	return result, finalUndoProgram, false
+}
+
+// setParentRebaseProgram moves the commits of the current branch from its old parent onto the given new parent.
+func setParentRebaseProgram(prog, finalUndoProgram *program.Program, newParent gitdomain.LocalBranchName, proposal Option[hostingdomain.Proposal], data setParentData) {
+	existingParent, hasExistingParent := data.existingParent.Get()
+	if !hasExistingParent || existingParent == newParent {
+		return
+	}
+	// the old parent might have been deleted locally, for example after shipping it
+	var commitsToRemove gitdomain.BranchName
+	switch {
+	case data.branchesSnapshot.Branches.HasLocalBranch(existingParent):
+		commitsToRemove = existingParent.BranchName()
+	case data.branchesSnapshot.Branches.FindByRemoteName(existingParent.TrackingBranch(data.config.Config.TrackingRemote(existingParent))) != nil:
+		commitsToRemove = existingParent.TrackingBranch(data.config.Config.TrackingRemote(existingParent)).BranchName()
+	default:
+		return
+	}
+	prog.Add(&opcodes.RebaseOnto{
+		BranchToRebaseOnto: newParent.BranchName(),
+		CommitsToRemove:    commitsToRemove,
+	})
+	initialBranchInfo, hasInitialBranchInfo := data.branchesSnapshot.Branches.FindByLocalName(data.initialBranch).Get()
+	if hasInitialBranchInfo && initialBranchInfo.HasTrackingBranch() && data.online.Bool() {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+	if proposal, hasProposal := proposal.Get(); hasProposal {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      newParent,
+			ProposalNumber: proposal.Number,
+		})
+		// undo retargets the proposal back to the old parent
+		finalUndoProgram.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      existingParent,
+			ProposalNumber: proposal.Number,
+		})
+	}
+	previousBranchCandidates := gitdomain.LocalBranchNames{}
+	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch {
+		previousBranchCandidates = append(previousBranchCandidates, previousBranch)
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   false,
+		PreviousBranchCandidates: previousBranchCandidates,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges,
+	})
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
 	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -54,28 +55,36 @@ If you use GitHub, this command can squash merge pull requests via the GitHub AP
 
 Now anytime you ship a branch with a pull request on GitHub, it will squash merge via the GitHub API. It will also update the base branch for any pull requests against that branch.
 
+The "%s" setting changes how this command merges branches: "squash-merge" (default), "merge" to use a merge commit, "fast-forward" to fast-forward the main branch to <branch_name>, or "api" to only ship via the API of your code hosting platform.
+
 If your origin server deletes shipped branches, for example GitHub's feature to automatically delete head branches, run "git config %s false" and Git Town will leave it up to your origin server to delete the tracking branch of the branch you are shipping.`
 
 func shipCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
-	addMessageFlag, readMessageFlag := flags.CommitMessage("Specify the commit message for the squash commit")
+	addMessageFlag, readMessageFlag := flags.CommitMessage("Specify the commit message for the squash or merge commit")
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	cmd := cobra.Command{
 		Use:   shipCommand,
 		Args:  cobra.MaximumNArgs(1),
 		Short: shipDesc,
-		Long:  cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, gitconfig.KeyGithubToken, gitconfig.KeyShipDeleteTrackingBranch)),
+		Long:  cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, gitconfig.KeyGithubToken, gitconfig.KeyShipStrategy, gitconfig.KeyShipDeleteTrackingBranch)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun, verbose bool) error {
+func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun bool, plan Option[report.Format], verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -95,6 +104,10 @@ func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun,
 	if err != nil {
 		return err
 	}
+	err = validateShipStrategy(*data, message)
+	if err != nil {
+		return err
+	}
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -120,6 +133,7 @@ func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun,
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -159,6 +173,7 @@ func determineShipData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 true,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -181,8 +196,8 @@ func determineShipData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	}
 	branchNameToShip := gitdomain.NewLocalBranchName(slice.FirstElementOr(args, branchesSnapshot.Active.String()))
 	branchToShip, hasBranchToShip := branchesSnapshot.Branches.FindByLocalName(branchNameToShip).Get()
-	if hasBranchToShip && branchToShip.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return nil, false, fmt.Errorf(messages.ShipBranchOtherWorktree, branchNameToShip)
+	if worktree, inOtherWorktree := branchesSnapshot.Worktrees.ForBranch(branchNameToShip).Get(); inOtherWorktree {
+		return nil, false, fmt.Errorf(messages.ShipBranchOtherWorktree, branchNameToShip, worktree.Path)
 	}
 	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
 	if !hasInitialBranch {
@@ -219,6 +234,9 @@ func determineShipData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	if !hasTargetBranch {
 		return nil, false, fmt.Errorf(messages.BranchDoesntExist, targetBranchName)
 	}
+	if worktree, inOtherWorktree := branchesSnapshot.Worktrees.ForBranch(targetBranchName).Get(); inOtherWorktree {
+		return nil, false, fmt.Errorf(messages.ShipTargetOtherWorktree, targetBranchName, worktree.Path)
+	}
 	err = ensureParentBranchIsMainOrPerennialBranch(branchNameToShip, targetBranchName, validatedConfig.Config, validatedConfig.Config.Lineage)
 	if err != nil {
 		return nil, false, err
@@ -227,12 +245,13 @@ func determineShipData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 	childBranches := validatedConfig.Config.Lineage.Children(branchNameToShip)
 	proposalsOfChildBranches := []hostingdomain.Proposal{}
 	var connectorOpt Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
 		})
 		if err != nil {
 			return nil, false, err
@@ -248,7 +267,8 @@ func determineShipData(args []string, repo execute.OpenRepoResult, dryRun, verbo
 					return nil, false, err
 				}
 				proposal, hasProposal := proposalOpt.Get()
-				if hasProposal {
+				// fast-forwarding happens locally, the code hosting platform detects the shipped proposal when we push
+				if hasProposal && validatedConfig.Config.ShipStrategy != configdomain.ShipStrategyFastForward {
 					canShipViaAPI = true
 					proposalMessage = connector.DefaultProposalMessage(proposal)
 				}
@@ -300,33 +320,47 @@ func ensureParentBranchIsMainOrPerennialBranch(branch, parentBranch gitdomain.Lo
 
 func shipProgram(data *shipData, commitMessage Option[gitdomain.CommitMessage]) program.Program {
 	prog := program.Program{}
+	localBranchToShip, hasLocalBranchToShip := data.branchToShip.LocalName.Get()
+	localTargetBranch, _ := data.targetBranch.LocalName.Get()
+	sync.AddHook(sync.AddHookArgs{
+		Branch:  localBranchToShip,
+		Command: shipCommand,
+		Hook:    configdomain.HookPreShip,
+		Hooks:   data.config.Config.Hooks,
+		Parent:  Some(localTargetBranch),
+		Program: &prog,
+	})
 	if data.config.Config.SyncBeforeShip {
 		// sync the parent branch
 		sync.BranchProgram(data.targetBranch, sync.BranchProgramArgs{
-			BranchInfos:   data.allBranches,
-			Config:        data.config.Config,
-			InitialBranch: data.initialBranch,
-			Remotes:       data.remotes,
-			Program:       &prog,
-			PushBranch:    true,
+			BranchInfos:        data.allBranches,
+			Command:            shipCommand,
+			Config:             data.config.Config,
+			InitialBranch:      data.initialBranch,
+			Remotes:            data.remotes,
+			Program:            &prog,
+			PushBranch:         true,
+			QueueSkippedPushes: false,
 		})
 		// sync the branch to ship (local sync only)
 		sync.BranchProgram(data.branchToShip, sync.BranchProgramArgs{
-			BranchInfos:   data.allBranches,
-			Config:        data.config.Config,
-			InitialBranch: data.initialBranch,
-			Remotes:       data.remotes,
-			Program:       &prog,
-			PushBranch:    false,
+			BranchInfos:        data.allBranches,
+			Command:            shipCommand,
+			Config:             data.config.Config,
+			InitialBranch:      data.initialBranch,
+			Remotes:            data.remotes,
+			Program:            &prog,
+			PushBranch:         false,
+			QueueSkippedPushes: false,
 		})
 	}
-	localBranchToShip, hasLocalBranchToShip := data.branchToShip.LocalName.Get()
-	localTargetBranch, _ := data.targetBranch.LocalName.Get()
 	if hasLocalBranchToShip {
 		prog.Add(&opcodes.EnsureHasShippableChanges{Branch: localBranchToShip, Parent: data.config.Config.MainBranch})
 		prog.Add(&opcodes.Checkout{Branch: localTargetBranch})
 	}
-	if proposal, hasProposal := data.proposal.Get(); hasProposal && data.canShipViaAPI {
+	proposal, hasProposal := data.proposal.Get()
+	shipViaAPI := hasProposal && data.canShipViaAPI
+	if shipViaAPI {
 		// update the proposals of child branches
 		for _, childProposal := range data.proposalsOfChildBranches {
 			prog.Add(&opcodes.UpdateProposalTarget{
@@ -335,17 +369,32 @@ func shipProgram(data *shipData, commitMessage Option[gitdomain.CommitMessage])
 			})
 		}
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: localBranchToShip})
-		prog.Add(&opcodes.ConnectorMergeProposal{
-			Branch:          localBranchToShip,
-			ProposalNumber:  proposal.Number,
-			CommitMessage:   commitMessage,
-			ProposalMessage: data.proposalMessage,
-		})
+	}
+	switch data.config.Config.ShipStrategy {
+	case configdomain.ShipStrategyAPI, configdomain.ShipStrategySquashMerge:
+		if shipViaAPI {
+			prog.Add(&opcodes.ConnectorMergeProposal{
+				Branch:          localBranchToShip,
+				ProposalNumber:  proposal.Number,
+				CommitMessage:   commitMessage,
+				ProposalMessage: data.proposalMessage,
+			})
+		} else {
+			prog.Add(&opcodes.SquashMerge{Branch: localBranchToShip, CommitMessage: commitMessage, Parent: localTargetBranch})
+		}
+	case configdomain.ShipStrategyMerge:
+		if shipViaAPI {
+			prog.Add(&opcodes.ConnectorMergeProposalWithMergeCommit{ProposalNumber: proposal.Number, CommitMessage: commitMessage})
+		} else {
+			prog.Add(&opcodes.MergeNoFastForward{Branch: localBranchToShip, CommitMessage: commitMessage})
+		}
+	case configdomain.ShipStrategyFastForward:
+		prog.Add(&opcodes.MergeFastForward{Branch: localBranchToShip, Parent: localTargetBranch})
+	}
+	if shipViaAPI {
 		prog.Add(&opcodes.PullCurrentBranch{})
-	} else {
-		prog.Add(&opcodes.SquashMerge{Branch: localBranchToShip, CommitMessage: commitMessage, Parent: localTargetBranch})
 	}
-	if data.remotes.HasOrigin() && data.config.Config.IsOnline() {
+	if data.remotes.HasRemote(data.config.Config.TrackingRemote(localTargetBranch)) && data.config.Config.IsOnline() {
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: localTargetBranch})
 	}
 	// NOTE: when shipping via API, we can always delete the tracking branch because:
@@ -366,6 +415,14 @@ func shipProgram(data *shipData, commitMessage Option[gitdomain.CommitMessage])
 	for _, child := range data.childBranches {
 		prog.Add(&opcodes.ChangeParent{Branch: child, Parent: localTargetBranch})
 	}
+	sync.AddHook(sync.AddHookArgs{
+		Branch:  localBranchToShip,
+		Command: shipCommand,
+		Hook:    configdomain.HookPostShip,
+		Hooks:   data.config.Config.Hooks,
+		Parent:  Some(localTargetBranch),
+		Program: &prog,
+	})
 	if !data.isShippingInitialBranch {
 		prog.Add(&opcodes.Checkout{Branch: data.initialBranch})
 	}
@@ -382,11 +439,30 @@ func shipProgram(data *shipData, commitMessage Option[gitdomain.CommitMessage])
 	return prog
 }
 
+func validateShipStrategy(data shipData, message Option[gitdomain.CommitMessage]) error {
+	strategy := data.config.Config.ShipStrategy
+	switch strategy {
+	case configdomain.ShipStrategyAPI:
+		if data.connector.IsNone() {
+			return fmt.Errorf(messages.ShipStrategyAPINoConnector, strategy)
+		}
+		if !data.canShipViaAPI {
+			return fmt.Errorf(messages.ShipStrategyAPINoProposal, strategy, data.branchToShip.LocalName.GetOrDefault())
+		}
+	case configdomain.ShipStrategyFastForward:
+		if message.IsSome() {
+			return errors.New(messages.ShipFastForwardMessage)
+		}
+	case configdomain.ShipStrategyMerge, configdomain.ShipStrategySquashMerge:
+	}
+	return nil
+}
+
 func validateShippableBranchType(branchType configdomain.BranchType) error {
 	switch branchType {
 	case configdomain.BranchTypeContributionBranch:
 		return errors.New(messages.ContributionBranchCannotShip)
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotShip)
@@ -60,6 +60,7 @@ func executeSkip(verbose bool) error {
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -112,12 +113,13 @@ func executeSkip(verbose bool) error {
 		}
 	}
 	var connector Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
 		})
 		if err != nil {
 			return err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/flags"
 	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/execute"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
@@ -19,6 +20,7 @@ import (
 const statusDesc = "Displays or resets the current suspended Git Town command"
 
 func RootCommand() *cobra.Command {
+	addFormatFlag, readFormatFlag := flags.Format()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "status",
@@ -27,15 +29,20 @@ func RootCommand() *cobra.Command {
 		Short:   statusDesc,
 		Long:    cmdhelpers.Long(statusDesc),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeStatus(readVerboseFlag(cmd))
+			format, err := readFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeStatus(format, readVerboseFlag(cmd))
 		},
 	}
+	addFormatFlag(&cmd)
 	addVerboseFlag(&cmd)
 	cmd.AddCommand(resetRunstateCommand())
 	return &cmd
 }
 
-func executeStatus(verbose bool) error {
+func executeStatus(format report.Format, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -51,14 +58,18 @@ func executeStatus(verbose bool) error {
 	if err != nil {
 		return err
 	}
+	if format == report.FormatJSON {
+		return displayStatusJSON(*data, repo)
+	}
 	displayStatus(*data)
 	print.Footer(verbose, repo.CommandsCounter.Count(), print.NoFinalMessages)
 	return nil
 }
 
 type displayStatusData struct {
-	filepath string                    // filepath of the runstate file
-	state    Option[runstate.RunState] // content of the runstate file
+	filepath      string                     // filepath of the runstate file
+	pendingPushes gitdomain.LocalBranchNames // branches whose pushes an offline sync had to skip
+	state         Option[runstate.RunState]  // content of the runstate file
 }
 
 func loadDisplayStatusData(rootDir gitdomain.RepoRootDir) (*displayStatusData, error) {
@@ -70,23 +81,52 @@ func loadDisplayStatusData(rootDir gitdomain.RepoRootDir) (*displayStatusData, e
 	if err != nil {
 		return nil, err
 	}
+	pendingPushes, err := statefile.LoadPendingPushes(rootDir)
+	if err != nil {
+		return nil, err
+	}
 	return &displayStatusData{
-		filepath: filepath,
-		state:    state,
+		filepath:      filepath,
+		pendingPushes: pendingPushes,
+		state:         state,
 	}, nil
 }
 
 func displayStatus(data displayStatusData) {
 	state, hasState := data.state.Get()
-	if !hasState {
+	switch {
+	case !hasState:
 		fmt.Println(messages.StatusFileNotFound)
-		return
-	}
-	if state.IsFinished() {
+	case state.IsFinished():
 		displayFinishedStatus(state)
-	} else {
+	default:
 		displayUnfinishedStatus(state)
 	}
+	displayPendingPushes(data.pendingPushes)
+}
+
+func displayPendingPushes(pendingPushes gitdomain.LocalBranchNames) {
+	if len(pendingPushes) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println(messages.PendingPushes)
+	for _, branch := range pendingPushes {
+		fmt.Println("  - " + branch.String())
+	}
+}
+
+func displayStatusJSON(data displayStatusData, repo execute.OpenRepoResult) error {
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return err
+	}
+	return report.Print(report.NewRepo(report.NewRepoArgs{
+		Branches:      branchesSnapshot,
+		Config:        *repo.UnvalidatedConfig.Config,
+		PendingPushes: data.pendingPushes,
+		RunState:      data.state,
+	}))
 }
 
 func displayUnfinishedStatus(state runstate.RunState) {
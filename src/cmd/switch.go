@@ -2,17 +2,20 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 
 	"github.com/git-town/git-town/v14/src/cli/dialog"
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/execute"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/validate"
 	"github.com/spf13/cobra"
@@ -54,7 +57,7 @@ func executeSwitch(verbose, merge bool) error {
 	if err != nil || exit {
 		return err
 	}
-	branchToCheckout, exit, err := dialog.SwitchBranch(data.branchNames, data.initialBranch, data.config.Config.Lineage, data.branchesSnapshot.Branches, data.uncommittedChanges, data.dialogInputs.Next())
+	branchToCheckout, exit, err := dialog.SwitchBranch(data.branchNames, data.initialBranch, data.config.Config.Lineage, data.branchesSnapshot.Branches, data.branchesSnapshot.Worktrees, data.config.Config.PushRemote, data.proposals, data.uncommittedChanges, data.dialogInputs.Next())
 	if err != nil || exit {
 		return err
 	}
@@ -80,6 +83,7 @@ type switchData struct {
 	dialogInputs       components.TestInputs
 	initialBranch      gitdomain.LocalBranchName
 	lineage            configdomain.Lineage
+	proposals          map[gitdomain.LocalBranchName]hostingdomain.Proposal
 	uncommittedChanges bool
 }
 
@@ -98,6 +102,7 @@ func determineSwitchData(repo execute.OpenRepoResult, verbose bool) (switchData,
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -133,6 +138,7 @@ func determineSwitchData(repo execute.OpenRepoResult, verbose bool) (switchData,
 	if err != nil || exit {
 		return emptySwitchData(), exit, err
 	}
+	proposals := loadSwitchProposals(validatedConfig, repo, localBranches)
 	return switchData{
 		branchNames:        branchesSnapshot.Branches.Names(),
 		branchesSnapshot:   branchesSnapshot,
@@ -140,6 +146,29 @@ func determineSwitchData(repo execute.OpenRepoResult, verbose bool) (switchData,
 		dialogInputs:       dialogTestInputs,
 		initialBranch:      initialBranch,
 		lineage:            validatedConfig.Config.Lineage,
+		proposals:          proposals,
 		uncommittedChanges: repoStatus.UntrackedChanges,
 	}, false, err
 }
+
+// loadSwitchProposals provides the open proposals for the given branches.
+// Problems talking to the code hosting platform only print a warning
+// because the branch list is useful without proposal information.
+func loadSwitchProposals(validatedConfig config.ValidatedConfig, repo execute.OpenRepoResult, branches gitdomain.LocalBranchNames) map[gitdomain.LocalBranchName]hostingdomain.Proposal {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	connectorOpt, err := onlineConnector(validatedConfig, repo)
+	if err != nil {
+		print.Error(fmt.Errorf(messages.ProposalsLoadProblem, err))
+		return result
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return result
+	}
+	proposals, err := connector.FindProposals(branches)
+	if err != nil {
+		print.Error(fmt.Errorf(messages.ProposalsLoadProblem, err))
+		return result
+	}
+	return proposals
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -15,15 +17,18 @@ import (
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/gohacks/stringslice"
+	"github.com/git-town/git-town/v14/src/hosting"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/sync"
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
 	"github.com/git-town/git-town/v14/src/validate"
 	fullInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
 	"github.com/git-town/git-town/v14/src/vm/optimizer"
 	"github.com/git-town/git-town/v14/src/vm/program"
 	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
 	"github.com/spf13/cobra"
 )
 
@@ -44,12 +49,24 @@ When run on the main branch or a perennial branch:
 - pulls and pushes updates for the current branch
 - pushes tags
 
+The "--stack" flag syncs all branches in the stack of the current branch:
+its ancestors, the current branch, and all its descendants.
+
+The "--prune" flag deletes feature branches that were merged into their parent branch,
+for example through a merge commit or a rebase on your code hosting platform,
+and makes their children children of their parent branch.
+If you have configured an API token for your code hosting platform,
+this also detects branches whose squash-merged proposals were merged.
+
 If the repository contains an "upstream" remote, syncs the main branch with its upstream counterpart. You can disable this by running "git config %s false".`
 
 func syncCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
 	addAllFlag, readAllFlag := flags.Bool("all", "a", "Sync all local branches", flags.FlagTypeNonPersistent)
+	addPruneFlag, readPruneFlag := flags.Bool("prune", "p", "Delete branches that were merged into their parent branch", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Sync the stack that the current branch belongs to", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     syncCommand,
 		GroupID: "basic",
@@ -57,16 +74,26 @@ func syncCmd() *cobra.Command {
 		Short:   syncDesc,
 		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, gitconfig.KeySyncUpstream)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSync(readAllFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeSync(readAllFlag(cmd), readStackFlag(cmd), readPruneFlag(cmd), readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
 		},
 	}
 	addAllFlag(&cmd)
+	addPruneFlag(&cmd)
+	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
 	return &cmd
 }
 
-func executeSync(all, dryRun, verbose bool) error {
+func executeSync(all, stack, prune, dryRun bool, plan Option[report.Format], verbose bool) error {
+	if all && stack {
+		return errors.New(messages.SyncAllAndStack)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -78,7 +105,7 @@ func executeSync(all, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineSyncData(all, repo, verbose)
+	data, exit, err := determineSyncData(all, stack, prune, repo, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -92,22 +119,40 @@ func executeSync(all, dryRun, verbose bool) error {
 	}
 
 	runProgram := program.Program{}
+	sync.AddHook(sync.AddHookArgs{
+		Branch:  data.initialBranch,
+		Command: syncCommand,
+		Hook:    configdomain.HookPreSync,
+		Hooks:   data.config.Config.Hooks,
+		Parent:  data.config.Config.Lineage.Parent(data.initialBranch),
+		Program: &runProgram,
+	})
 	sync.BranchesProgram(sync.BranchesProgramArgs{
 		BranchProgramArgs: sync.BranchProgramArgs{
-			BranchInfos:   data.allBranches,
-			Config:        data.config.Config,
-			InitialBranch: data.initialBranch,
-			Remotes:       data.remotes,
-			Program:       &runProgram,
-			PushBranch:    true,
+			BranchInfos:        data.allBranches,
+			Command:            syncCommand,
+			Config:             data.config.Config,
+			InitialBranch:      data.initialBranch,
+			Remotes:            data.remotes,
+			Program:            &runProgram,
+			PushBranch:         true,
+			QueueSkippedPushes: data.queueSkippedPushes,
+			Worktrees:          data.branchesSnapshot.Worktrees,
 		},
-		BranchesToSync: data.branchesToSync,
-		DryRun:         dryRun,
-		HasOpenChanges: data.hasOpenChanges,
-		InitialBranch:  data.initialBranch,
-		PreviousBranch: data.previousBranch,
-		ShouldPushTags: data.shouldPushTags,
+		BranchesToPrune: data.branchesToPrune,
+		BranchesToSync:  data.branchesToSync,
+		DryRun:          dryRun,
+		HasOpenChanges:  data.hasOpenChanges,
+		InitialBranch:   data.initialBranch,
+		PreviousBranch:  data.previousBranch,
+		ShouldPushTags:  data.shouldPushTags,
 	})
+	if data.connector.IsSome() && data.config.Config.ProposalsShowLineage.Bool() {
+		runProgram.Add(&opcodes.UpdateProposalLineage{Branches: data.branchesToSync.LocalBranches().Names()})
+	}
+	if pushedPendingPushes := pendingPushesIn(data.pendingPushes, data.branchesToSync.LocalBranches().Names()); data.config.Config.IsOnline() && len(pushedPendingPushes) > 0 {
+		runProgram.Add(&opcodes.RemovePendingPushes{Branches: pushedPendingPushes})
+	}
 	runProgram = optimizer.Optimize(runProgram)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
@@ -124,7 +169,7 @@ func executeSync(all, dryRun, verbose bool) error {
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
 		Config:                  data.config,
-		Connector:               None[hostingdomain.Connector](),
+		Connector:               data.connector,
 		DialogTestInputs:        data.dialogTestInputs,
 		FinalMessages:           repo.FinalMessages,
 		Frontend:                repo.Frontend,
@@ -134,6 +179,7 @@ func executeSync(all, dryRun, verbose bool) error {
 		InitialBranchesSnapshot: data.branchesSnapshot,
 		InitialConfigSnapshot:   repo.ConfigSnapshot,
 		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
 		RootDir:                 repo.RootDir,
 		RunState:                runState,
 		Verbose:                 verbose,
@@ -141,24 +187,28 @@ func executeSync(all, dryRun, verbose bool) error {
 }
 
 type syncData struct {
-	allBranches      gitdomain.BranchInfos
-	branchesSnapshot gitdomain.BranchesSnapshot
-	branchesToSync   gitdomain.BranchInfos
-	config           config.ValidatedConfig
-	dialogTestInputs components.TestInputs
-	hasOpenChanges   bool
-	initialBranch    gitdomain.LocalBranchName
-	previousBranch   Option[gitdomain.LocalBranchName]
-	remotes          gitdomain.Remotes
-	shouldPushTags   bool
-	stashSize        gitdomain.StashSize
+	allBranches        gitdomain.BranchInfos
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	branchesToPrune    gitdomain.LocalBranchNames
+	branchesToSync     gitdomain.BranchInfos
+	config             config.ValidatedConfig
+	connector          Option[hostingdomain.Connector]
+	dialogTestInputs   components.TestInputs
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	pendingPushes      gitdomain.LocalBranchNames // branches whose pushes got skipped by an earlier offline sync
+	previousBranch     Option[gitdomain.LocalBranchName]
+	queueSkippedPushes bool
+	remotes            gitdomain.Remotes
+	shouldPushTags     bool
+	stashSize          gitdomain.StashSize
 }
 
 func emptySyncData() syncData {
 	return syncData{} //exhaustruct:ignore
 }
 
-func determineSyncData(allFlag bool, repo execute.OpenRepoResult, verbose bool) (syncData, bool, error) {
+func determineSyncData(allFlag, stackFlag, pruneFlag bool, repo execute.OpenRepoResult, verbose bool) (syncData, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
 	if err != nil {
@@ -169,6 +219,7 @@ func determineSyncData(allFlag bool, repo execute.OpenRepoResult, verbose bool)
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     true,
 		Fetch:                 true,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -206,17 +257,17 @@ func determineSyncData(allFlag bool, repo execute.OpenRepoResult, verbose bool)
 	if !hasInitialBranch {
 		return emptySyncData(), false, errors.New(messages.CurrentBranchCannotDetermine)
 	}
-	var branchNamesToSync gitdomain.LocalBranchNames
+	var branchNamesToValidate gitdomain.LocalBranchNames
 	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
 	if allFlag {
-		branchNamesToSync = localBranches
+		branchNamesToValidate = localBranches
 	} else {
-		branchNamesToSync = gitdomain.LocalBranchNames{initialBranch}
+		branchNamesToValidate = gitdomain.LocalBranchNames{initialBranch}
 	}
 	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
 		Backend:            repo.Backend,
 		BranchesSnapshot:   branchesSnapshot,
-		BranchesToValidate: branchNamesToSync,
+		BranchesToValidate: branchNamesToValidate,
 		DialogTestInputs:   dialogTestInputs,
 		Frontend:           repo.Frontend,
 		Git:                repo.Git,
@@ -228,27 +279,150 @@ func determineSyncData(allFlag bool, repo execute.OpenRepoResult, verbose bool)
 	if err != nil || exit {
 		return emptySyncData(), exit, err
 	}
+	var branchNamesToSync gitdomain.LocalBranchNames
 	var shouldPushTags bool
-	if allFlag {
+	switch {
+	case allFlag:
+		branchNamesToSync = localBranches
 		shouldPushTags = true
-	} else {
+	case stackFlag:
+		// the lineage can contain branches that were deleted without Git Town
+		branchNamesToSync = validatedConfig.Config.Lineage.BranchLineage(initialBranch).KeepOnly(localBranches)
+		shouldPushTags = validatedConfig.Config.IsMainOrPerennialBranch(initialBranch)
+	default:
+		branchNamesToSync = gitdomain.LocalBranchNames{initialBranch}
 		shouldPushTags = validatedConfig.Config.IsMainOrPerennialBranch(initialBranch)
 	}
+	// the origin remote was unreachable when fetching --> sync offline and remember the skipped pushes
+	queueSkippedPushes := validatedConfig.Config.Offline.Bool() && !repo.IsOffline.Bool()
+	pendingPushes, err := statefile.LoadPendingPushes(repo.RootDir)
+	if err != nil {
+		return emptySyncData(), false, err
+	}
+	if validatedConfig.Config.IsOnline() {
+		for _, pendingPush := range pendingPushes {
+			if localBranches.Contains(pendingPush) {
+				branchNamesToSync = branchNamesToSync.AppendAllMissing(pendingPush)
+			}
+		}
+	}
 	allBranchNamesToSync := validatedConfig.Config.Lineage.BranchesAndAncestors(branchNamesToSync)
 	branchesToSync, err := branchesSnapshot.Branches.Select(allBranchNamesToSync...)
+	if err != nil {
+		return emptySyncData(), false, err
+	}
+	connector := None[hostingdomain.Connector]()
+	if pruneFlag || validatedConfig.Config.ProposalsShowLineage.Bool() {
+		connector, err = onlineConnector(validatedConfig, repo)
+		if err != nil {
+			return emptySyncData(), false, err
+		}
+	}
+	branchesToPrune := gitdomain.LocalBranchNames{}
+	if pruneFlag {
+		branchesToPrune, err = determineBranchesToPrune(branchesToSync, branchesSnapshot.Branches, validatedConfig, connector, repo)
+		if err != nil {
+			return emptySyncData(), false, err
+		}
+	}
 	return syncData{
-		allBranches:      branchesSnapshot.Branches,
-		branchesSnapshot: branchesSnapshot,
-		branchesToSync:   branchesToSync,
-		config:           validatedConfig,
-		dialogTestInputs: dialogTestInputs,
-		hasOpenChanges:   repoStatus.OpenChanges,
-		initialBranch:    initialBranch,
-		previousBranch:   previousBranchOpt,
-		remotes:          remotes,
-		shouldPushTags:   shouldPushTags,
-		stashSize:        stashSize,
-	}, false, err
+		allBranches:        branchesSnapshot.Branches,
+		branchesSnapshot:   branchesSnapshot,
+		branchesToPrune:    branchesToPrune,
+		branchesToSync:     branchesToSync,
+		config:             validatedConfig,
+		connector:          connector,
+		dialogTestInputs:   dialogTestInputs,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		pendingPushes:      pendingPushes,
+		previousBranch:     previousBranchOpt,
+		queueSkippedPushes: queueSkippedPushes,
+		remotes:            remotes,
+		shouldPushTags:     shouldPushTags,
+		stashSize:          stashSize,
+	}, false, nil
+}
+
+// determineBranchesToPrune provides the feature branches among the given branches that were merged into their parent branch.
+func determineBranchesToPrune(branchesToSync, allBranches gitdomain.BranchInfos, validatedConfig config.ValidatedConfig, connector Option[hostingdomain.Connector], repo execute.OpenRepoResult) (gitdomain.LocalBranchNames, error) {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branchesToSync {
+		localName, hasLocalName := branch.LocalName.Get()
+		if !hasLocalName {
+			continue
+		}
+		switch branch.SyncStatus {
+		case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusUpToDate:
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusOtherWorktree, gitdomain.SyncStatusRemoteOnly:
+			continue
+		}
+		switch validatedConfig.Config.BranchType(localName) {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+			continue
+		}
+		parent, hasParent := validatedConfig.Config.Lineage.Parent(localName).Get()
+		if !hasParent {
+			continue
+		}
+		// compare against the tracking branch of the parent because it contains the latest changes
+		target := parent.BranchName()
+		if parentInfo, hasParentInfo := allBranches.FindByLocalName(parent).Get(); hasParentInfo && parentInfo.HasTrackingBranch() {
+			if parentTrackingBranch, hasParentTrackingBranch := parentInfo.RemoteName.Get(); hasParentTrackingBranch {
+				target = parentTrackingBranch.BranchName()
+			}
+		}
+		isMerged, err := repo.Git.BranchIsMergedInto(repo.Backend, localName, target)
+		if err != nil {
+			return result, err
+		}
+		if !isMerged && branch.HasTrackingBranch() {
+			if connector, hasConnector := connector.Get(); hasConnector {
+				mergedSHA, err := connector.MergedProposalSHA(localName, parent)
+				if err != nil {
+					return result, err
+				}
+				// the merged proposal might have contained an earlier branch with the same name
+				if mergedSHA, hasMergedSHA := mergedSHA.Get(); hasMergedSHA {
+					isMerged = branch.IsAt(mergedSHA)
+				}
+			}
+		}
+		if isMerged {
+			result = append(result, localName)
+		}
+	}
+	return result, nil
+}
+
+// onlineConnector provides the connector to the code hosting platform, if Git Town is online and the platform is known.
+func onlineConnector(validatedConfig config.ValidatedConfig, repo execute.OpenRepoResult) (Option[hostingdomain.Connector], error) {
+	if validatedConfig.Config.Offline.Bool() {
+		return None[hostingdomain.Connector](), nil
+	}
+	originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get()
+	if !hasOriginURL {
+		return None[hostingdomain.Connector](), nil
+	}
+	return hosting.NewConnector(hosting.NewConnectorArgs{
+		Config:          *validatedConfig.Config.UnvalidatedConfig,
+		HostingPlatform: validatedConfig.Config.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       originURL,
+		PushURL:         validatedConfig.PushRemoteURL(),
+	})
+}
+
+// pendingPushesIn provides the given pending pushes that are among the given synced branches.
+func pendingPushesIn(pendingPushes, syncedBranches gitdomain.LocalBranchNames) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, pendingPush := range pendingPushes {
+		if syncedBranches.Contains(pendingPush) {
+			result = append(result, pendingPush)
+		}
+	}
+	return result
 }
 
 // cleanupPerennialParentEntries removes outdated entries from the configuration.
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const topDesc = "Switch to the youngest branch of the current stack"
+
+const topHelp = `
+Checks out the descendant of the current branch
+that has no child branches itself.
+If there are several such branches,
+asks which one to check out.`
+
+func topCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "top",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   topDesc,
+		Long:    cmdhelpers.Long(topDesc, topHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeTop(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeTop(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineNavigateData(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	leaves := data.existingBranches(data.lineage.Leaves(data.initialBranch))
+	if len(leaves) == 0 {
+		fmt.Printf(messages.NavigateAlreadyAtTop, data.initialBranch)
+		return nil
+	}
+	return navigateTo(repo, data, leaves)
+}
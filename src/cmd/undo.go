@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -17,57 +18,83 @@ import (
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/undo"
 	"github.com/git-town/git-town/v14/src/validate"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
 	"github.com/git-town/git-town/v14/src/vm/statefile"
 	"github.com/spf13/cobra"
 )
 
 const undoDesc = "Undo the most recent Git Town command"
 
+const undoHelp = `
+Git Town remembers the %d most recent commands in each repository.
+"--steps" undoes several of them, starting with the most recent one.
+An older command can only be undone together with all commands that ran after it.
+Run "git town history" to see the commands that can be undone.`
+
 func undoCmd() *cobra.Command {
+	addStepsFlag, readStepsFlag := flags.Steps()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "undo",
 		GroupID: "errors",
 		Args:    cobra.NoArgs,
 		Short:   undoDesc,
-		Long:    cmdhelpers.Long(undoDesc),
+		Long:    cmdhelpers.Long(undoDesc, fmt.Sprintf(undoHelp, runstate.HistoryMaxLength)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeUndo(readVerboseFlag(cmd))
+			return executeUndo(readStepsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addStepsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeUndo(verbose bool) error {
-	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		OmitBranchNames:  false,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
-	})
-	if err != nil {
-		return err
+func executeUndo(steps uint, verbose bool) error {
+	if steps == 0 {
+		return errors.New(messages.UndoStepsZero)
+	}
+	for step := uint(0); step < steps; step++ {
+		repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+			DryRun:           false,
+			OmitBranchNames:  false,
+			PrintCommands:    true,
+			ValidateGitRepo:  true,
+			ValidateIsOnline: false,
+			Verbose:          verbose,
+		})
+		if err != nil {
+			return err
+		}
+		undoable, err := statefile.Undoable(repo.RootDir)
+		if err != nil {
+			return fmt.Errorf(messages.RunstateLoadProblem, err)
+		}
+		if len(undoable) == 0 {
+			fmt.Println(messages.UndoNothingToDo)
+			return nil
+		}
+		if step == 0 && uint(len(undoable)) < steps {
+			return fmt.Errorf(messages.UndoStepsTooMany, steps, len(undoable))
+		}
+		err = undoRunState(undoable[0].RunState, repo, verbose)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// undoes the given runstate
+func undoRunState(runState runstate.RunState, repo execute.OpenRepoResult, verbose bool) error {
 	data, exit, err := determineUndoData(repo, verbose)
 	if err != nil || exit {
 		return err
 	}
-	runStateOpt, err := statefile.Load(repo.RootDir)
-	if err != nil {
-		return fmt.Errorf(messages.RunstateLoadProblem, err)
-	}
-	runState, hasRunState := runStateOpt.Get()
-	if !hasRunState {
-		fmt.Println(messages.UndoNothingToDo)
-		return nil
-	}
 	return undo.Execute(undo.ExecuteArgs{
 		Backend:          repo.Backend,
 		CommandsCounter:  repo.CommandsCounter,
 		Config:           data.config,
+		Connector:        data.connector,
 		FinalMessages:    repo.FinalMessages,
 		Frontend:         repo.Frontend,
 		Git:              repo.Git,
@@ -104,6 +131,7 @@ func determineUndoData(repo execute.OpenRepoResult, verbose bool) (undoData, boo
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
 		Fetch:                 false,
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
@@ -137,12 +165,13 @@ func determineUndoData(repo execute.OpenRepoResult, verbose bool) (undoData, boo
 	}
 	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
 	var connector Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if originURL, hasOriginURL := validatedConfig.ProposalRemoteURL().Get(); hasOriginURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
 			Config:          *repo.UnvalidatedConfig.Config,
 			HostingPlatform: repo.UnvalidatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
 			OriginURL:       originURL,
+			PushURL:         validatedConfig.PushRemoteURL(),
 		})
 		if err != nil {
 			return emptyUndoData(), false, err
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const upDesc = "Switch to the child branch of the current branch"
+
+const upHelp = `
+Checks out the child branch of the current branch.
+If the current branch has several child branches,
+asks which one to check out.`
+
+func upCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "up",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   upDesc,
+		Long:    cmdhelpers.Long(upDesc, upHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeUp(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeUp(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineNavigateData(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	children := data.existingBranches(data.lineage.Children(data.initialBranch))
+	if len(children) == 0 {
+		return fmt.Errorf(messages.NavigateNoChild, data.initialBranch)
+	}
+	return navigateTo(repo, data, children)
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/flags"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v14/src/config"
+	"github.com/git-town/git-town/v14/src/execute"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	"github.com/git-town/git-town/v14/src/validate"
+	fullInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const walkDesc = "Run a shell command on every branch in the current stack"
+
+const walkHelp = `
+Checks out each branch in the stack of the current branch,
+from the oldest to the youngest branch,
+and runs the given shell command on it.
+Returns to the current branch when done.
+
+If the shell command fails on a branch, this command stops.
+Fix the problem and run "git town continue" to proceed with the next branch,
+or run "git town skip" to undo the changes to the failing branch and move on.
+
+With the --commit switch, this command commits the changes
+that the shell command made on a branch,
+using the shell command as the commit message.
+
+Example: git town walk make test`
+
+func walkCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPlanFlag, readPlanFlag := flags.Plan()
+	addCommitFlag, readCommitFlag := flags.Bool("commit", "c", "Commit the changes made by the shell command", flags.FlagTypeNonPersistent)
+	cmd := cobra.Command{
+		Use:     runstate.WalkCommand + " <command> [<arguments>...]",
+		Aliases: []string{"each"},
+		GroupID: "lineage",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   walkDesc,
+		Long:    cmdhelpers.Long(walkDesc, walkHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plan, err := readPlanFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeWalk(args, readCommitFlag(cmd), readDryRunFlag(cmd), plan, readVerboseFlag(cmd))
+		},
+	}
+	// all arguments after the shell command belong to the shell command
+	cmd.Flags().SetInterspersed(false)
+	addCommitFlag(&cmd)
+	addDryRunFlag(&cmd)
+	addPlanFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeWalk(args []string, commit, dryRun bool, plan Option[report.Format], verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineWalkData(args, commit, repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               runstate.WalkCommand,
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            walkProgram(data),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               None[hostingdomain.Connector](),
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Plan:                    plan,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type walkData struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	branchesToWalk   gitdomain.LocalBranchNames
+	commit           bool
+	config           config.ValidatedConfig
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   Option[gitdomain.LocalBranchName]
+	shellCommand     string
+	stashSize        gitdomain.StashSize
+}
+
+func determineWalkData(args []string, commit bool, repo execute.OpenRepoResult, dryRun, verbose bool) (*walkData, bool, error) {
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return nil, false, err
+	}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		FallbackToOffline:     false,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return nil, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	branchesToWalk := gitdomain.LocalBranchNames{}
+	for _, branch := range validatedConfig.Config.Lineage.BranchLineageWithoutRoot(initialBranch) {
+		branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(branch).Get()
+		if !hasBranchInfo || branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+			continue
+		}
+		branchesToWalk = append(branchesToWalk, branch)
+	}
+	if len(branchesToWalk) == 0 {
+		return nil, false, fmt.Errorf(messages.WalkNoBranches, initialBranch)
+	}
+	return &walkData{
+		branchesSnapshot: branchesSnapshot,
+		branchesToWalk:   branchesToWalk,
+		commit:           commit,
+		config:           validatedConfig,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		previousBranch:   previousBranch,
+		shellCommand:     shellScript(args),
+		stashSize:        stashSize,
+	}, false, nil
+}
+
+func walkProgram(data *walkData) program.Program {
+	prog := program.Program{}
+	for _, branch := range data.branchesToWalk {
+		prog.Add(&opcodes.Checkout{Branch: branch})
+		prog.Add(&opcodes.ExecuteShellCommand{Command: data.shellCommand})
+		if data.commit {
+			prog.Add(&opcodes.CommitOpenChangesIfAny{Message: data.shellCommand})
+		}
+		prog.Add(&opcodes.EndOfBranchProgram{})
+	}
+	prog.Add(&opcodes.Checkout{Branch: data.initialBranch})
+	previousBranchCandidates := gitdomain.LocalBranchNames{}
+	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch {
+		previousBranchCandidates = append(previousBranchCandidates, previousBranch)
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return prog
+}
+
+// shellScript provides the shell script that executes the given command-line arguments.
+// A single argument is the script itself.
+// Multiple arguments are the words of a command, which the script quotes to preserve them as given.
+func shellScript(args []string) string {
+	if len(args) == 1 {
+		return args[0]
+	}
+	words := make([]string, len(args))
+	for a, arg := range args {
+		words[a] = shellQuote(arg)
+	}
+	return strings.Join(words, " ")
+}
+
+// shellQuote quotes the given word so that the shell doesn't interpret the characters in it.
+func shellQuote(word string) string {
+	isSafe := func(char rune) bool {
+		return (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9') || strings.ContainsRune("%+,-./:=@_", char)
+	}
+	if word != "" && strings.IndexFunc(word, func(char rune) bool { return !isSafe(char) }) == -1 {
+		return word
+	}
+	return "'" + strings.ReplaceAll(word, "'", `'"'"'`) + "'"
+}
@@ -0,0 +1,30 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// AzureDevOpsToken is a personal access token to use with the Azure DevOps API.
+type AzureDevOpsToken string
+
+func (self AzureDevOpsToken) String() string {
+	return string(self)
+}
+
+func NewAzureDevOpsToken(value string) AzureDevOpsToken {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		panic("empty Azure DevOps token")
+	}
+	return AzureDevOpsToken(value)
+}
+
+func NewAzureDevOpsTokenOption(value string) Option[AzureDevOpsToken] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[AzureDevOpsToken]()
+	}
+	return Some(NewAzureDevOpsToken(value))
+}
@@ -0,0 +1,30 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// BitbucketAppPassword is an app password to use with the Bitbucket API.
+type BitbucketAppPassword string
+
+func (self BitbucketAppPassword) String() string {
+	return string(self)
+}
+
+func NewBitbucketAppPassword(value string) BitbucketAppPassword {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		panic("empty Bitbucket app password")
+	}
+	return BitbucketAppPassword(value)
+}
+
+func NewBitbucketAppPasswordOption(value string) Option[BitbucketAppPassword] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[BitbucketAppPassword]()
+	}
+	return Some(NewBitbucketAppPassword(value))
+}
@@ -0,0 +1,30 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// BitbucketUsername is the name of the Bitbucket user to authenticate against the Bitbucket API.
+type BitbucketUsername string
+
+func (self BitbucketUsername) String() string {
+	return string(self)
+}
+
+func NewBitbucketUsername(value string) BitbucketUsername {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		panic("empty Bitbucket username")
+	}
+	return BitbucketUsername(value)
+}
+
+func NewBitbucketUsernameOption(value string) Option[BitbucketUsername] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[BitbucketUsername]()
+	}
+	return Some(NewBitbucketUsername(value))
+}
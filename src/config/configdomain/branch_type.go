@@ -1,6 +1,9 @@
 package configdomain
 
-import "github.com/git-town/git-town/v14/src/git/gitdomain"
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
 
 type BranchType int
 
@@ -11,24 +14,56 @@ const (
 	BranchTypeParkedBranch
 	BranchTypeContributionBranch
 	BranchTypeObservedBranch
+	BranchTypePrototypeBranch
 )
 
 func NewBranchType(name string) BranchType {
+	if branchType, has := ParseBranchType(name).Get(); has {
+		return branchType
+	}
+	panic("unhandled branch type: " + name)
+}
+
+// ParseBranchType provides the BranchType with the given stable name, if one exists.
+func ParseBranchType(name string) Option[BranchType] {
 	switch name {
 	case "contribution":
-		return BranchTypeContributionBranch
+		return Some(BranchTypeContributionBranch)
 	case "feature":
-		return BranchTypeFeatureBranch
+		return Some(BranchTypeFeatureBranch)
 	case "main":
-		return BranchTypeMainBranch
+		return Some(BranchTypeMainBranch)
 	case "observed":
-		return BranchTypeObservedBranch
+		return Some(BranchTypeObservedBranch)
 	case "parked":
-		return BranchTypeParkedBranch
+		return Some(BranchTypeParkedBranch)
 	case "perennial":
-		return BranchTypePerennialBranch
+		return Some(BranchTypePerennialBranch)
+	case "prototype":
+		return Some(BranchTypePrototypeBranch)
 	}
-	panic("unhandled branch type: " + name)
+	return None[BranchType]()
+}
+
+// Name provides the stable name of this branch type.
+func (self BranchType) Name() string {
+	switch self {
+	case BranchTypeMainBranch:
+		return "main"
+	case BranchTypePerennialBranch:
+		return "perennial"
+	case BranchTypeFeatureBranch:
+		return "feature"
+	case BranchTypeParkedBranch:
+		return "parked"
+	case BranchTypeContributionBranch:
+		return "contribution"
+	case BranchTypeObservedBranch:
+		return "observed"
+	case BranchTypePrototypeBranch:
+		return "prototype"
+	}
+	panic("unhandled branch type")
 }
 
 // ShouldPush indicates whether a branch with this type should push its local commit to origin.
@@ -36,7 +71,7 @@ func (self BranchType) ShouldPush(currentBranch, initialBranch gitdomain.LocalBr
 	switch self {
 	case BranchTypeMainBranch, BranchTypeFeatureBranch, BranchTypePerennialBranch, BranchTypeContributionBranch:
 		return true
-	case BranchTypeObservedBranch:
+	case BranchTypeObservedBranch, BranchTypePrototypeBranch:
 		return false
 	case BranchTypeParkedBranch:
 		return currentBranch == initialBranch
@@ -58,6 +93,8 @@ func (self BranchType) String() string {
 		return "contribution branch"
 	case BranchTypeObservedBranch:
 		return "observed branch"
+	case BranchTypePrototypeBranch:
+		return "prototype branch"
 	}
 	panic("unhandled branch type")
 }
@@ -0,0 +1,98 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// BranchTypeRule assigns the given branch type to all branches whose name matches the given regex.
+type BranchTypeRule struct {
+	Regex *regexp.Regexp
+	Type  BranchType
+}
+
+// MatchesBranch indicates whether the given branch matches this BranchTypeRule.
+func (self BranchTypeRule) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return self.Regex.MatchString(branch.String())
+}
+
+// String provides the serialized form of this BranchTypeRule, as used in the Git configuration.
+func (self BranchTypeRule) String() string {
+	return self.Regex.String() + "=" + self.Type.Name()
+}
+
+// NewBranchTypeRule provides a BranchTypeRule with the given regex and branch type name.
+func NewBranchTypeRule(regex, typeName string) (BranchTypeRule, error) {
+	regex = strings.TrimSpace(regex)
+	typeName = strings.TrimSpace(typeName)
+	if regex == "" {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleInvalid, regex+"="+typeName) //exhaustruct:ignore
+	}
+	compiled, err := regexp.Compile(regex)
+	if err != nil {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleInvalidRegex, regex, err) //exhaustruct:ignore
+	}
+	branchType, hasBranchType := ParseBranchType(typeName).Get()
+	if !hasBranchType || branchType == BranchTypeMainBranch {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleUnknownType, typeName, regex) //exhaustruct:ignore
+	}
+	return BranchTypeRule{
+		Regex: compiled,
+		Type:  branchType,
+	}, nil
+}
+
+// ParseBranchTypeRule parses a BranchTypeRule in the format "<regex>=<type>".
+func ParseBranchTypeRule(text string) (BranchTypeRule, error) {
+	index := strings.LastIndex(text, "=")
+	if index < 0 {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleInvalid, text) //exhaustruct:ignore
+	}
+	return NewBranchTypeRule(text[:index], text[index+1:])
+}
+
+// BranchTypeRules is an ordered list of BranchTypeRule instances.
+// The first rule that matches a branch determines its type.
+type BranchTypeRules []BranchTypeRule
+
+// BranchType provides the type of the first rule matching the given branch.
+func (self BranchTypeRules) BranchType(branch gitdomain.LocalBranchName) Option[BranchType] {
+	for _, rule := range self {
+		if rule.MatchesBranch(branch) {
+			return Some(rule.Type)
+		}
+	}
+	return None[BranchType]()
+}
+
+// String provides the serialized form of these BranchTypeRules, as used in the Git configuration.
+func (self BranchTypeRules) String() string {
+	return strings.Join(self.Strings(), " ")
+}
+
+func (self BranchTypeRules) Strings() []string {
+	result := make([]string, len(self))
+	for r, rule := range self {
+		result[r] = rule.String()
+	}
+	return result
+}
+
+// ParseBranchTypeRules parses the whitespace-separated BranchTypeRules in the given text.
+func ParseBranchTypeRules(text string) (BranchTypeRules, error) {
+	fields := strings.Fields(text)
+	result := make(BranchTypeRules, 0, len(fields))
+	for _, field := range fields {
+		rule, err := ParseBranchTypeRule(field)
+		if err != nil {
+			return BranchTypeRules{}, err
+		}
+		result = append(result, rule)
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchTypeRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BranchType", func(t *testing.T) {
+		t.Parallel()
+		rules, err := configdomain.ParseBranchTypeRules("dependabot/.*=observed release/.*=perennial user/alice/.*=feature user/.*=prototype")
+		must.NoError(t, err)
+		tests := map[string]Option[configdomain.BranchType]{
+			"dependabot/go-1.22": Some(configdomain.BranchTypeObservedBranch),
+			"release/v1":         Some(configdomain.BranchTypePerennialBranch),
+			"user/alice/spike":   Some(configdomain.BranchTypeFeatureBranch),
+			"user/bob/spike":     Some(configdomain.BranchTypePrototypeBranch),
+			"feature":            None[configdomain.BranchType](),
+		}
+		for give, want := range tests {
+			have := rules.BranchType(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
+	t.Run("ParseBranchTypeRules", func(t *testing.T) {
+		t.Parallel()
+		t.Run("multiple rules", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchTypeRules(" dependabot/.*=observed\n release/.*=perennial ")
+			must.NoError(t, err)
+			must.Eq(t, []string{"dependabot/.*=observed", "release/.*=perennial"}, have.Strings())
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchTypeRules("")
+			must.NoError(t, err)
+			must.Len(t, 0, have)
+		})
+		t.Run("regex containing an equal sign", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchTypeRules("a=b.*=parked")
+			must.NoError(t, err)
+			must.EqOp(t, "a=b.*=parked", have.String())
+			must.Eq(t, Some(configdomain.BranchTypeParkedBranch), have.BranchType("a=b1"))
+		})
+		t.Run("missing type", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchTypeRules("dependabot/.*")
+			must.Error(t, err)
+		})
+		t.Run("unknown type", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchTypeRules("dependabot/.*=zonk")
+			must.Error(t, err)
+		})
+		t.Run("main type", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchTypeRules("trunk=main")
+			must.Error(t, err)
+		})
+		t.Run("invalid regex", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchTypeRules("dependabot/(=observed")
+			must.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,41 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v14/src/cli/colors"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// ContributionRegex contains the "branches.contribution-regex" setting.
+type ContributionRegex string
+
+// MatchesBranch indicates whether the given branch matches this ContributionRegex.
+func (self ContributionRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	if self == "" {
+		return false
+	}
+	re, err := regexp.Compile(string(self))
+	if err != nil {
+		fmt.Println(colors.Red().Styled(fmt.Sprintf("Error in contribution regex %q: %s", self, err.Error())))
+		return false
+	}
+	return re.MatchString(branch.String())
+}
+
+func (self ContributionRegex) String() string {
+	return string(self)
+}
+
+func NewContributionRegex(value string) ContributionRegex {
+	return ContributionRegex(value)
+}
+
+func NewContributionRegexOption(value string) Option[ContributionRegex] {
+	if value == "" {
+		return None[ContributionRegex]()
+	}
+	return Some(NewContributionRegex(value))
+}
@@ -0,0 +1,30 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// ForgejoToken is a bearer token to use with the Forgejo API.
+type ForgejoToken string
+
+func (self ForgejoToken) String() string {
+	return string(self)
+}
+
+func NewForgejoToken(value string) ForgejoToken {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		panic("empty Forgejo token")
+	}
+	return ForgejoToken(value)
+}
+
+func NewForgejoTokenOption(value string) Option[ForgejoToken] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[ForgejoToken]()
+	}
+	return Some(NewForgejoToken(value))
+}
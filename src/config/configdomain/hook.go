@@ -0,0 +1,26 @@
+package configdomain
+
+// Hook defines the points in the lifecycle of Git Town commands
+// at which Git Town runs user-defined shell commands.
+type Hook string
+
+func (self Hook) String() string { return string(self) }
+
+const (
+	HookPostHack       = Hook("post-hack")        // runs after "git town hack" created a new branch
+	HookPostShip       = Hook("post-ship")        // runs after "git town ship" shipped a branch
+	HookPostSyncBranch = Hook("post-sync-branch") // runs after "git town sync" synced a branch
+	HookPreShip        = Hook("pre-ship")         // runs before "git town ship" ships a branch
+	HookPreSync        = Hook("pre-sync")         // runs before "git town sync" syncs branches
+)
+
+// AllHooks provides all Hook values.
+func AllHooks() []Hook {
+	return []Hook{
+		HookPreSync,
+		HookPostSyncBranch,
+		HookPreShip,
+		HookPostShip,
+		HookPostHack,
+	}
+}
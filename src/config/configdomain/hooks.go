@@ -0,0 +1,15 @@
+package configdomain
+
+import . "github.com/git-town/git-town/v14/src/gohacks/prelude"
+
+// Hooks contains the user-defined shell commands to run at the respective lifecycle hooks.
+type Hooks map[Hook]string
+
+// Command provides the shell command configured for the given hook.
+func (self Hooks) Command(hook Hook) Option[string] {
+	command, has := self[hook]
+	if !has || command == "" {
+		return None[string]()
+	}
+	return Some(command)
+}
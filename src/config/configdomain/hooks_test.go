@@ -0,0 +1,24 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Command", func(t *testing.T) {
+		t.Parallel()
+		hooks := configdomain.Hooks{
+			configdomain.HookPreSync:  "make generate",
+			configdomain.HookPostShip: "",
+		}
+		must.Eq(t, Some("make generate"), hooks.Command(configdomain.HookPreSync))
+		must.Eq(t, None[string](), hooks.Command(configdomain.HookPostShip))
+		must.Eq(t, None[string](), hooks.Command(configdomain.HookPreShip))
+	})
+}
@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// HostingExternalCommand is the shell command that implements the "external" hosting platform.
+type HostingExternalCommand string
+
+func (self HostingExternalCommand) String() string {
+	return string(self)
+}
+
+func NewHostingExternalCommandOption(value string) Option[HostingExternalCommand] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[HostingExternalCommand]()
+	}
+	return Some(HostingExternalCommand(value))
+}
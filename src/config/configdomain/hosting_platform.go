@@ -14,10 +14,13 @@ type HostingPlatform string
 func (self HostingPlatform) String() string { return string(self) }
 
 const (
-	HostingPlatformBitbucket = HostingPlatform("bitbucket")
-	HostingPlatformGitHub    = HostingPlatform("github")
-	HostingPlatformGitLab    = HostingPlatform("gitlab")
-	HostingPlatformGitea     = HostingPlatform("gitea")
+	HostingPlatformAzureDevOps = HostingPlatform("azure-devops")
+	HostingPlatformBitbucket   = HostingPlatform("bitbucket")
+	HostingPlatformExternal    = HostingPlatform("external") // a user-provided executable talks to the hosting platform
+	HostingPlatformForgejo     = HostingPlatform("forgejo")
+	HostingPlatformGitHub      = HostingPlatform("github")
+	HostingPlatformGitLab      = HostingPlatform("gitlab")
+	HostingPlatformGitea       = HostingPlatform("gitea")
 )
 
 // NewHostingPlatform provides the HostingPlatform enum matching the given text.
@@ -43,7 +46,10 @@ func NewHostingPlatformOption(platformName string) (Option[HostingPlatform], err
 // hostingPlatforms provides all legal values for HostingPlatform.
 func hostingPlatforms() []HostingPlatform {
 	return []HostingPlatform{
+		HostingPlatformAzureDevOps,
 		HostingPlatformBitbucket,
+		HostingPlatformExternal,
+		HostingPlatformForgejo,
 		HostingPlatformGitHub,
 		HostingPlatformGitLab,
 		HostingPlatformGitea,
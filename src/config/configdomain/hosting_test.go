@@ -13,14 +13,19 @@ func TestNewHostingPlatform(t *testing.T) {
 	t.Run("valid content", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]configdomain.HostingPlatform{
-			"bitbucket": configdomain.HostingPlatformBitbucket,
-			"BitBucket": configdomain.HostingPlatformBitbucket,
-			"github":    configdomain.HostingPlatformGitHub,
-			"GitHub":    configdomain.HostingPlatformGitHub,
-			"gitlab":    configdomain.HostingPlatformGitLab,
-			"GitLab":    configdomain.HostingPlatformGitLab,
-			"gitea":     configdomain.HostingPlatformGitea,
-			"Gitea":     configdomain.HostingPlatformGitea,
+			"azure-devops": configdomain.HostingPlatformAzureDevOps,
+			"Azure-DevOps": configdomain.HostingPlatformAzureDevOps,
+			"bitbucket":    configdomain.HostingPlatformBitbucket,
+			"BitBucket":    configdomain.HostingPlatformBitbucket,
+			"external":     configdomain.HostingPlatformExternal,
+			"forgejo":      configdomain.HostingPlatformForgejo,
+			"Forgejo":      configdomain.HostingPlatformForgejo,
+			"github":       configdomain.HostingPlatformGitHub,
+			"GitHub":       configdomain.HostingPlatformGitHub,
+			"gitlab":       configdomain.HostingPlatformGitLab,
+			"GitLab":       configdomain.HostingPlatformGitLab,
+			"gitea":        configdomain.HostingPlatformGitea,
+			"Gitea":        configdomain.HostingPlatformGitea,
 		}
 		for give, want := range tests {
 			have, err := configdomain.NewHostingPlatform(give)
@@ -54,6 +54,12 @@ func (self Lineage) BranchAndAncestors(branchName gitdomain.LocalBranchName) git
 	return append(self.Ancestors(branchName), branchName)
 }
 
+// BranchLineage provides all branches in the lineage of the given branch,
+// from oldest to youngest, including the root and the given branch.
+func (self Lineage) BranchLineage(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	return append(self.BranchAndAncestors(branch), self.Descendants(branch)...)
+}
+
 // BranchLineageWithoutRoot provides all branches in the lineage of the given branch,
 // from oldest to youngest, including the given branch.
 func (self Lineage) BranchLineageWithoutRoot(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
@@ -138,6 +144,17 @@ func (self Lineage) IsEmpty() bool {
 	return self.data == nil || self.Len() == 0
 }
 
+// Leaves provides the descendants of the given branch that have no children themselves.
+func (self Lineage) Leaves(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, descendant := range self.Descendants(branch) {
+		if len(self.Children(descendant)) == 0 {
+			result = append(result, descendant)
+		}
+	}
+	return result
+}
+
 func (self Lineage) Len() int {
 	return len(self.data)
 }
@@ -111,6 +111,42 @@ func TestLineage(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("BranchLineage", func(t *testing.T) {
+		t.Parallel()
+		t.Run("only root exists", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.Lineage{}
+			have := lineage.BranchLineage(main)
+			want := gitdomain.LocalBranchNames{main}
+			must.Eq(t, want, have)
+		})
+		t.Run("multiple branches", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.NewLineage()
+			lineage.Add(one, main)
+			lineage.Add(two, one)
+			lineage.Add(three, main)
+			t.Run("given root", func(t *testing.T) {
+				t.Parallel()
+				have := lineage.BranchLineage(main)
+				want := gitdomain.LocalBranchNames{main, one, two, three}
+				must.Eq(t, want, have)
+			})
+			t.Run("given middle branch", func(t *testing.T) {
+				t.Parallel()
+				have := lineage.BranchLineage(one)
+				want := gitdomain.LocalBranchNames{main, one, two}
+				must.Eq(t, want, have)
+			})
+			t.Run("given leaf branch", func(t *testing.T) {
+				t.Parallel()
+				have := lineage.BranchLineage(two)
+				want := gitdomain.LocalBranchNames{main, one, two}
+				must.Eq(t, want, have)
+			})
+		})
+	})
+
 	t.Run("BranchLineageWithoutRoot", func(t *testing.T) {
 		t.Parallel()
 		t.Run("only root exists", func(t *testing.T) {
@@ -409,6 +445,36 @@ func TestLineage(t *testing.T) {
 		})
 	})
 
+	t.Run("Leaves", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch has no children", func(t *testing.T) {
+			t.Parallel()
+			branch := gitdomain.NewLocalBranchName("branch")
+			lineage := configdomain.NewLineage()
+			lineage.Add(branch, main)
+			have := lineage.Leaves(branch)
+			want := gitdomain.LocalBranchNames{}
+			must.Eq(t, want, have)
+		})
+		t.Run("branch has descendants", func(t *testing.T) {
+			t.Parallel()
+			branch := gitdomain.NewLocalBranchName("branch")
+			child1 := gitdomain.NewLocalBranchName("child1")
+			child1a := gitdomain.NewLocalBranchName("child1a")
+			child2 := gitdomain.NewLocalBranchName("child2")
+			other := gitdomain.NewLocalBranchName("other")
+			lineage := configdomain.NewLineage()
+			lineage.Add(branch, main)
+			lineage.Add(child1, branch)
+			lineage.Add(child1a, child1)
+			lineage.Add(child2, branch)
+			lineage.Add(other, main)
+			have := lineage.Leaves(branch)
+			want := gitdomain.LocalBranchNames{child1a, child2}
+			must.Eq(t, want, have)
+		})
+	})
+
 	t.Run("Len", func(t *testing.T) {
 		t.Parallel()
 		t.Run("empty", func(t *testing.T) {
@@ -0,0 +1,41 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v14/src/cli/colors"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// ObservedRegex contains the "branches.observed-regex" setting.
+type ObservedRegex string
+
+// MatchesBranch indicates whether the given branch matches this ObservedRegex.
+func (self ObservedRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	if self == "" {
+		return false
+	}
+	re, err := regexp.Compile(string(self))
+	if err != nil {
+		fmt.Println(colors.Red().Styled(fmt.Sprintf("Error in observed regex %q: %s", self, err.Error())))
+		return false
+	}
+	return re.MatchString(branch.String())
+}
+
+func (self ObservedRegex) String() string {
+	return string(self)
+}
+
+func NewObservedRegex(value string) ObservedRegex {
+	return ObservedRegex(value)
+}
+
+func NewObservedRegexOption(value string) Option[ObservedRegex] {
+	if value == "" {
+		return None[ObservedRegex]()
+	}
+	return Some(NewObservedRegex(value))
+}
@@ -8,24 +8,39 @@ import (
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         Option[AzureDevOpsToken]
+	BitbucketAppPassword     Option[BitbucketAppPassword]
+	BitbucketUsername        Option[BitbucketUsername]
+	BranchTypeRules          BranchTypeRules
 	ContributionBranches     gitdomain.LocalBranchNames
+	ContributionRegex        Option[ContributionRegex]
+	FeatureBranches          gitdomain.LocalBranchNames
+	ForgejoToken             Option[ForgejoToken]
 	GitHubToken              Option[GitHubToken]
 	GitLabToken              Option[GitLabToken]
 	GitUserEmail             Option[GitUserEmail]
 	GitUserName              Option[GitUserName]
 	GiteaToken               Option[GiteaToken]
+	Hooks                    Hooks
+	HostingExternalCommand   Option[HostingExternalCommand]
 	HostingOriginHostname    Option[HostingOriginHostname]
 	HostingPlatform          Option[HostingPlatform]
 	Lineage                  Lineage
 	MainBranch               Option[gitdomain.LocalBranchName]
 	ObservedBranches         gitdomain.LocalBranchNames
+	ObservedRegex            Option[ObservedRegex]
 	Offline                  Option[Offline]
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           Option[PerennialRegex]
+	ProposalRemote           Option[gitdomain.Remote]
+	ProposalsShowLineage     Option[ProposalsShowLineage]
+	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 Option[PushHook]
 	PushNewBranches          Option[PushNewBranches]
+	PushRemote               Option[gitdomain.Remote]
 	ShipDeleteTrackingBranch Option[ShipDeleteTrackingBranch]
+	ShipStrategy             Option[ShipStrategy]
 	SyncBeforeShip           Option[SyncBeforeShip]
 	SyncFeatureStrategy      Option[SyncFeatureStrategy]
 	SyncPerennialStrategy    Option[SyncPerennialStrategy]
@@ -35,5 +50,6 @@ type PartialConfig struct {
 func EmptyPartialConfig() PartialConfig {
 	return PartialConfig{
 		Aliases: Aliases{},
+		Hooks:   Hooks{},
 	} //exhaustruct:ignore
 }
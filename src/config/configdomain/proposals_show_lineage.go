@@ -0,0 +1,37 @@
+package configdomain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v14/src/gohacks"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// ProposalsShowLineage contains the configuration setting whether to embed the lineage of a branch into the body of its proposal.
+type ProposalsShowLineage bool
+
+func (self ProposalsShowLineage) Bool() bool {
+	return bool(self)
+}
+
+func (self ProposalsShowLineage) String() string {
+	return strconv.FormatBool(self.Bool())
+}
+
+func ParseProposalsShowLineage(value, source string) (ProposalsShowLineage, error) {
+	parsed, err := gohacks.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, source, value)
+	}
+	return ProposalsShowLineage(parsed), nil
+}
+
+func ParseProposalsShowLineageOption(value, source string) (Option[ProposalsShowLineage], error) {
+	result, err := ParseProposalsShowLineage(value, source)
+	if err != nil {
+		return None[ProposalsShowLineage](), err
+	}
+	return Some(result), err
+}
@@ -0,0 +1,53 @@
+package configdomain
+
+import (
+	"fmt"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// ShipStrategy defines legal values for the "ship-strategy" configuration setting.
+type ShipStrategy string
+
+func (self ShipStrategy) String() string { return string(self) }
+
+const (
+	ShipStrategyAPI         = ShipStrategy("api")          // merge the proposal via the API of the hosting platform
+	ShipStrategyFastForward = ShipStrategy("fast-forward") // fast-forward the parent branch to the shipped branch
+	ShipStrategyMerge       = ShipStrategy("merge")        // merge the shipped branch into its parent with a merge commit
+	ShipStrategySquashMerge = ShipStrategy("squash-merge") // squash-merge the shipped branch into its parent
+)
+
+// ShipStrategies provides all legal values for ShipStrategy.
+func ShipStrategies() []ShipStrategy {
+	return []ShipStrategy{
+		ShipStrategySquashMerge,
+		ShipStrategyMerge,
+		ShipStrategyFastForward,
+		ShipStrategyAPI,
+	}
+}
+
+func NewShipStrategy(text string) (ShipStrategy, error) {
+	switch text {
+	case "squash-merge", "":
+		return ShipStrategySquashMerge, nil
+	case "merge":
+		return ShipStrategyMerge, nil
+	case "fast-forward":
+		return ShipStrategyFastForward, nil
+	case "api":
+		return ShipStrategyAPI, nil
+	default:
+		return ShipStrategySquashMerge, fmt.Errorf(messages.ConfigShipStrategyUnknown, text)
+	}
+}
+
+func NewShipStrategyOption(text string) (Option[ShipStrategy], error) {
+	result, err := NewShipStrategy(text)
+	if err != nil {
+		return None[ShipStrategy](), err
+	}
+	return Some(result), err
+}
@@ -0,0 +1,34 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewShipStrategy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid values", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]configdomain.ShipStrategy{
+			"":             configdomain.ShipStrategySquashMerge,
+			"squash-merge": configdomain.ShipStrategySquashMerge,
+			"merge":        configdomain.ShipStrategyMerge,
+			"fast-forward": configdomain.ShipStrategyFastForward,
+			"api":          configdomain.ShipStrategyAPI,
+		}
+		for give, want := range tests {
+			have, err := configdomain.NewShipStrategy(give)
+			must.NoError(t, err)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		_, err := configdomain.NewShipStrategy("zonk")
+		must.EqError(t, err, `unknown ship strategy: "zonk"`)
+	})
+}
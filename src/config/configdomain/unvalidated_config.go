@@ -1,6 +1,8 @@
 package configdomain
 
 import (
+	"slices"
+
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/gohacks/slice"
@@ -11,24 +13,39 @@ import (
 // If you need this information, validate it into a ValidatedConfig.
 type UnvalidatedConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         Option[AzureDevOpsToken]
+	BitbucketAppPassword     Option[BitbucketAppPassword]
+	BitbucketUsername        Option[BitbucketUsername]
+	BranchTypeRules          BranchTypeRules
 	ContributionBranches     gitdomain.LocalBranchNames
+	ContributionRegex        Option[ContributionRegex]
+	FeatureBranches          gitdomain.LocalBranchNames
+	ForgejoToken             Option[ForgejoToken]
 	GitHubToken              Option[GitHubToken]
 	GitLabToken              Option[GitLabToken]
 	GitUserEmail             Option[GitUserEmail]
 	GitUserName              Option[GitUserName]
 	GiteaToken               Option[GiteaToken]
+	Hooks                    Hooks
+	HostingExternalCommand   Option[HostingExternalCommand]
 	HostingOriginHostname    Option[HostingOriginHostname]
 	HostingPlatform          Option[HostingPlatform] // Some = override by user, None = auto-detect
 	Lineage                  Lineage
 	MainBranch               Option[gitdomain.LocalBranchName]
 	ObservedBranches         gitdomain.LocalBranchNames
+	ObservedRegex            Option[ObservedRegex]
 	Offline                  Offline
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           Option[PerennialRegex]
+	ProposalRemote           gitdomain.Remote // the remote whose repository receives proposals
+	ProposalsShowLineage     ProposalsShowLineage
+	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 PushHook
 	PushNewBranches          PushNewBranches
+	PushRemote               gitdomain.Remote // the remote that feature branches get pushed to
 	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
+	ShipStrategy             ShipStrategy
 	SyncBeforeShip           SyncBeforeShip
 	SyncFeatureStrategy      SyncFeatureStrategy
 	SyncPerennialStrategy    SyncPerennialStrategy
@@ -36,19 +53,10 @@ type UnvalidatedConfig struct {
 }
 
 func (self *UnvalidatedConfig) BranchType(branch gitdomain.LocalBranchName) BranchType {
-	switch {
-	case self.IsMainBranch(branch):
+	if self.IsMainBranch(branch) {
 		return BranchTypeMainBranch
-	case self.IsPerennialBranch(branch):
-		return BranchTypePerennialBranch
-	case self.IsContributionBranch(branch):
-		return BranchTypeContributionBranch
-	case self.IsObservedBranch(branch):
-		return BranchTypeObservedBranch
-	case self.IsParkedBranch(branch):
-		return BranchTypeParkedBranch
 	}
-	return BranchTypeFeatureBranch
+	return self.nonMainBranchType(branch)
 }
 
 // ContainsLineage indicates whether this configuration contains any lineage entries.
@@ -57,7 +65,7 @@ func (self *UnvalidatedConfig) ContainsLineage() bool {
 }
 
 func (self *UnvalidatedConfig) IsContributionBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ContributionBranches, branch)
+	return self.BranchType(branch) == BranchTypeContributionBranch
 }
 
 // IsMainBranch indicates whether the branch with the given name
@@ -76,7 +84,7 @@ func (self *UnvalidatedConfig) IsMainOrPerennialBranch(branch gitdomain.LocalBra
 }
 
 func (self *UnvalidatedConfig) IsObservedBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ObservedBranches, branch)
+	return self.BranchType(branch) == BranchTypeObservedBranch
 }
 
 func (self *UnvalidatedConfig) IsOnline() bool {
@@ -84,17 +92,15 @@ func (self *UnvalidatedConfig) IsOnline() bool {
 }
 
 func (self *UnvalidatedConfig) IsParkedBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ParkedBranches, branch)
+	return self.BranchType(branch) == BranchTypeParkedBranch
 }
 
 func (self *UnvalidatedConfig) IsPerennialBranch(branch gitdomain.LocalBranchName) bool {
-	if slice.Contains(self.PerennialBranches, branch) {
-		return true
-	}
-	if perennialRegex, has := self.PerennialRegex.Get(); has {
-		return perennialRegex.MatchesBranch(branch)
-	}
-	return false
+	return self.BranchType(branch) == BranchTypePerennialBranch
+}
+
+func (self *UnvalidatedConfig) IsPrototypeBranch(branch gitdomain.LocalBranchName) bool {
+	return self.BranchType(branch) == BranchTypePrototypeBranch
 }
 
 func (self *UnvalidatedConfig) MainAndPerennials() gitdomain.LocalBranchNames {
@@ -109,16 +115,40 @@ func (self *UnvalidatedConfig) Merge(other PartialConfig) {
 	for key, value := range other.Aliases {
 		self.Aliases[key] = value
 	}
+	for hook, command := range other.Hooks {
+		self.Hooks[hook] = command
+	}
 	for _, entry := range other.Lineage.Entries() {
 		self.Lineage.Add(entry.Child, entry.Parent)
 	}
+	if other.BitbucketAppPassword.IsSome() {
+		self.BitbucketAppPassword = other.BitbucketAppPassword
+	}
+	if other.BitbucketUsername.IsSome() {
+		self.BitbucketUsername = other.BitbucketUsername
+	}
+	// rules from more specific config sources go first so that they take precedence
+	self.BranchTypeRules = append(slices.Clone(other.BranchTypeRules), self.BranchTypeRules...)
 	self.ContributionBranches = append(self.ContributionBranches, other.ContributionBranches...)
+	if other.ContributionRegex.IsSome() {
+		self.ContributionRegex = other.ContributionRegex
+	}
+	if other.HostingExternalCommand.IsSome() {
+		self.HostingExternalCommand = other.HostingExternalCommand
+	}
 	if other.HostingOriginHostname.IsSome() {
 		self.HostingOriginHostname = other.HostingOriginHostname
 	}
 	if other.HostingPlatform.IsSome() {
 		self.HostingPlatform = other.HostingPlatform
 	}
+	if other.AzureDevOpsToken.IsSome() {
+		self.AzureDevOpsToken = other.AzureDevOpsToken
+	}
+	self.FeatureBranches = append(self.FeatureBranches, other.FeatureBranches...)
+	if other.ForgejoToken.IsSome() {
+		self.ForgejoToken = other.ForgejoToken
+	}
 	if other.GiteaToken.IsSome() {
 		self.GiteaToken = other.GiteaToken
 	}
@@ -141,6 +171,9 @@ func (self *UnvalidatedConfig) Merge(other PartialConfig) {
 		self.PushNewBranches = pushNewBranches
 	}
 	self.ObservedBranches = append(self.ObservedBranches, other.ObservedBranches...)
+	if other.ObservedRegex.IsSome() {
+		self.ObservedRegex = other.ObservedRegex
+	}
 	if offline, has := other.Offline.Get(); has {
 		self.Offline = offline
 	}
@@ -149,12 +182,25 @@ func (self *UnvalidatedConfig) Merge(other PartialConfig) {
 	if other.PerennialRegex.IsSome() {
 		self.PerennialRegex = other.PerennialRegex
 	}
+	if value, has := other.ProposalRemote.Get(); has {
+		self.ProposalRemote = value
+	}
+	if value, has := other.ProposalsShowLineage.Get(); has {
+		self.ProposalsShowLineage = value
+	}
+	self.PrototypeBranches = append(self.PrototypeBranches, other.PrototypeBranches...)
 	if value, has := other.PushHook.Get(); has {
 		self.PushHook = value
 	}
+	if value, has := other.PushRemote.Get(); has {
+		self.PushRemote = value
+	}
 	if value, has := other.ShipDeleteTrackingBranch.Get(); has {
 		self.ShipDeleteTrackingBranch = value
 	}
+	if value, has := other.ShipStrategy.Get(); has {
+		self.ShipStrategy = value
+	}
 	if value, has := other.SyncBeforeShip.Get(); has {
 		self.SyncBeforeShip = value
 	}
@@ -181,6 +227,51 @@ func (self *UnvalidatedConfig) Online() Online {
 	return self.Offline.ToOnline()
 }
 
+// nonMainBranchType classifies the given branch, which is known to not be the main branch.
+// Explicitly configured branch lists take precedence over branch type rules,
+// which take precedence over the perennial, contribution, and observed regexes.
+func (self *UnvalidatedConfig) nonMainBranchType(branch gitdomain.LocalBranchName) BranchType {
+	switch {
+	case slice.Contains(self.PerennialBranches, branch):
+		return BranchTypePerennialBranch
+	case slice.Contains(self.ContributionBranches, branch):
+		return BranchTypeContributionBranch
+	case slice.Contains(self.ObservedBranches, branch):
+		return BranchTypeObservedBranch
+	case slice.Contains(self.ParkedBranches, branch):
+		return BranchTypeParkedBranch
+	case slice.Contains(self.PrototypeBranches, branch):
+		return BranchTypePrototypeBranch
+	case slice.Contains(self.FeatureBranches, branch):
+		return BranchTypeFeatureBranch
+	}
+	if branchType, has := self.BranchTypeRules.BranchType(branch).Get(); has {
+		return branchType
+	}
+	if perennialRegex, has := self.PerennialRegex.Get(); has && perennialRegex.MatchesBranch(branch) {
+		return BranchTypePerennialBranch
+	}
+	if contributionRegex, has := self.ContributionRegex.Get(); has && contributionRegex.MatchesBranch(branch) {
+		return BranchTypeContributionBranch
+	}
+	if observedRegex, has := self.ObservedRegex.Get(); has && observedRegex.MatchesBranch(branch) {
+		return BranchTypeObservedBranch
+	}
+	return BranchTypeFeatureBranch
+}
+
+// TrackingRemote provides the remote that contains the tracking branch of the given branch.
+// Branches that the team shares track the origin remote,
+// the user's own branches track the push remote.
+func (self *UnvalidatedConfig) TrackingRemote(branch gitdomain.LocalBranchName) gitdomain.Remote {
+	switch self.BranchType(branch) {
+	case BranchTypeFeatureBranch, BranchTypeParkedBranch, BranchTypePrototypeBranch:
+		return self.PushRemote
+	case BranchTypeMainBranch, BranchTypePerennialBranch, BranchTypeContributionBranch, BranchTypeObservedBranch:
+	}
+	return gitdomain.RemoteOrigin
+}
+
 func (self *UnvalidatedConfig) ShouldPushNewBranches() bool {
 	return self.PushNewBranches.Bool()
 }
@@ -189,24 +280,39 @@ func (self *UnvalidatedConfig) ShouldPushNewBranches() bool {
 func DefaultConfig() UnvalidatedConfig {
 	return UnvalidatedConfig{
 		Aliases:                  Aliases{},
+		AzureDevOpsToken:         None[AzureDevOpsToken](),
+		BitbucketAppPassword:     None[BitbucketAppPassword](),
+		BitbucketUsername:        None[BitbucketUsername](),
+		BranchTypeRules:          BranchTypeRules{},
 		ContributionBranches:     gitdomain.NewLocalBranchNames(),
+		ContributionRegex:        None[ContributionRegex](),
+		FeatureBranches:          gitdomain.NewLocalBranchNames(),
+		ForgejoToken:             None[ForgejoToken](),
 		GitHubToken:              None[GitHubToken](),
 		GitLabToken:              None[GitLabToken](),
 		GitUserEmail:             None[GitUserEmail](),
 		GitUserName:              None[GitUserName](),
 		GiteaToken:               None[GiteaToken](),
+		Hooks:                    Hooks{},
+		HostingExternalCommand:   None[HostingExternalCommand](),
 		HostingOriginHostname:    None[HostingOriginHostname](),
 		HostingPlatform:          None[HostingPlatform](),
 		Lineage:                  NewLineage(),
 		MainBranch:               None[gitdomain.LocalBranchName](),
 		ObservedBranches:         gitdomain.NewLocalBranchNames(),
+		ObservedRegex:            None[ObservedRegex](),
 		Offline:                  false,
 		ParkedBranches:           gitdomain.NewLocalBranchNames(),
 		PerennialBranches:        gitdomain.NewLocalBranchNames(),
 		PerennialRegex:           None[PerennialRegex](),
+		ProposalRemote:           gitdomain.RemoteOrigin,
+		ProposalsShowLineage:     false,
+		PrototypeBranches:        gitdomain.NewLocalBranchNames(),
 		PushHook:                 true,
 		PushNewBranches:          false,
+		PushRemote:               gitdomain.RemoteOrigin,
 		ShipDeleteTrackingBranch: true,
+		ShipStrategy:             ShipStrategySquashMerge,
 		SyncBeforeShip:           false,
 		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
 		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
@@ -2,7 +2,6 @@ package configdomain
 
 import (
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
-	"github.com/git-town/git-town/v14/src/gohacks/slice"
 )
 
 // ValidatedConfig is Git Town configuration where all essential values are guaranteed to exist and have meaningful values.
@@ -15,19 +14,10 @@ type ValidatedConfig struct {
 }
 
 func (self *ValidatedConfig) BranchType(branch gitdomain.LocalBranchName) BranchType {
-	switch {
-	case self.IsMainBranch(branch):
+	if self.IsMainBranch(branch) {
 		return BranchTypeMainBranch
-	case self.IsPerennialBranch(branch):
-		return BranchTypePerennialBranch
-	case self.IsContributionBranch(branch):
-		return BranchTypeContributionBranch
-	case self.IsObservedBranch(branch):
-		return BranchTypeObservedBranch
-	case self.IsParkedBranch(branch):
-		return BranchTypeParkedBranch
 	}
-	return BranchTypeFeatureBranch
+	return self.nonMainBranchType(branch)
 }
 
 // IsMainBranch indicates whether the branch with the given name
@@ -43,13 +33,7 @@ func (self *ValidatedConfig) IsMainOrPerennialBranch(branch gitdomain.LocalBranc
 }
 
 func (self *ValidatedConfig) IsPerennialBranch(branch gitdomain.LocalBranchName) bool {
-	if slice.Contains(self.PerennialBranches, branch) {
-		return true
-	}
-	if perennialRegex, hasPerennialRegex := self.PerennialRegex.Get(); hasPerennialRegex {
-		return perennialRegex.MatchesBranch(branch)
-	}
-	return false
+	return self.BranchType(branch) == BranchTypePerennialBranch
 }
 
 func (self *ValidatedConfig) MainAndPerennials() gitdomain.LocalBranchNames {
@@ -13,6 +13,56 @@ import (
 func TestValidatedConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("BranchType", func(t *testing.T) {
+		t.Parallel()
+		rules, err := configdomain.ParseBranchTypeRules("dependabot/.*=observed release/.*=perennial user/alice/.*=feature")
+		must.NoError(t, err)
+		config := configdomain.ValidatedConfig{
+			MainBranch: gitdomain.NewLocalBranchName("main"),
+			UnvalidatedConfig: &configdomain.UnvalidatedConfig{
+				BranchTypeRules: rules,
+				FeatureBranches: gitdomain.NewLocalBranchNames("dependabot/proposed"),
+				ParkedBranches:  gitdomain.NewLocalBranchNames("dependabot/old"),
+				PerennialRegex:  configdomain.NewPerennialRegexOption("^user/"),
+			},
+		}
+		tests := map[string]configdomain.BranchType{
+			"main":                configdomain.BranchTypeMainBranch,
+			"dependabot/go-1.22":  configdomain.BranchTypeObservedBranch,
+			"dependabot/old":      configdomain.BranchTypeParkedBranch,
+			"dependabot/proposed": configdomain.BranchTypeFeatureBranch,
+			"release/v1":          configdomain.BranchTypePerennialBranch,
+			"user/alice/spike":    configdomain.BranchTypeFeatureBranch,
+			"user/bob/spike":      configdomain.BranchTypePerennialBranch,
+			"feature":             configdomain.BranchTypeFeatureBranch,
+		}
+		for give, want := range tests {
+			have := config.BranchType(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+		must.True(t, config.IsPerennialBranch("release/v1"))
+		must.True(t, config.IsObservedBranch("dependabot/go-1.22"))
+		must.False(t, config.IsPerennialBranch("user/alice/spike"))
+	})
+
+	t.Run("IsContributionBranch", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.UnvalidatedConfig{
+			ContributionBranches: gitdomain.NewLocalBranchNames("contribution"),
+			ContributionRegex:    configdomain.NewContributionRegexOption("^coworker/"),
+		}
+		tests := map[string]bool{
+			"contribution":    true,
+			"coworker/branch": true,
+			"feature":         false,
+			"me/coworker/one": false,
+		}
+		for give, want := range tests {
+			have := config.IsContributionBranch(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("IsMainOrPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.UnvalidatedConfig{
@@ -50,6 +100,23 @@ func TestValidatedConfig(t *testing.T) {
 		must.False(t, config.IsMainBranch(gitdomain.NewLocalBranchName("peren2")))
 	})
 
+	t.Run("IsObservedBranch", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.UnvalidatedConfig{
+			ObservedBranches: gitdomain.NewLocalBranchNames("observed"),
+			ObservedRegex:    configdomain.NewObservedRegexOption("^dependabot/"),
+		}
+		tests := map[string]bool{
+			"observed":          true,
+			"dependabot/update": true,
+			"feature":           false,
+		}
+		for give, want := range tests {
+			have := config.IsObservedBranch(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("IsPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.UnvalidatedConfig{
@@ -83,4 +150,32 @@ func TestValidatedConfig(t *testing.T) {
 		want := gitdomain.NewLocalBranchNames("main", "perennial-1", "perennial-2")
 		must.Eq(t, want, have)
 	})
+
+	t.Run("TrackingRemote", func(t *testing.T) {
+		t.Parallel()
+		fork := gitdomain.Remote("fork")
+		config := configdomain.ValidatedConfig{
+			MainBranch: gitdomain.NewLocalBranchName("main"),
+			UnvalidatedConfig: &configdomain.UnvalidatedConfig{
+				ContributionBranches: gitdomain.NewLocalBranchNames("contribution"),
+				MainBranch:           Some(gitdomain.NewLocalBranchName("main")),
+				ObservedBranches:     gitdomain.NewLocalBranchNames("observed"),
+				ParkedBranches:       gitdomain.NewLocalBranchNames("parked"),
+				PerennialBranches:    gitdomain.NewLocalBranchNames("perennial"),
+				PushRemote:           fork,
+			},
+		}
+		tests := map[string]gitdomain.Remote{
+			"main":         gitdomain.RemoteOrigin,
+			"perennial":    gitdomain.RemoteOrigin,
+			"contribution": gitdomain.RemoteOrigin,
+			"observed":     gitdomain.RemoteOrigin,
+			"parked":       fork,
+			"feature":      fork,
+		}
+		for give, want := range tests {
+			have := config.TrackingRemote(gitdomain.NewLocalBranchName(give))
+			must.EqOp(t, want, have)
+		}
+	})
 }
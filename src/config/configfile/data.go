@@ -3,32 +3,61 @@ package configfile
 // Data defines the Go equivalent of the TOML file content.
 type Data struct {
 	Branches                 *Branches     `toml:"branches"`
+	Hooks                    *Hooks        `toml:"hooks"`
 	Hosting                  *Hosting      `toml:"hosting"`
 	PushHook                 *bool         `toml:"push-hook"`
 	PushNewbranches          *bool         `toml:"push-new-branches"`
 	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
+	ShipStrategy             *string       `toml:"ship-strategy"`
 	SyncBeforeShip           *bool         `toml:"sync-before-ship"`
 	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
 	SyncUpstream             *bool         `toml:"sync-upstream"`
 }
 
 type Branches struct {
-	Main           *string  `toml:"main"`
-	Perennials     []string `toml:"perennials"`
-	PerennialRegex *string  `toml:"perennial-regex"`
+	ContributionRegex *string          `toml:"contribution-regex"`
+	Contributions     []string         `toml:"contributions"`
+	Main              *string          `toml:"main"`
+	Observed          []string         `toml:"observed"`
+	ObservedRegex     *string          `toml:"observed-regex"`
+	Parked            []string         `toml:"parked"`
+	Perennials        []string         `toml:"perennials"`
+	PerennialRegex    *string          `toml:"perennial-regex"`
+	Prototypes        []string         `toml:"prototypes"`
+	TypeRules         []BranchTypeRule `toml:"type-rules"`
+}
+
+type BranchTypeRule struct {
+	Regex string `toml:"regex"`
+	Type  string `toml:"type"`
 }
 
 func (self Branches) IsEmpty() bool {
-	return self.Main == nil && len(self.Perennials) == 0
+	return self.Main == nil &&
+		len(self.Perennials) == 0 && self.PerennialRegex == nil &&
+		len(self.Contributions) == 0 && self.ContributionRegex == nil &&
+		len(self.Observed) == 0 && self.ObservedRegex == nil &&
+		len(self.Parked) == 0 && len(self.Prototypes) == 0 &&
+		len(self.TypeRules) == 0
+}
+
+type Hooks struct {
+	PostHack       *string `toml:"post-hack"`
+	PostShip       *string `toml:"post-ship"`
+	PostSyncBranch *string `toml:"post-sync-branch"`
+	PreShip        *string `toml:"pre-ship"`
+	PreSync        *string `toml:"pre-sync"`
 }
 
 type Hosting struct {
-	OriginHostname *string `toml:"origin-hostname"`
-	Platform       *string `toml:"platform"`
+	ExternalCommand      *string `toml:"external-command"`
+	OriginHostname       *string `toml:"origin-hostname"`
+	Platform             *string `toml:"platform"`
+	ProposalsShowLineage *bool   `toml:"proposals-show-lineage"`
 }
 
 func (self Hosting) IsEmpty() bool {
-	return self.Platform == nil && self.OriginHostname == nil
+	return self.Platform == nil && self.ExternalCommand == nil && self.OriginHostname == nil && self.ProposalsShowLineage == nil
 }
 
 type SyncStrategy struct {
@@ -42,28 +42,78 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	result := configdomain.PartialConfig{} //exhaustruct:ignore
 	var err error
 	if data.Branches != nil {
+		result.ContributionBranches = gitdomain.NewLocalBranchNames(data.Branches.Contributions...)
+		if data.Branches.ContributionRegex != nil {
+			result.ContributionRegex = configdomain.NewContributionRegexOption(*data.Branches.ContributionRegex)
+		}
 		if data.Branches.Main != nil {
 			result.MainBranch = gitdomain.NewLocalBranchNameOption(*data.Branches.Main)
 		}
+		result.ObservedBranches = gitdomain.NewLocalBranchNames(data.Branches.Observed...)
+		if data.Branches.ObservedRegex != nil {
+			result.ObservedRegex = configdomain.NewObservedRegexOption(*data.Branches.ObservedRegex)
+		}
+		result.ParkedBranches = gitdomain.NewLocalBranchNames(data.Branches.Parked...)
 		result.PerennialBranches = gitdomain.NewLocalBranchNames(data.Branches.Perennials...)
 		if data.Branches.PerennialRegex != nil {
 			result.PerennialRegex = configdomain.NewPerennialRegexOption(*data.Branches.PerennialRegex)
 		}
+		result.PrototypeBranches = gitdomain.NewLocalBranchNames(data.Branches.Prototypes...)
+		for _, typeRule := range data.Branches.TypeRules {
+			rule, err := configdomain.NewBranchTypeRule(typeRule.Regex, typeRule.Type)
+			if err != nil {
+				return result, err
+			}
+			result.BranchTypeRules = append(result.BranchTypeRules, rule)
+		}
+	}
+	if data.Hooks != nil {
+		result.Hooks = configdomain.Hooks{}
+		if data.Hooks.PostHack != nil {
+			result.Hooks[configdomain.HookPostHack] = *data.Hooks.PostHack
+		}
+		if data.Hooks.PostShip != nil {
+			result.Hooks[configdomain.HookPostShip] = *data.Hooks.PostShip
+		}
+		if data.Hooks.PostSyncBranch != nil {
+			result.Hooks[configdomain.HookPostSyncBranch] = *data.Hooks.PostSyncBranch
+		}
+		if data.Hooks.PreShip != nil {
+			result.Hooks[configdomain.HookPreShip] = *data.Hooks.PreShip
+		}
+		if data.Hooks.PreSync != nil {
+			result.Hooks[configdomain.HookPreSync] = *data.Hooks.PreSync
+		}
 	}
 	if data.Hosting != nil {
 		if data.Hosting.Platform != nil {
 			result.HostingPlatform, err = configdomain.NewHostingPlatformOption(*data.Hosting.Platform)
+			if err != nil {
+				return result, err
+			}
+		}
+		if data.Hosting.ExternalCommand != nil {
+			result.HostingExternalCommand = configdomain.NewHostingExternalCommandOption(*data.Hosting.ExternalCommand)
 		}
 		if data.Hosting.OriginHostname != nil {
 			result.HostingOriginHostname = configdomain.NewHostingOriginHostnameOption(*data.Hosting.OriginHostname)
 		}
+		if data.Hosting.ProposalsShowLineage != nil {
+			result.ProposalsShowLineage = Some(configdomain.ProposalsShowLineage(*data.Hosting.ProposalsShowLineage))
+		}
 	}
 	if data.SyncStrategy != nil {
 		if data.SyncStrategy.FeatureBranches != nil {
 			result.SyncFeatureStrategy, err = configdomain.NewSyncFeatureStrategyOption(*data.SyncStrategy.FeatureBranches)
+			if err != nil {
+				return result, err
+			}
 		}
 		if data.SyncStrategy.PerennialBranches != nil {
 			result.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyOption(*data.SyncStrategy.PerennialBranches)
+			if err != nil {
+				return result, err
+			}
 		}
 	}
 	if data.PushNewbranches != nil {
@@ -72,6 +122,12 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	if data.ShipDeleteTrackingBranch != nil {
 		result.ShipDeleteTrackingBranch = Some(configdomain.ShipDeleteTrackingBranch(*data.ShipDeleteTrackingBranch))
 	}
+	if data.ShipStrategy != nil {
+		result.ShipStrategy, err = configdomain.NewShipStrategyOption(*data.ShipStrategy)
+		if err != nil {
+			return result, err
+		}
+	}
 	if data.SyncBeforeShip != nil {
 		result.SyncBeforeShip = Some(configdomain.SyncBeforeShip(*data.SyncBeforeShip))
 	}
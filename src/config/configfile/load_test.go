@@ -3,6 +3,7 @@ package configfile_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/config/configfile"
 	"github.com/shoenig/test/must"
 )
@@ -18,6 +19,7 @@ func TestConfigfile(t *testing.T) {
 push-hook = true
 push-new-branches = true
 ship-delete-tracking-branch = false
+ship-strategy = "fast-forward"
 sync-before-ship = false
 sync-upstream = true
 
@@ -25,10 +27,25 @@ sync-upstream = true
 main = "main"
 perennials = [ "public", "staging" ]
 perennial-regex = "release-.*"
+contributions = [ "coworker" ]
+contribution-regex = "coworker/.*"
+observed = [ "upstream" ]
+observed-regex = "dependabot/.*"
+parked = [ "old" ]
+prototypes = [ "spike" ]
+type-rules = [
+  { regex = "renovate/.*", type = "observed" },
+  { regex = "user/alice/.*", type = "feature" },
+]
+
+[hooks]
+pre-sync = "make generate"
+post-ship = "make deploy"
 
 [hosting]
 platform = "github"
 origin-hostname = "github.com"
+proposals-show-lineage = true
 
 [sync-strategy]
 feature-branches = "merge"
@@ -36,26 +53,51 @@ perennial-branches = "rebase"
 `[1:]
 			have, err := configfile.Decode(give)
 			must.NoError(t, err)
+			contributionRegex := "coworker/.*"
 			github := "github"
 			githubCom := "github.com"
 			main := "main"
+			makeDeploy := "make deploy"
+			makeGenerate := "make generate"
 			merge := "merge"
+			observedRegex := "dependabot/.*"
+			proposalsShowLineage := true
 			pushNewBranches := true
 			pushHook := true
 			rebase := "rebase"
 			releaseRegex := "release-.*"
 			shipDeleteTrackingBranch := false
+			shipStrategy := "fast-forward"
 			syncBeforeShip := false
 			syncUpstream := true
 			want := configfile.Data{
 				Branches: &configfile.Branches{
-					Main:           &main,
-					Perennials:     []string{"public", "staging"},
-					PerennialRegex: &releaseRegex,
+					ContributionRegex: &contributionRegex,
+					Contributions:     []string{"coworker"},
+					Main:              &main,
+					Observed:          []string{"upstream"},
+					ObservedRegex:     &observedRegex,
+					Parked:            []string{"old"},
+					Perennials:        []string{"public", "staging"},
+					PerennialRegex:    &releaseRegex,
+					Prototypes:        []string{"spike"},
+					TypeRules: []configfile.BranchTypeRule{
+						{Regex: "renovate/.*", Type: "observed"},
+						{Regex: "user/alice/.*", Type: "feature"},
+					},
+				},
+				Hooks: &configfile.Hooks{
+					PostHack:       nil,
+					PostShip:       &makeDeploy,
+					PostSyncBranch: nil,
+					PreShip:        nil,
+					PreSync:        &makeGenerate,
 				},
 				Hosting: &configfile.Hosting{
-					Platform:       &github,
-					OriginHostname: &githubCom,
+					ExternalCommand:      nil,
+					Platform:             &github,
+					OriginHostname:       &githubCom,
+					ProposalsShowLineage: &proposalsShowLineage,
 				},
 				SyncStrategy: &configfile.SyncStrategy{
 					FeatureBranches:   &merge,
@@ -64,6 +106,7 @@ perennial-branches = "rebase"
 				PushHook:                 &pushHook,
 				PushNewbranches:          &pushNewBranches,
 				ShipDeleteTrackingBranch: &shipDeleteTrackingBranch,
+				ShipStrategy:             &shipStrategy,
 				SyncBeforeShip:           &syncBeforeShip,
 				SyncUpstream:             &syncUpstream,
 			}
@@ -81,15 +124,24 @@ main = "main"
 			main := "main"
 			want := configfile.Data{
 				Branches: &configfile.Branches{
-					Main:           &main,
-					Perennials:     nil,
-					PerennialRegex: nil,
+					ContributionRegex: nil,
+					Contributions:     nil,
+					Main:              &main,
+					Observed:          nil,
+					ObservedRegex:     nil,
+					Parked:            nil,
+					Perennials:        nil,
+					PerennialRegex:    nil,
+					Prototypes:        nil,
+					TypeRules:         nil,
 				},
+				Hooks:                    nil,
 				Hosting:                  nil,
 				SyncStrategy:             nil,
 				PushNewbranches:          nil,
 				PushHook:                 nil,
 				ShipDeleteTrackingBranch: nil,
+				ShipStrategy:             nil,
 				SyncBeforeShip:           nil,
 				SyncUpstream:             nil,
 			}
@@ -131,4 +183,49 @@ perennials = [
 			must.Eq(t, want, *have)
 		})
 	})
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch type rules", func(t *testing.T) {
+			t.Parallel()
+			give := configfile.Data{
+				Branches: &configfile.Branches{
+					TypeRules: []configfile.BranchTypeRule{
+						{Regex: "renovate/.*", Type: "observed"},
+						{Regex: "release/.*", Type: "perennial"},
+					},
+				},
+			}
+			have, err := configfile.Validate(give)
+			must.NoError(t, err)
+			must.EqOp(t, "renovate/.*=observed release/.*=perennial", have.BranchTypeRules.String())
+		})
+		t.Run("hooks", func(t *testing.T) {
+			t.Parallel()
+			makeGenerate := "make generate"
+			give := configfile.Data{
+				Hooks: &configfile.Hooks{
+					PreSync: &makeGenerate,
+				},
+			}
+			have, err := configfile.Validate(give)
+			must.NoError(t, err)
+			want := configdomain.Hooks{
+				configdomain.HookPreSync: "make generate",
+			}
+			must.Eq(t, want, have.Hooks)
+		})
+		t.Run("invalid branch type rule", func(t *testing.T) {
+			t.Parallel()
+			give := configfile.Data{
+				Branches: &configfile.Branches{
+					TypeRules: []configfile.BranchTypeRule{
+						{Regex: "renovate/.*", Type: "zonk"},
+					},
+				},
+			}
+			_, err := configfile.Validate(give)
+			must.Error(t, err)
+		})
+	})
 }
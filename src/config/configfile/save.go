@@ -10,11 +10,70 @@ import (
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 )
 
-func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
-	if len(perennials) == 0 {
+func RenderBranchNames(branches gitdomain.LocalBranchNames) string {
+	if len(branches) == 0 {
 		return "[]"
 	}
-	return fmt.Sprintf(`["%s"]`, perennials.Join(`", "`))
+	return fmt.Sprintf(`["%s"]`, branches.Join(`", "`))
+}
+
+// ContributionBranchesHelp describes the "contributions" entry in the config file.
+const ContributionBranchesHelp = `
+Contribution branches are branches that other people own.
+Git Town pushes your commits to them but does not propose or ship them.
+`
+
+// ObservedBranchesHelp describes the "observed" entry in the config file.
+const ObservedBranchesHelp = `
+Observed branches are branches that other people own.
+Git Town pulls updates into them but never pushes to them.
+`
+
+// ParkedBranchesHelp describes the "parked" entry in the config file.
+const ParkedBranchesHelp = `
+Parked branches are your own branches that Git Town doesn't sync
+unless you sync them explicitly.
+`
+
+// PrototypeBranchesHelp describes the "prototypes" entry in the config file.
+const PrototypeBranchesHelp = `
+Prototype branches are your own branches that Git Town syncs
+but doesn't push to the remote.
+`
+
+// BranchTypeRulesHelp describes the "type-rules" entry in the config file.
+const BranchTypeRulesHelp = `
+Rules that assign a branch type to all branches whose names match a regex.
+Git Town applies the first matching rule.
+Branches listed explicitly in the configuration take precedence over these rules.
+`
+
+// HooksHelp describes the "hooks" section in the config file.
+const HooksHelp = `
+Shell commands that Git Town runs at certain points of its commands.
+More info at https://www.git-town.com/preferences/hooks.
+`
+
+// HostingExternalCommandHelp describes the "external-command" entry in the config file.
+const HostingExternalCommandHelp = `
+Shell command that implements the "external" hosting platform.
+More info at https://www.git-town.com/preferences/hosting-external-command.
+`
+
+// ProposalsShowLineageHelp describes the "proposals-show-lineage" entry in the config file.
+const ProposalsShowLineageHelp = `
+Should Git Town embed the branch stack into the descriptions of proposals?
+More info at https://www.git-town.com/preferences/proposals-show-lineage.
+`
+
+func RenderBranchTypeRules(rules configdomain.BranchTypeRules) string {
+	result := strings.Builder{}
+	result.WriteString("[\n")
+	for _, rule := range rules {
+		result.WriteString(fmt.Sprintf("  { regex = %q, type = %q },\n", rule.Regex.String(), rule.Type.Name()))
+	}
+	result.WriteString("]")
+	return result.String()
 }
 
 func RenderTOML(config *configdomain.UnvalidatedConfig) string {
@@ -30,6 +89,8 @@ func RenderTOML(config *configdomain.UnvalidatedConfig) string {
 	result.WriteString(fmt.Sprintf("push-new-branches = %t\n\n", config.PushNewBranches))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ShipDeleteTrackingBranchHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("ship-delete-tracking-branch = %t\n\n", config.ShipDeleteTrackingBranch))
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ShipStrategyHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("ship-strategy = %q\n\n", config.ShipStrategy))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncBeforeShipHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("sync-before-ship = %t\n\n", config.SyncBeforeShip))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncUpstreamHelp)) + "\n")
@@ -38,9 +99,38 @@ func RenderTOML(config *configdomain.UnvalidatedConfig) string {
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.MainBranchHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("main = %q\n\n", config.MainBranch))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialBranchesHelp)) + "\n")
-	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderPerennialBranches(config.PerennialBranches)) + "\n")
+	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderBranchNames(config.PerennialBranches)) + "\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialRegexHelp)) + "\n")
-	result.WriteString(fmt.Sprintf("perennial-regex = %q\n", config.PerennialRegex))
+	result.WriteString(fmt.Sprintf("perennial-regex = %q\n\n", config.PerennialRegex))
+	if len(config.ContributionBranches) > 0 {
+		result.WriteString(TOMLComment(strings.TrimSpace(ContributionBranchesHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("contributions = %s\n\n", RenderBranchNames(config.ContributionBranches)))
+	}
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ContributionRegexHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("contribution-regex = %q\n\n", config.ContributionRegex))
+	if len(config.ObservedBranches) > 0 {
+		result.WriteString(TOMLComment(strings.TrimSpace(ObservedBranchesHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("observed = %s\n\n", RenderBranchNames(config.ObservedBranches)))
+	}
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ObservedRegexHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("observed-regex = %q\n", config.ObservedRegex))
+	if len(config.ParkedBranches) > 0 {
+		result.WriteString("\n" + TOMLComment(strings.TrimSpace(ParkedBranchesHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("parked = %s\n", RenderBranchNames(config.ParkedBranches)))
+	}
+	if len(config.PrototypeBranches) > 0 {
+		result.WriteString("\n" + TOMLComment(strings.TrimSpace(PrototypeBranchesHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("prototypes = %s\n", RenderBranchNames(config.PrototypeBranches)))
+	}
+	if len(config.BranchTypeRules) > 0 {
+		result.WriteString("\n" + TOMLComment(strings.TrimSpace(BranchTypeRulesHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("type-rules = %s\n", RenderBranchTypeRules(config.BranchTypeRules)))
+	}
+	if hooks := renderHooks(config.Hooks); hooks != "" {
+		result.WriteString("\n[hooks]\n\n")
+		result.WriteString(TOMLComment(strings.TrimSpace(HooksHelp)) + "\n")
+		result.WriteString(hooks)
+	}
 	result.WriteString("\n[hosting]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.HostingPlatformHelp)) + "\n")
 	if platform, has := config.HostingPlatform.Get(); has {
@@ -48,12 +138,20 @@ func RenderTOML(config *configdomain.UnvalidatedConfig) string {
 	} else {
 		result.WriteString("# platform = \"\"\n\n")
 	}
+	if externalCommand, has := config.HostingExternalCommand.Get(); has {
+		result.WriteString(TOMLComment(strings.TrimSpace(HostingExternalCommandHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("external-command = %q\n\n", externalCommand))
+	}
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.OriginHostnameHelp)) + "\n")
 	if config.HostingOriginHostname.IsNone() {
 		result.WriteString("# origin-hostname = \"\"\n")
 	} else {
 		result.WriteString(fmt.Sprintf("origin-hostname = %q\n", config.HostingOriginHostname))
 	}
+	if config.ProposalsShowLineage {
+		result.WriteString("\n" + TOMLComment(strings.TrimSpace(ProposalsShowLineageHelp)) + "\n")
+		result.WriteString(fmt.Sprintf("proposals-show-lineage = %t\n", config.ProposalsShowLineage))
+	}
 	result.WriteString("\n[sync-strategy]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncFeatureStrategyHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("feature-branches = %q\n\n", config.SyncFeatureStrategy))
@@ -62,6 +160,17 @@ func RenderTOML(config *configdomain.UnvalidatedConfig) string {
 	return result.String()
 }
 
+// renderHooks provides the entries of the "hooks" section for the given hooks.
+func renderHooks(hooks configdomain.Hooks) string {
+	result := strings.Builder{}
+	for _, hook := range configdomain.AllHooks() {
+		if command, hasCommand := hooks.Command(hook).Get(); hasCommand {
+			result.WriteString(fmt.Sprintf("%s = %q\n", hook, command))
+		}
+	}
+	return result.String()
+}
+
 func Save(config *configdomain.UnvalidatedConfig) error {
 	return os.WriteFile(FileName, []byte(RenderTOML(config)), 0o600)
 }
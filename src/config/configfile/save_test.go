@@ -14,26 +14,39 @@ import (
 func TestSave(t *testing.T) {
 	t.Parallel()
 
-	t.Run("RenderPerennialBranches", func(t *testing.T) {
+	t.Run("RenderBranchTypeRules", func(t *testing.T) {
 		t.Parallel()
-		t.Run("no perennial branches", func(t *testing.T) {
+		give, err := configdomain.ParseBranchTypeRules("dependabot/.*=observed release/.*=perennial")
+		must.NoError(t, err)
+		have := configfile.RenderBranchTypeRules(give)
+		want := `
+[
+  { regex = "dependabot/.*", type = "observed" },
+  { regex = "release/.*", type = "perennial" },
+]`[1:]
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RenderBranchNames", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no branches", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.NewLocalBranchNames()
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := "[]"
 			must.EqOp(t, want, have)
 		})
-		t.Run("one perennial branch", func(t *testing.T) {
+		t.Run("one branch", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.NewLocalBranchNames("one")
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := `["one"]`
 			must.EqOp(t, want, have)
 		})
-		t.Run("multiple perennial branches", func(t *testing.T) {
+		t.Run("multiple branches", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.NewLocalBranchNames("one", "two")
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := `["one", "two"]`
 			must.EqOp(t, want, have)
 		})
@@ -54,6 +67,7 @@ func TestSave(t *testing.T) {
 			PushHook:                 true,
 			PushNewBranches:          false,
 			ShipDeleteTrackingBranch: true,
+			ShipStrategy:             configdomain.ShipStrategySquashMerge,
 			SyncBeforeShip:           false,
 			SyncFeatureStrategy:      configdomain.SyncFeatureStrategyMerge,
 			SyncPerennialStrategy:    configdomain.SyncPerennialStrategyRebase,
@@ -95,6 +109,24 @@ push-new-branches = false
 # merging pull requests through its UI.
 ship-delete-tracking-branch = true
 
+# How should "git ship" merge branches into their parent?
+#
+# squash-merge: combine all commits on the shipped branch
+# into a single commit on the parent branch.
+# If a proposal exists, ship via the code hosting API.
+#
+# merge: merge the shipped branch into its parent
+# using a merge commit.
+# If a proposal exists, ship via the code hosting API.
+#
+# fast-forward: fast-forward the parent branch
+# to the shipped branch. This keeps the existing commits
+# and does not create new ones.
+#
+# api: squash-merge the proposal via the code hosting API only.
+# Shipping fails if there is no proposal.
+ship-strategy = "squash-merge"
+
 # Should "git ship" sync branches before shipping them?
 #
 # Guidance: enable when shipping branches locally on your machine
@@ -139,6 +171,22 @@ perennials = ["one", "two"]
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are considered contribution branches.
+# Git Town pushes your commits to these branches
+# but does not propose or ship them.
+#
+# If you are not sure, leave this empty.
+contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are considered observed branches.
+# Git Town pulls updates into these branches
+# but never pushes to them.
+#
+# If you are not sure, leave this empty.
+observed-regex = ""
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
@@ -170,6 +218,53 @@ perennial-branches = "rebase"
 		must.EqOp(t, want, have)
 	})
 
+	t.Run("RenderTOML round-trips through Decode and Validate", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.DefaultConfig()
+		renovateRule, err := configdomain.NewBranchTypeRule("renovate/.*", "observed")
+		must.NoError(t, err)
+		releaseRule, err := configdomain.NewBranchTypeRule("release/.*", "perennial")
+		must.NoError(t, err)
+		give.BranchTypeRules = configdomain.BranchTypeRules{renovateRule, releaseRule}
+		give.ContributionBranches = gitdomain.NewLocalBranchNames("coworker")
+		give.ContributionRegex = configdomain.NewContributionRegexOption("^coworker-")
+		give.Hooks = configdomain.Hooks{
+			configdomain.HookPostHack:       "echo hacked",
+			configdomain.HookPostShip:       "make release",
+			configdomain.HookPostSyncBranch: "make test",
+			configdomain.HookPreShip:        "make lint",
+			configdomain.HookPreSync:        `echo "syncing"`,
+		}
+		give.HostingExternalCommand = Some(configdomain.HostingExternalCommand("git-town-hosting --token=secret"))
+		give.HostingPlatform = Some(configdomain.HostingPlatformExternal)
+		give.MainBranch = Some(gitdomain.NewLocalBranchName("main"))
+		give.ObservedBranches = gitdomain.NewLocalBranchNames("upstream-1", "upstream-2")
+		give.ObservedRegex = configdomain.NewObservedRegexOption("^dependabot/")
+		give.ParkedBranches = gitdomain.NewLocalBranchNames("old")
+		give.PerennialBranches = gitdomain.NewLocalBranchNames("qa", "staging")
+		give.PrototypeBranches = gitdomain.NewLocalBranchNames("spike")
+		give.ProposalsShowLineage = true
+		give.ShipStrategy = configdomain.ShipStrategyFastForward
+		data, err := configfile.Decode(configfile.RenderTOML(&give))
+		must.NoError(t, err)
+		have, err := configfile.Validate(*data)
+		must.NoError(t, err)
+		must.EqOp(t, give.BranchTypeRules.String(), have.BranchTypeRules.String())
+		must.Eq(t, give.ContributionBranches, have.ContributionBranches)
+		must.EqOp(t, give.ContributionRegex.String(), have.ContributionRegex.String())
+		must.Eq(t, give.Hooks, have.Hooks)
+		must.Eq(t, give.HostingExternalCommand, have.HostingExternalCommand)
+		must.Eq(t, give.HostingPlatform, have.HostingPlatform)
+		must.Eq(t, give.MainBranch, have.MainBranch)
+		must.Eq(t, give.ObservedBranches, have.ObservedBranches)
+		must.EqOp(t, give.ObservedRegex.String(), have.ObservedRegex.String())
+		must.Eq(t, give.ParkedBranches, have.ParkedBranches)
+		must.Eq(t, give.PerennialBranches, have.PerennialBranches)
+		must.Eq(t, give.PrototypeBranches, have.PrototypeBranches)
+		must.Eq(t, Some(give.ProposalsShowLineage), have.ProposalsShowLineage)
+		must.Eq(t, Some(give.ShipStrategy), have.ShipStrategy)
+	})
+
 	t.Run("Save", func(t *testing.T) {
 		t.Parallel()
 		give := configdomain.DefaultConfig()
@@ -213,6 +308,24 @@ push-new-branches = false
 # merging pull requests through its UI.
 ship-delete-tracking-branch = true
 
+# How should "git ship" merge branches into their parent?
+#
+# squash-merge: combine all commits on the shipped branch
+# into a single commit on the parent branch.
+# If a proposal exists, ship via the code hosting API.
+#
+# merge: merge the shipped branch into its parent
+# using a merge commit.
+# If a proposal exists, ship via the code hosting API.
+#
+# fast-forward: fast-forward the parent branch
+# to the shipped branch. This keeps the existing commits
+# and does not create new ones.
+#
+# api: squash-merge the proposal via the code hosting API only.
+# Shipping fails if there is no proposal.
+ship-strategy = "squash-merge"
+
 # Should "git ship" sync branches before shipping them?
 #
 # Guidance: enable when shipping branches locally on your machine
@@ -257,6 +370,22 @@ perennials = []
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are considered contribution branches.
+# Git Town pushes your commits to these branches
+# but does not propose or ship them.
+#
+# If you are not sure, leave this empty.
+contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are considered observed branches.
+# Git Town pulls updates into these branches
+# but never pushes to them.
+#
+# If you are not sure, leave this empty.
+observed-regex = ""
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
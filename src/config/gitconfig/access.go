@@ -72,12 +72,38 @@ func (self *Access) AddKeyToPartialConfig(key Key, value string, config *configd
 		config.Aliases[configdomain.AliasableCommandShip] = value
 	case KeyAliasSync:
 		config.Aliases[configdomain.AliasableCommandSync] = value
+	case KeyBitbucketAppPassword:
+		config.BitbucketAppPassword = configdomain.NewBitbucketAppPasswordOption(value)
+	case KeyBitbucketUsername:
+		config.BitbucketUsername = configdomain.NewBitbucketUsernameOption(value)
+	case KeyBranchTypeRules:
+		config.BranchTypeRules, err = configdomain.ParseBranchTypeRules(value)
 	case KeyContributionBranches:
 		config.ContributionBranches = gitdomain.ParseLocalBranchNames(value)
+	case KeyContributionRegex:
+		config.ContributionRegex = configdomain.NewContributionRegexOption(value)
+	case KeyHookPostHack:
+		config.Hooks[configdomain.HookPostHack] = value
+	case KeyHookPostShip:
+		config.Hooks[configdomain.HookPostShip] = value
+	case KeyHookPostSyncBranch:
+		config.Hooks[configdomain.HookPostSyncBranch] = value
+	case KeyHookPreShip:
+		config.Hooks[configdomain.HookPreShip] = value
+	case KeyHookPreSync:
+		config.Hooks[configdomain.HookPreSync] = value
+	case KeyHostingExternalCommand:
+		config.HostingExternalCommand = configdomain.NewHostingExternalCommandOption(value)
 	case KeyHostingOriginHostname:
 		config.HostingOriginHostname = configdomain.NewHostingOriginHostnameOption(value)
 	case KeyHostingPlatform:
 		config.HostingPlatform, err = configdomain.NewHostingPlatformOption(value)
+	case KeyAzureDevOpsToken:
+		config.AzureDevOpsToken = configdomain.NewAzureDevOpsTokenOption(value)
+	case KeyFeatureBranches:
+		config.FeatureBranches = gitdomain.ParseLocalBranchNames(value)
+	case KeyForgejoToken:
+		config.ForgejoToken = configdomain.NewForgejoTokenOption(value)
 	case KeyGiteaToken:
 		config.GiteaToken = configdomain.NewGiteaTokenOption(value)
 	case KeyGithubToken:
@@ -92,6 +118,8 @@ func (self *Access) AddKeyToPartialConfig(key Key, value string, config *configd
 		config.MainBranch = gitdomain.NewLocalBranchNameOption(value)
 	case KeyObservedBranches:
 		config.ObservedBranches = gitdomain.ParseLocalBranchNames(value)
+	case KeyObservedRegex:
+		config.ObservedRegex = configdomain.NewObservedRegexOption(value)
 	case KeyOffline:
 		config.Offline, err = configdomain.NewOfflineOption(value, KeyOffline.String())
 	case KeyParkedBranches:
@@ -100,14 +128,24 @@ func (self *Access) AddKeyToPartialConfig(key Key, value string, config *configd
 		config.PerennialBranches = gitdomain.ParseLocalBranchNames(value)
 	case KeyPerennialRegex:
 		config.PerennialRegex = configdomain.NewPerennialRegexOption(value)
+	case KeyProposalRemote:
+		config.ProposalRemote = gitdomain.NewRemoteOption(value)
+	case KeyProposalsShowLineage:
+		config.ProposalsShowLineage, err = configdomain.ParseProposalsShowLineageOption(value, KeyProposalsShowLineage.String())
+	case KeyPrototypeBranches:
+		config.PrototypeBranches = gitdomain.ParseLocalBranchNames(value)
 	case KeyPushHook:
 		var pushHook configdomain.PushHook
 		pushHook, err = configdomain.NewPushHook(value, KeyPushHook.String())
 		config.PushHook = Some(pushHook)
 	case KeyPushNewBranches:
 		config.PushNewBranches, err = configdomain.ParsePushNewBranchesOption(value, KeyPushNewBranches.String())
+	case KeyPushRemote:
+		config.PushRemote = gitdomain.NewRemoteOption(value)
 	case KeyShipDeleteTrackingBranch:
 		config.ShipDeleteTrackingBranch, err = configdomain.ParseShipDeleteTrackingBranchOption(value, KeyShipDeleteTrackingBranch.String())
+	case KeyShipStrategy:
+		config.ShipStrategy, err = configdomain.NewShipStrategyOption(value)
 	case KeySyncBeforeShip:
 		config.SyncBeforeShip, err = configdomain.ParseSyncBeforeShipOption(value, KeySyncBeforeShip.String())
 	case KeySyncFeatureStrategy:
@@ -141,11 +179,12 @@ func (self *Access) LoadLocal(updateOutdated bool) (SingleSnapshot, configdomain
 	return self.load(false, updateOutdated)
 }
 
-func (self *Access) OriginRemote() string {
-	output, err := self.Query("git", "remote", "get-url", gitdomain.RemoteOrigin.String())
+// RemoteURL provides the URL of the given remote.
+func (self *Access) RemoteURL(remote gitdomain.Remote) string {
+	output, err := self.Query("git", "remote", "get-url", remote.String())
 	if err != nil {
 		// NOTE: it's okay to ignore the error here.
-		// If we get an error here, we simply don't use the origin remote.
+		// If we get an error here, we simply don't use this remote.
 		return ""
 	}
 	return strings.TrimSpace(output)
@@ -43,7 +43,12 @@ const (
 	KeyAliasSetParent                      = Key("alias.set-parent")
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
+	KeyAzureDevOpsToken                    = Key("git-town.azure-devops-token")
+	KeyBitbucketAppPassword                = Key("git-town.bitbucket-app-password")
+	KeyBitbucketUsername                   = Key("git-town.bitbucket-username")
+	KeyBranchTypeRules                     = Key("git-town.branch-type-rules")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
+	KeyContributionRegex                   = Key("git-town.contribution-regex")
 	KeyDeprecatedCodeHostingDriver         = Key("git-town.code-hosting-driver")
 	KeyDeprecatedCodeHostingOriginHostname = Key("git-town.code-hosting-origin-hostname")
 	KeyDeprecatedCodeHostingPlatform       = Key("git-town.code-hosting-platform")
@@ -54,20 +59,34 @@ const (
 	KeyDeprecatedPushVerify                = Key("git-town.push-verify")
 	KeyDeprecatedShipDeleteRemoteBranch    = Key("git-town.ship-delete-remote-branch")
 	KeyDeprecatedSyncStrategy              = Key("git-town.sync-strategy")
+	KeyFeatureBranches                     = Key("git-town.feature-branches")
+	KeyForgejoToken                        = Key("git-town.forgejo-token")
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key("git-town.github-token")
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
+	KeyHookPostHack                        = Key("git-town.hook.post-hack")
+	KeyHookPostShip                        = Key("git-town.hook.post-ship")
+	KeyHookPostSyncBranch                  = Key("git-town.hook.post-sync-branch")
+	KeyHookPreShip                         = Key("git-town.hook.pre-ship")
+	KeyHookPreSync                         = Key("git-town.hook.pre-sync")
+	KeyHostingExternalCommand              = Key("git-town.hosting-external-command")
 	KeyHostingOriginHostname               = Key("git-town.hosting-origin-hostname")
 	KeyHostingPlatform                     = Key("git-town.hosting-platform")
 	KeyMainBranch                          = Key("git-town.main-branch")
 	KeyObservedBranches                    = Key("git-town.observed-branches")
+	KeyObservedRegex                       = Key("git-town.observed-regex")
 	KeyOffline                             = Key("git-town.offline")
 	KeyParkedBranches                      = Key("git-town.parked-branches")
 	KeyPerennialBranches                   = Key("git-town.perennial-branches")
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
+	KeyProposalRemote                      = Key("git-town.proposal-remote")
+	KeyProposalsShowLineage                = Key("git-town.proposals-show-lineage")
+	KeyPrototypeBranches                   = Key("git-town.prototype-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
+	KeyPushRemote                          = Key("git-town.push-remote")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
+	KeyShipStrategy                        = Key("git-town.ship-strategy")
 	KeySyncBeforeShip                      = Key("git-town.sync-before-ship")
 	KeySyncFeatureStrategy                 = Key("git-town.sync-feature-strategy")
 	KeySyncPerennialStrategy               = Key("git-town.sync-perennial-strategy")
@@ -78,9 +97,15 @@ const (
 )
 
 var keys = []Key{ //nolint:gochecknoglobals
+	KeyHostingExternalCommand,
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
+	KeyAzureDevOpsToken,
+	KeyBitbucketAppPassword,
+	KeyBitbucketUsername,
+	KeyBranchTypeRules,
 	KeyContributionBranches,
+	KeyContributionRegex,
 	KeyDeprecatedCodeHostingDriver,
 	KeyDeprecatedCodeHostingOriginHostname,
 	KeyDeprecatedCodeHostingPlatform,
@@ -91,20 +116,33 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyDeprecatedPushVerify,
 	KeyDeprecatedShipDeleteRemoteBranch,
 	KeyDeprecatedSyncStrategy,
+	KeyFeatureBranches,
+	KeyForgejoToken,
 	KeyGiteaToken,
 	KeyGithubToken,
 	KeyGitlabToken,
 	KeyGitUserEmail,
 	KeyGitUserName,
+	KeyHookPostHack,
+	KeyHookPostShip,
+	KeyHookPostSyncBranch,
+	KeyHookPreShip,
+	KeyHookPreSync,
 	KeyMainBranch,
 	KeyObservedBranches,
+	KeyObservedRegex,
 	KeyOffline,
 	KeyParkedBranches,
 	KeyPerennialBranches,
 	KeyPerennialRegex,
+	KeyProposalRemote,
+	KeyProposalsShowLineage,
+	KeyPrototypeBranches,
 	KeyPushHook,
 	KeyPushNewBranches,
+	KeyPushRemote,
 	KeyShipDeleteTrackingBranch,
+	KeyShipStrategy,
 	KeySyncBeforeShip,
 	KeySyncFeatureStrategy,
 	KeySyncPerennialStrategy,
@@ -47,6 +47,12 @@ func (self *UnvalidatedConfig) AddToContributionBranches(branches ...gitdomain.L
 	return self.SetContributionBranches(append(self.Config.ContributionBranches, branches...))
 }
 
+// AddToFeatureBranches registers the given branch names as feature branches,
+// overriding branch type rules and regexes that would classify them differently.
+func (self *UnvalidatedConfig) AddToFeatureBranches(branches ...gitdomain.LocalBranchName) error {
+	return self.SetFeatureBranches(slice.AppendAllMissing(self.Config.FeatureBranches, branches...))
+}
+
 // AddToObservedBranches registers the given branch names as perennial branches.
 // The branches must exist.
 func (self *UnvalidatedConfig) AddToObservedBranches(branches ...gitdomain.LocalBranchName) error {
@@ -59,25 +65,54 @@ func (self *UnvalidatedConfig) AddToParkedBranches(branches ...gitdomain.LocalBr
 	return self.SetParkedBranches(append(self.Config.ParkedBranches, branches...))
 }
 
-// OriginURL provides the URL for the "origin" remote.
-// Tests can stub this through the GIT_TOWN_REMOTE environment variable.
-// Caches its result so can be called repeatedly.
-func (self *UnvalidatedConfig) OriginURL() Option[giturl.Parts] {
-	text := self.OriginURLString()
+// AddToPrototypeBranches registers the given branch names as prototype branches.
+// The branches must exist.
+func (self *UnvalidatedConfig) AddToPrototypeBranches(branches ...gitdomain.LocalBranchName) error {
+	return self.SetPrototypeBranches(append(self.Config.PrototypeBranches, branches...))
+}
+
+// ProposalRemoteURL provides the URL of the remote whose repository receives proposals.
+func (self *UnvalidatedConfig) ProposalRemoteURL() Option[giturl.Parts] {
+	return self.RemoteURL(self.Config.ProposalRemote)
+}
+
+// PushRemoteURL provides the URL of the remote that feature branches get pushed to,
+// if it is a different remote than the one that receives proposals.
+func (self *UnvalidatedConfig) PushRemoteURL() Option[giturl.Parts] {
+	if self.Config.PushRemote == self.Config.ProposalRemote {
+		return None[giturl.Parts]()
+	}
+	return self.RemoteURL(self.Config.PushRemote)
+}
+
+// RemoteURL provides the URL for the given remote.
+// Tests can stub the URL of the "origin" remote through the GIT_TOWN_REMOTE environment variable.
+func (self *UnvalidatedConfig) RemoteURL(remote gitdomain.Remote) Option[giturl.Parts] {
+	text := self.RemoteURLString(remote)
 	if text == "" {
 		return None[giturl.Parts]()
 	}
 	return confighelpers.DetermineOriginURL(text, self.Config.HostingOriginHostname)
 }
 
-// OriginURLString provides the URL for the "origin" remote.
-// Tests can stub this through the GIT_TOWN_REMOTE environment variable.
-func (self *UnvalidatedConfig) OriginURLString() string {
-	remoteOverride := envconfig.OriginURLOverride()
-	if remoteOverride != "" {
-		return remoteOverride
+// RemoteURLString provides the URL for the given remote.
+// Tests can stub the URL of the "origin" remote through the GIT_TOWN_REMOTE environment variable.
+func (self *UnvalidatedConfig) RemoteURLString(remote gitdomain.Remote) string {
+	if remote == gitdomain.RemoteOrigin {
+		remoteOverride := envconfig.OriginURLOverride()
+		if remoteOverride != "" {
+			return remoteOverride
+		}
 	}
-	return self.GitConfig.OriginRemote()
+	return self.GitConfig.RemoteURL(remote)
+}
+
+func (self *UnvalidatedConfig) RemoveBranchTypeRules() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyBranchTypeRules)
+}
+
+func (self *UnvalidatedConfig) RemoveContributionRegex() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyContributionRegex)
 }
 
 // RemoveFromContributionBranches removes the given branch as a perennial branch.
@@ -98,10 +133,20 @@ func (self *UnvalidatedConfig) RemoveFromParkedBranches(branch gitdomain.LocalBr
 	return self.SetParkedBranches(self.Config.ParkedBranches)
 }
 
+// RemoveFromPrototypeBranches removes the given branch as a prototype branch.
+func (self *UnvalidatedConfig) RemoveFromPrototypeBranches(branch gitdomain.LocalBranchName) error {
+	self.Config.PrototypeBranches = slice.Remove(self.Config.PrototypeBranches, branch)
+	return self.SetPrototypeBranches(self.Config.PrototypeBranches)
+}
+
 func (self *UnvalidatedConfig) RemoveMainBranch() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyMainBranch)
 }
 
+func (self *UnvalidatedConfig) RemoveObservedRegex() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyObservedRegex)
+}
+
 // RemoveOutdatedConfiguration removes outdated Git Town configuration.
 func (self *UnvalidatedConfig) RemoveOutdatedConfiguration(localBranches gitdomain.LocalBranchNames) error {
 	for _, entry := range self.Config.Lineage.Entries() {
@@ -140,6 +185,10 @@ func (self *UnvalidatedConfig) RemoveShipDeleteTrackingBranch() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyShipDeleteTrackingBranch)
 }
 
+func (self *UnvalidatedConfig) RemoveShipStrategy() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyShipStrategy)
+}
+
 func (self *UnvalidatedConfig) RemoveSyncBeforeShip() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeySyncBeforeShip)
 }
@@ -162,6 +211,18 @@ func (self *UnvalidatedConfig) SetContributionBranches(branches gitdomain.LocalB
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyContributionBranches, branches.Join(" "))
 }
 
+// SetContributionRegexLocally updates the locally configured contribution regex.
+func (self *UnvalidatedConfig) SetContributionRegexLocally(value configdomain.ContributionRegex) error {
+	self.Config.ContributionRegex = Some(value)
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyContributionRegex, value.String())
+}
+
+// SetFeatureBranches marks the given branches as feature branches.
+func (self *UnvalidatedConfig) SetFeatureBranches(branches gitdomain.LocalBranchNames) error {
+	self.Config.FeatureBranches = branches
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyFeatureBranches, branches.Join(" "))
+}
+
 // SetMainBranch marks the given branch as the main branch
 // in the Git Town configuration.
 func (self *UnvalidatedConfig) SetMainBranch(branch gitdomain.LocalBranchName) error {
@@ -175,6 +236,12 @@ func (self *UnvalidatedConfig) SetObservedBranches(branches gitdomain.LocalBranc
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyObservedBranches, branches.Join(" "))
 }
 
+// SetObservedRegexLocally updates the locally configured observed regex.
+func (self *UnvalidatedConfig) SetObservedRegexLocally(value configdomain.ObservedRegex) error {
+	self.Config.ObservedRegex = Some(value)
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyObservedRegex, value.String())
+}
+
 // SetOffline updates whether Git Town is in offline mode.
 func (self *UnvalidatedConfig) SetOffline(value configdomain.Offline) error {
 	self.Config.Offline = value
@@ -209,6 +276,12 @@ func (self *UnvalidatedConfig) SetPerennialRegexLocally(value configdomain.Peren
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPerennialRegex, value.String())
 }
 
+// SetPrototypeBranches marks the given branches as prototype branches.
+func (self *UnvalidatedConfig) SetPrototypeBranches(branches gitdomain.LocalBranchNames) error {
+	self.Config.PrototypeBranches = branches
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPrototypeBranches, branches.Join(" "))
+}
+
 // SetPushHookLocally updates the locally configured push-hook strategy.
 func (self *UnvalidatedConfig) SetPushHookLocally(value configdomain.PushHook) error {
 	self.Config.PushHook = value
@@ -235,6 +308,12 @@ func (self *UnvalidatedConfig) SetShipDeleteTrackingBranch(value configdomain.Sh
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyShipDeleteTrackingBranch, strconv.FormatBool(value.Bool()))
 }
 
+// SetShipStrategy updates the configured ship strategy.
+func (self *UnvalidatedConfig) SetShipStrategy(value configdomain.ShipStrategy) error {
+	self.Config.ShipStrategy = value
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyShipStrategy, value.String())
+}
+
 func (self *UnvalidatedConfig) SetSyncBeforeShip(value configdomain.SyncBeforeShip, global bool) error {
 	self.Config.SyncBeforeShip = value
 	if global {
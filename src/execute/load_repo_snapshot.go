@@ -9,6 +9,7 @@ import (
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/gohacks/stringslice"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
 	"github.com/git-town/git-town/v14/src/validate"
 )
@@ -47,6 +48,10 @@ func LoadRepoSnapshot(args LoadRepoSnapshotArgs) (gitdomain.BranchesSnapshot, gi
 			return gitdomain.EmptyBranchesSnapshot(), 0, false, err
 		}
 	}
+	err = validate.ConfiguredRemotes(args.UnvalidatedConfig.Config, args.Git, args.Repo.Backend)
+	if err != nil {
+		return gitdomain.EmptyBranchesSnapshot(), 0, false, err
+	}
 	if args.Fetch {
 		var remotes gitdomain.Remotes
 		remotes, err := args.Git.Remotes(args.Repo.Backend)
@@ -55,6 +60,18 @@ func LoadRepoSnapshot(args LoadRepoSnapshotArgs) (gitdomain.BranchesSnapshot, gi
 		}
 		if remotes.HasOrigin() && !args.Repo.IsOffline.Bool() {
 			err = args.Git.Fetch(args.Frontend)
+			if err != nil {
+				if !args.FallbackToOffline || !args.Git.OriginIsUnreachable(args.Backend) {
+					return gitdomain.EmptyBranchesSnapshot(), 0, false, err
+				}
+				// the origin remote is unreachable --> run the rest of this command in offline mode
+				args.UnvalidatedConfig.Config.Offline = true
+				args.FinalMessages.Add(messages.OfflineFallback)
+			}
+		}
+		pushRemote := args.UnvalidatedConfig.Config.PushRemote
+		if pushRemote != gitdomain.RemoteOrigin && remotes.HasRemote(pushRemote) && args.UnvalidatedConfig.Config.IsOnline() {
+			err = args.Git.FetchRemote(args.Frontend, pushRemote)
 			if err != nil {
 				return gitdomain.EmptyBranchesSnapshot(), 0, false, err
 			}
@@ -76,6 +93,7 @@ type LoadRepoSnapshotArgs struct {
 	CommandsCounter       gohacks.Counter
 	ConfigSnapshot        undoconfig.ConfigSnapshot
 	DialogTestInputs      components.TestInputs
+	FallbackToOffline     bool // whether to continue in offline mode if fetching fails because the origin remote is unreachable
 	Fetch                 bool
 	FinalMessages         stringslice.Collector
 	Frontend              gitdomain.Runner
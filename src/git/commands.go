@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,16 @@ func (self *Commands) AbortRebase(runner gitdomain.Runner) error {
 	return runner.Run("git", "rebase", "--abort")
 }
 
+// AbortMergeInWorktree cancels the ongoing Git merge operation in the given worktree.
+func (self *Commands) AbortMergeInWorktree(runner gitdomain.Runner, worktree string) error {
+	return runner.Run("git", "-C", worktree, "merge", "--abort")
+}
+
+// AbortRebaseInWorktree cancels the ongoing Git rebase operation in the given worktree.
+func (self *Commands) AbortRebaseInWorktree(runner gitdomain.Runner, worktree string) error {
+	return runner.Run("git", "-C", worktree, "rebase", "--abort")
+}
+
 // BranchAuthors provides the user accounts that contributed to the given branch.
 // Returns lines of "name <email>".
 func (self *Commands) BranchAuthors(querier gitdomain.Querier, branch, parent gitdomain.LocalBranchName) ([]gitdomain.Author, error) {
@@ -51,6 +62,35 @@ func (self *Commands) BranchAuthors(querier gitdomain.Querier, branch, parent gi
 	return result, nil
 }
 
+// BranchIsMergedInto indicates whether the given branch was merged into the given target branch,
+// either through a merge commit or by applying all its commits onto the target branch.
+// Branches without commits of their own don't count as merged.
+func (self *Commands) BranchIsMergedInto(querier gitdomain.Querier, branch gitdomain.LocalBranchName, target gitdomain.BranchName) (bool, error) {
+	output, err := querier.QueryTrim("git", "cherry", target.String(), branch.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, target, err)
+	}
+	if output != "" {
+		return !strings.Contains("\n"+output, "\n+"), nil
+	}
+	// all commits of the branch exist in the target branch --> check whether a merge commit brought them there
+	branchSHA, err := querier.QueryTrim("git", "rev-parse", branch.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, target, err)
+	}
+	output, err = querier.QueryTrim("git", "rev-list", "--merges", "--parents", branch.String()+".."+target.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, target, err)
+	}
+	for _, line := range stringslice.Lines(output) {
+		shas := strings.Fields(line)
+		if len(shas) > 2 && slices.Contains(shas[2:], branchSHA) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (self *Commands) BranchExists(runner gitdomain.Runner, branch gitdomain.LocalBranchName) bool {
 	err := runner.Run("git", "show-ref", "--verify", "--quiet", "refs/heads/"+branch.String())
 	return err == nil
@@ -77,9 +117,14 @@ func (self *Commands) BranchesSnapshot(querier gitdomain.Querier) (gitdomain.Bra
 	if hasCurrentBranch {
 		self.CurrentBranchCache.Set(currentBranch)
 	}
+	worktrees, err := self.otherWorktrees(querier, branches)
+	if err != nil {
+		return gitdomain.EmptyBranchesSnapshot(), err
+	}
 	return gitdomain.BranchesSnapshot{
-		Branches: branches,
-		Active:   currentBranchOpt,
+		Branches:  branches,
+		Active:    currentBranchOpt,
+		Worktrees: worktrees,
 	}, nil
 }
 
@@ -234,13 +279,13 @@ func (self *Commands) CreateBranch(runner gitdomain.Runner, name gitdomain.Local
 	return runner.Run("git", "branch", name.String(), parent.String())
 }
 
-// CreateRemoteBranch creates a remote branch from the given local SHA.
-func (self *Commands) CreateRemoteBranch(runner gitdomain.Runner, localSHA gitdomain.SHA, branch gitdomain.LocalBranchName, noPushHook configdomain.NoPushHook) error {
+// CreateRemoteBranch creates a branch at the given remote from the given local SHA.
+func (self *Commands) CreateRemoteBranch(runner gitdomain.Runner, localSHA gitdomain.SHA, branch gitdomain.LocalBranchName, remote gitdomain.Remote, noPushHook configdomain.NoPushHook) error {
 	args := []string{"push"}
 	if noPushHook {
 		args = append(args, "--no-verify")
 	}
-	args = append(args, gitdomain.RemoteOrigin.String(), localSHA.String()+":refs/heads/"+branch.String())
+	args = append(args, remote.String(), localSHA.String()+":refs/heads/"+branch.String())
 	return runner.Run("git", args...)
 }
 
@@ -336,6 +381,11 @@ func (self *Commands) Fetch(runner gitdomain.Runner) error {
 	return runner.Run("git", "fetch", "--prune", "--tags")
 }
 
+// FetchRemote fetches updates from the given remote.
+func (self *Commands) FetchRemote(runner gitdomain.Runner, remote gitdomain.Remote) error {
+	return runner.Run("git", "fetch", "--prune", remote.String())
+}
+
 // FetchUpstream fetches updates from the upstream remote.
 func (self *Commands) FetchUpstream(runner gitdomain.Runner, branch gitdomain.LocalBranchName) error {
 	return runner.Run("git", "fetch", gitdomain.RemoteUpstream.String(), branch.String())
@@ -359,6 +409,15 @@ func (self *Commands) ForcePushBranchSafely(runner gitdomain.Runner, noPushHook
 	return runner.Run("git", args...)
 }
 
+// ForcePushBranchSafelyInWorktree force-pushes the branch checked out in the given worktree to its tracking branch.
+func (self *Commands) ForcePushBranchSafelyInWorktree(runner gitdomain.Runner, worktree string, noPushHook configdomain.NoPushHook) error {
+	args := []string{"-C", worktree, "push", "--force-with-lease", "--force-if-includes"}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	return runner.Run("git", args...)
+}
+
 // HasLocalBranch indicates whether this repo has a local branch with the given name.
 func (self *Commands) HasLocalBranch(runner gitdomain.Runner, name gitdomain.LocalBranchName) bool {
 	return runner.Run("git", "show-ref", "--quiet", "refs/heads/"+name.String()) == nil
@@ -370,6 +429,15 @@ func (self *Commands) HasMergeInProgress(runner gitdomain.Runner) bool {
 	return err == nil
 }
 
+// HasOpenChangesInWorktree indicates whether the given worktree has uncommitted changes.
+func (self *Commands) HasOpenChangesInWorktree(querier gitdomain.Querier, worktree string) (bool, error) {
+	output, err := querier.QueryTrim("git", "-C", worktree, "status", "--porcelain", "--ignore-submodules")
+	if err != nil {
+		return false, fmt.Errorf(messages.WorktreeStatusProblem, worktree, err)
+	}
+	return output != "", nil
+}
+
 // HasShippableChanges indicates whether the given branch has changes
 // not currently in the main branch.
 func (self *Commands) HasShippableChanges(querier gitdomain.Querier, branch, mainBranch gitdomain.LocalBranchName) (bool, error) {
@@ -395,6 +463,25 @@ func (self *Commands) MergeBranchNoEdit(runner gitdomain.Runner, branch gitdomai
 	return runner.Run("git", "merge", "--no-edit", "--ff", branch.String())
 }
 
+// MergeBranchNoEditInWorktree merges the given branch into the branch checked out in the given worktree.
+func (self *Commands) MergeBranchNoEditInWorktree(runner gitdomain.Runner, worktree string, branch gitdomain.BranchName) error {
+	return runner.Run("git", "-C", worktree, "merge", "--no-edit", "--ff", branch.String())
+}
+
+// MergeFastForward fast-forwards the current branch to the given branch.
+func (self *Commands) MergeFastForward(runner gitdomain.Runner, branch gitdomain.LocalBranchName) error {
+	return runner.Run("git", "merge", "--ff-only", branch.String())
+}
+
+// MergeNoFastForward merges the given branch into the current branch using a merge commit,
+// using the given commit message or the default commit message if none is given.
+func (self *Commands) MergeNoFastForward(runner gitdomain.Runner, message Option[gitdomain.CommitMessage], branch gitdomain.LocalBranchName) error {
+	if message, hasMessage := message.Get(); hasMessage {
+		return runner.Run("git", "merge", "--no-ff", "-m", message.String(), branch.String())
+	}
+	return runner.Run("git", "merge", "--no-ff", "--no-edit", branch.String())
+}
+
 // NavigateToDir changes into the root directory of the current repository.
 func (self *Commands) NavigateToDir(dir gitdomain.RepoRootDir) error {
 	return os.Chdir(dir.String())
@@ -412,6 +499,40 @@ func (self *Commands) OriginHead(querier gitdomain.Querier) Option[gitdomain.Loc
 	return Some(gitdomain.LocalBranchName(LastBranchInRef(output)))
 }
 
+// OriginIsUnreachable indicates whether the origin remote cannot be reached due to network problems.
+func (self *Commands) OriginIsUnreachable(querier gitdomain.Querier) bool {
+	output, err := querier.Query("git", "ls-remote", "--heads", gitdomain.RemoteOrigin.String())
+	return err != nil && IsNetworkError(output)
+}
+
+// otherWorktrees provides the worktrees that have the branches in the given BranchInfos checked out
+// that are active in another worktree.
+func (self *Commands) otherWorktrees(querier gitdomain.Querier, branches gitdomain.BranchInfos) (gitdomain.Worktrees, error) {
+	result := gitdomain.Worktrees{}
+	if !slices.ContainsFunc(branches, func(branch gitdomain.BranchInfo) bool { return branch.SyncStatus == gitdomain.SyncStatusOtherWorktree }) {
+		return result, nil
+	}
+	output, err := querier.QueryTrim("git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return result, err
+	}
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return result, err
+	}
+	for _, worktree := range ParseWorktreesOutput(output) {
+		branchInfo, hasBranchInfo := branches.FindByLocalName(worktree.Branch).Get()
+		if !hasBranchInfo || branchInfo.SyncStatus != gitdomain.SyncStatusOtherWorktree {
+			continue
+		}
+		if relativePath, err := filepath.Rel(currentDir, worktree.Path); err == nil {
+			worktree.Path = relativePath
+		}
+		result = append(result, worktree)
+	}
+	return result, nil
+}
+
 // PopStash restores stashed-away changes into the workspace.
 func (self *Commands) PopStash(runner gitdomain.Runner) error {
 	return runner.Run("git", "stash", "pop")
@@ -443,6 +564,15 @@ func (self *Commands) PushCurrentBranch(runner gitdomain.Runner, noPushHook conf
 	return runner.Run("git", args...)
 }
 
+// PushBranchInWorktree pushes the branch checked out in the given worktree to its tracking branch.
+func (self *Commands) PushBranchInWorktree(runner gitdomain.Runner, worktree string, noPushHook configdomain.NoPushHook) error {
+	args := []string{"-C", worktree, "push"}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	return runner.Run("git", args...)
+}
+
 // PushTags pushes new the Git tags to origin.
 func (self *Commands) PushTags(runner gitdomain.Runner) error {
 	return runner.Run("git", "push", "--tags")
@@ -453,6 +583,16 @@ func (self *Commands) Rebase(runner gitdomain.Runner, target gitdomain.BranchNam
 	return runner.Run("git", "rebase", target.String())
 }
 
+// RebaseInWorktree rebases the branch checked out in the given worktree against the given branch.
+func (self *Commands) RebaseInWorktree(runner gitdomain.Runner, worktree string, target gitdomain.BranchName) error {
+	return runner.Run("git", "-C", worktree, "rebase", target.String())
+}
+
+// RebaseOnto moves the commits of the current branch that aren't in commitsToRemove onto the given branch.
+func (self *Commands) RebaseOnto(runner gitdomain.Runner, branchToRebaseOnto, commitsToRemove gitdomain.BranchName) error {
+	return runner.Run("git", "rebase", "--onto", branchToRebaseOnto.String(), commitsToRemove.String())
+}
+
 // Remotes provides the names of all Git remotes in this repository.
 func (self *Commands) Remotes(querier gitdomain.Querier) (gitdomain.Remotes, error) {
 	if !self.RemotesCache.Initialized() {
@@ -488,6 +628,26 @@ func (self *Commands) RemoveGitAlias(runner gitdomain.Runner, aliasableCommand c
 	return runner.Run("git", "config", "--global", "--unset", aliasKey.String())
 }
 
+// RemoveAzureDevOpsToken removes the stored token for the Azure DevOps API.
+func (self *Commands) RemoveAzureDevOpsToken(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", gitconfig.KeyAzureDevOpsToken.String())
+}
+
+// RemoveBitbucketAppPassword removes the stored app password for the Bitbucket API.
+func (self *Commands) RemoveBitbucketAppPassword(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", gitconfig.KeyBitbucketAppPassword.String())
+}
+
+// RemoveBitbucketUsername removes the stored username for the Bitbucket API.
+func (self *Commands) RemoveBitbucketUsername(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", gitconfig.KeyBitbucketUsername.String())
+}
+
+// RemoveForgejoToken removes the stored token for the Forgejo API.
+func (self *Commands) RemoveForgejoToken(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", gitconfig.KeyForgejoToken.String())
+}
+
 // RemoveHubToken removes the stored token for the GitHub API.
 func (self *Commands) RemoveGitHubToken(runner gitdomain.Runner) error {
 	return runner.Run("git", "config", "--unset", gitconfig.KeyGithubToken.String())
@@ -531,9 +691,15 @@ func (self *Commands) ResetCurrentBranchToSHA(runner gitdomain.Runner, sha gitdo
 	return runner.Run("git", args...)
 }
 
+// ResetWorktreeToSHA hard-resets the branch checked out in the given worktree to the given SHA.
+func (self *Commands) ResetWorktreeToSHA(runner gitdomain.Runner, worktree string, sha gitdomain.SHA) error {
+	return runner.Run("git", "-C", worktree, "reset", "--hard", sha.String())
+}
+
 // ResetRemoteBranchToSHA sets the given remote branch to the given SHA.
 func (self *Commands) ResetRemoteBranchToSHA(runner gitdomain.Runner, branch gitdomain.RemoteBranchName, sha gitdomain.SHA) error {
-	return runner.Run("git", "push", "--force-with-lease", gitdomain.RemoteOrigin.String(), sha.String()+":"+branch.LocalBranchName().String())
+	remote, localBranch := branch.Parts()
+	return runner.Run("git", "push", "--force-with-lease", remote.String(), sha.String()+":"+localBranch.String())
 }
 
 // RevertCommit reverts the commit with the given SHA.
@@ -560,6 +726,26 @@ func (self *Commands) SHAForBranch(querier gitdomain.Querier, name gitdomain.Bra
 	return gitdomain.NewSHA(output), nil
 }
 
+// SetAzureDevOpsToken sets the given personal access token for the Azure DevOps API.
+func (self *Commands) SetAzureDevOpsToken(runner gitdomain.Runner, value configdomain.AzureDevOpsToken) error {
+	return runner.Run("git", "config", gitconfig.KeyAzureDevOpsToken.String(), value.String())
+}
+
+// SetBitbucketAppPassword sets the given app password for the Bitbucket API.
+func (self *Commands) SetBitbucketAppPassword(runner gitdomain.Runner, value configdomain.BitbucketAppPassword) error {
+	return runner.Run("git", "config", gitconfig.KeyBitbucketAppPassword.String(), value.String())
+}
+
+// SetBitbucketUsername sets the given username for the Bitbucket API.
+func (self *Commands) SetBitbucketUsername(runner gitdomain.Runner, value configdomain.BitbucketUsername) error {
+	return runner.Run("git", "config", gitconfig.KeyBitbucketUsername.String(), value.String())
+}
+
+// SetForgejoToken sets the given API token for the Forgejo API.
+func (self *Commands) SetForgejoToken(runner gitdomain.Runner, value configdomain.ForgejoToken) error {
+	return runner.Run("git", "config", gitconfig.KeyForgejoToken.String(), value.String())
+}
+
 // SetGitAlias sets the given Git alias.
 func (self *Commands) SetGitAlias(runner gitdomain.Runner, aliasableCommand configdomain.AliasableCommand) error {
 	return runner.Run("git", "config", "--global", gitconfig.KeyForAliasableCommand(aliasableCommand).String(), "town "+aliasableCommand.String())
@@ -714,6 +900,28 @@ func IsInSync(branchName, remoteText string) (bool, Option[gitdomain.RemoteBranc
 	return false, None[gitdomain.RemoteBranchName]()
 }
 
+// IsNetworkError indicates whether the given Git output describes a failure to reach a remote repository.
+func IsNetworkError(output string) bool {
+	for _, marker := range networkErrorMarkers {
+		if strings.Contains(output, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// networkErrorMarkers contains the texts with which Git and its transports report that they cannot reach a remote.
+var networkErrorMarkers = []string{
+	"Could not read from remote repository",
+	"Could not resolve host",
+	"Connection refused",
+	"Connection timed out",
+	"Failed to connect",
+	"Network is unreachable",
+	"Operation timed out",
+	"Temporary failure in name resolution",
+}
+
 // IsRemoteGone indicates whether the given remoteText indicates a deleted tracking branch.
 func IsRemoteGone(branchName, remoteText string) (bool, Option[gitdomain.RemoteBranchName]) {
 	reText := fmt.Sprintf(`^\[(\w+\/%s): gone\] `, regexp.QuoteMeta(branchName))
@@ -802,6 +1010,25 @@ func ParseVerboseBranchesOutput(output string) (gitdomain.BranchInfos, Option[gi
 	return result, checkedoutBranch
 }
 
+// ParseWorktreesOutput provides the worktrees that have a branch checked out
+// from the output of "git worktree list --porcelain".
+func ParseWorktreesOutput(output string) gitdomain.Worktrees {
+	result := gitdomain.Worktrees{}
+	path := ""
+	for _, line := range stringslice.Lines(output) {
+		switch {
+		case strings.HasPrefix(line, "worktree "):
+			path = strings.TrimPrefix(line, "worktree ")
+		case strings.HasPrefix(line, "branch refs/heads/"):
+			result = append(result, gitdomain.Worktree{
+				Branch: gitdomain.NewLocalBranchName(strings.TrimPrefix(line, "branch refs/heads/")),
+				Path:   path,
+			})
+		}
+	}
+	return result
+}
+
 func determineSyncStatus(branchName, remoteText string) (syncStatus gitdomain.SyncStatus, trackingBranchName Option[gitdomain.RemoteBranchName]) {
 	isInSync, trackingBranchName := IsInSync(branchName, remoteText)
 	if isInSync {
@@ -83,6 +83,80 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("BranchIsMergedInto", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch without commits", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			have, err := runtime.TestCommands.BranchIsMergedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+		t.Run("branch with unmerged commits", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "content",
+				FileName:    "file",
+				Message:     "commit",
+			})
+			have, err := runtime.TestCommands.BranchIsMergedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+		t.Run("branch merged through a merge commit", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "branch_file",
+				Message:     "branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "initial_file",
+				Message:     "initial commit",
+			})
+			runtime.CheckoutBranch(initial)
+			runtime.MustRun("git", "merge", "--no-edit", branch.String())
+			have, err := runtime.TestCommands.BranchIsMergedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+		})
+		t.Run("branch whose commits got rebased onto the target", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "branch_file",
+				Message:     "branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "initial_file",
+				Message:     "initial commit",
+			})
+			runtime.CheckoutBranch(initial)
+			runtime.MustRun("git", "cherry-pick", branch.String())
+			have, err := runtime.TestCommands.BranchIsMergedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+		})
+	})
+
 	t.Run("CheckoutBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -227,6 +301,21 @@ func TestBackendCommands(t *testing.T) {
 		must.False(t, runner.Commands.HasLocalBranch(runner.TestCommands, gitdomain.NewLocalBranchName("b3")))
 	})
 
+	t.Run("IsNetworkError", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]bool{
+			"fatal: unable to access 'https://github.com/git-town/git-town.git/': Could not resolve host: github.com":        true,
+			"ssh: connect to host github.com port 22: Network is unreachable\nfatal: Could not read from remote repository.": true,
+			"fatal: unable to access 'https://github.com/git-town/git-town.git/': Failed to connect to github.com port 443":  true,
+			"error: failed to push some refs to 'github.com:git-town/git-town.git'":                                          false,
+			"": false,
+		}
+		for give, want := range tests {
+			have := git.IsNetworkError(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
 	t.Run("lastBranchInRef", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]string{
@@ -770,6 +859,29 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("ParseWorktreesOutput", func(t *testing.T) {
+		t.Parallel()
+		give := `
+worktree /home/user/repo
+HEAD 1234567890123456789012345678901234567890
+branch refs/heads/main
+
+worktree /home/user/feature
+HEAD 2345678901234567890123456789012345678901
+branch refs/heads/feature
+
+worktree /home/user/detached
+HEAD 3456789012345678901234567890123456789012
+detached
+`[1:]
+		have := git.ParseWorktreesOutput(give)
+		want := gitdomain.Worktrees{
+			{Branch: gitdomain.NewLocalBranchName("main"), Path: "/home/user/repo"},
+			{Branch: gitdomain.NewLocalBranchName("feature"), Path: "/home/user/feature"},
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("PreviouslyCheckedOutBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -2,6 +2,7 @@ package gitdomain
 
 import (
 	"fmt"
+	"strings"
 
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 )
@@ -77,6 +78,13 @@ func (self BranchInfo) HasTrackingBranch() bool {
 	return hasLocalBranch && hasRemoteBranch
 }
 
+// IsAt indicates whether both the local and the tracking branch point to the commit with the given SHA.
+// The given SHA can be abbreviated.
+func (self BranchInfo) IsAt(sha SHA) bool {
+	isOmni, _, branchSHA := self.IsOmniBranch()
+	return isOmni && strings.HasPrefix(branchSHA.String(), sha.String())
+}
+
 // Indicates whether the branch described by this BranchInfo is omni
 // and provides all relevant data around this scenario.
 // An omni branch has the same SHA locally and remotely.
@@ -275,6 +275,55 @@ func TestBranchInfo(t *testing.T) {
 		})
 	})
 
+	t.Run("IsAt", func(t *testing.T) {
+		t.Parallel()
+		t.Run("local and tracking branch are at the given SHA", func(t *testing.T) {
+			t.Parallel()
+			branchInfo := gitdomain.BranchInfo{
+				LocalName:  Some(gitdomain.NewLocalBranchName("branch-1")),
+				LocalSHA:   Some(gitdomain.NewSHA("111111aaaaaa")),
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+				RemoteName: Some(gitdomain.NewRemoteBranchName("origin/branch-1")),
+				RemoteSHA:  Some(gitdomain.NewSHA("111111aaaaaa")),
+			}
+			must.True(t, branchInfo.IsAt(gitdomain.NewSHA("111111aaaaaa")))
+			must.True(t, branchInfo.IsAt(gitdomain.NewSHA("111111")))
+		})
+		t.Run("branch name reused after its proposal was merged", func(t *testing.T) {
+			t.Parallel()
+			branchInfo := gitdomain.BranchInfo{
+				LocalName:  Some(gitdomain.NewLocalBranchName("branch-1")),
+				LocalSHA:   Some(gitdomain.NewSHA("222222")),
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+				RemoteName: Some(gitdomain.NewRemoteBranchName("origin/branch-1")),
+				RemoteSHA:  Some(gitdomain.NewSHA("222222")),
+			}
+			must.False(t, branchInfo.IsAt(gitdomain.NewSHA("111111")))
+		})
+		t.Run("only the tracking branch is at the given SHA", func(t *testing.T) {
+			t.Parallel()
+			branchInfo := gitdomain.BranchInfo{
+				LocalName:  Some(gitdomain.NewLocalBranchName("branch-1")),
+				LocalSHA:   Some(gitdomain.NewSHA("222222")),
+				SyncStatus: gitdomain.SyncStatusNotInSync,
+				RemoteName: Some(gitdomain.NewRemoteBranchName("origin/branch-1")),
+				RemoteSHA:  Some(gitdomain.NewSHA("111111")),
+			}
+			must.False(t, branchInfo.IsAt(gitdomain.NewSHA("111111")))
+		})
+		t.Run("no tracking branch", func(t *testing.T) {
+			t.Parallel()
+			branchInfo := gitdomain.BranchInfo{
+				LocalName:  Some(gitdomain.NewLocalBranchName("branch-1")),
+				LocalSHA:   Some(gitdomain.NewSHA("111111")),
+				SyncStatus: gitdomain.SyncStatusLocalOnly,
+				RemoteName: None[gitdomain.RemoteBranchName](),
+				RemoteSHA:  None[gitdomain.SHA](),
+			}
+			must.False(t, branchInfo.IsAt(gitdomain.NewSHA("111111")))
+		})
+	})
+
 	t.Run("IsOmniBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("is an omnibranch", func(t *testing.T) {
@@ -75,9 +75,9 @@ func (self BranchInfos) HasLocalBranches(branches LocalBranchNames) bool {
 	return true
 }
 
-// HasMatchingRemoteBranchFor indicates whether there is already a remote branch matching the given local branch.
-func (self BranchInfos) HasMatchingTrackingBranchFor(localBranch LocalBranchName) bool {
-	return self.FindByRemoteName(localBranch.TrackingBranch()) != nil
+// HasMatchingTrackingBranchFor indicates whether there is already a branch at the given remote matching the given local branch.
+func (self BranchInfos) HasMatchingTrackingBranchFor(localBranch LocalBranchName, remote Remote) bool {
+	return self.FindByRemoteName(localBranch.TrackingBranch(remote)) != nil
 }
 
 // LocalBranches provides only the branches that exist on the local machine.
@@ -11,11 +11,15 @@ type BranchesSnapshot struct {
 	// Don't use these branches for business logic since businss logic might want to modify its in-memory cache of branches
 	// as it adds or removes branches.
 	Branches BranchInfos
+
+	// the other worktrees of this repo and the branches checked out in them
+	Worktrees Worktrees
 }
 
 func EmptyBranchesSnapshot() BranchesSnapshot {
 	return BranchesSnapshot{
-		Active:   None[LocalBranchName](),
-		Branches: BranchInfos{},
+		Active:    None[LocalBranchName](),
+		Branches:  BranchInfos{},
+		Worktrees: Worktrees{},
 	}
 }
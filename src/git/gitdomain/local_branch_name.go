@@ -43,7 +43,7 @@ func (self LocalBranchName) Location() Location {
 // Implementation of the fmt.Stringer interface.
 func (self LocalBranchName) String() string { return string(self) }
 
-// TrackingBranch provides the name of the tracking branch for this local branch.
-func (self LocalBranchName) TrackingBranch() RemoteBranchName {
-	return self.AtRemote(RemoteOrigin)
+// TrackingBranch provides the name of the tracking branch for this local branch at the given remote.
+func (self LocalBranchName) TrackingBranch(remote Remote) RemoteBranchName {
+	return self.AtRemote(remote)
 }
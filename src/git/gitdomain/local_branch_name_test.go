@@ -29,8 +29,8 @@ func TestLocalBranchName(t *testing.T) {
 	t.Run("TrackingBranch", func(t *testing.T) {
 		t.Parallel()
 		branch := gitdomain.NewLocalBranchName("branch")
-		want := gitdomain.NewRemoteBranchName("origin/branch")
-		must.EqOp(t, want, branch.TrackingBranch())
+		must.EqOp(t, gitdomain.NewRemoteBranchName("origin/branch"), branch.TrackingBranch(gitdomain.RemoteOrigin))
+		must.EqOp(t, gitdomain.NewRemoteBranchName("fork/branch"), branch.TrackingBranch(gitdomain.Remote("fork")))
 	})
 
 	t.Run("UnmarshalJSON", func(t *testing.T) {
@@ -60,6 +60,17 @@ func (self LocalBranchNames) Join(sep string) string {
 	return strings.Join(self.Strings(), sep)
 }
 
+// KeepOnly provides the branch names in this collection that are also among the given branch names.
+func (self LocalBranchNames) KeepOnly(toKeep LocalBranchNames) LocalBranchNames {
+	result := make(LocalBranchNames, 0, len(self))
+	for _, branch := range self {
+		if toKeep.Contains(branch) {
+			result = append(result, branch)
+		}
+	}
+	return result
+}
+
 func (self *LocalBranchNames) Prepend(branch LocalBranchName) {
 	*self = append(LocalBranchNames{branch}, *self...)
 }
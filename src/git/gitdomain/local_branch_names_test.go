@@ -62,6 +62,14 @@ func TestLocalBranchNames(t *testing.T) {
 		})
 	})
 
+	t.Run("KeepOnly", func(t *testing.T) {
+		t.Parallel()
+		branches := gitdomain.NewLocalBranchNames("one", "two", "three")
+		have := branches.KeepOnly(gitdomain.NewLocalBranchNames("three", "one", "zonk"))
+		want := gitdomain.NewLocalBranchNames("one", "three")
+		must.Eq(t, want, have)
+	})
+
 	t.Run("NewLocalBranchNames and Strings", func(t *testing.T) {
 		t.Parallel()
 		t.Run("with value", func(t *testing.T) {
@@ -154,7 +162,7 @@ func TestLocalBranchNames(t *testing.T) {
 	t.Run("TrackingBranch", func(t *testing.T) {
 		t.Parallel()
 		branch := gitdomain.NewLocalBranchName("branch")
-		have := branch.TrackingBranch()
+		have := branch.TrackingBranch(gitdomain.RemoteOrigin)
 		want := gitdomain.NewRemoteBranchName("origin/branch")
 		must.EqOp(t, want, have)
 	})
@@ -1,15 +1,19 @@
 package gitdomain
 
+import . "github.com/git-town/git-town/v14/src/gohacks/prelude"
+
 // Remote represents a Git remote.
 type Remote string
 
 func NewRemote(id string) Remote {
-	for _, remote := range AllRemotes {
-		if id == remote.String() {
-			return remote
-		}
+	return Remote(id)
+}
+
+func NewRemoteOption(id string) Option[Remote] {
+	if id == "" {
+		return None[Remote]()
 	}
-	return RemoteOther
+	return Some(NewRemote(id))
 }
 
 // Implementation of the fmt.Stringer interface.
@@ -20,13 +24,5 @@ func (self Remote) String() string {
 const (
 	RemoteNone     = Remote("")
 	RemoteOrigin   = Remote("origin")
-	RemoteOther    = Remote("other")
 	RemoteUpstream = Remote("upstream")
 )
-
-var AllRemotes = []Remote{ //nolint:gochecknoglobals
-	RemoteNone,
-	RemoteOrigin,
-	RemoteOther,
-	RemoteUpstream,
-}
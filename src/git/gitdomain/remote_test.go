@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/shoenig/test/must"
 )
 
@@ -13,10 +14,26 @@ func TestNewRemote(t *testing.T) {
 		"origin":   gitdomain.RemoteOrigin,
 		"upstream": gitdomain.RemoteUpstream,
 		"":         gitdomain.RemoteNone,
-		"foo":      gitdomain.RemoteOther,
+		"fork":     gitdomain.Remote("fork"),
 	}
 	for give, want := range tests {
 		have := gitdomain.NewRemote(give)
 		must.Eq(t, want, have)
 	}
 }
+
+func TestNewRemoteOption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		have := gitdomain.NewRemoteOption("")
+		must.True(t, have.IsNone())
+	})
+
+	t.Run("remote name", func(t *testing.T) {
+		t.Parallel()
+		have := gitdomain.NewRemoteOption("fork")
+		must.Eq(t, Some(gitdomain.Remote("fork")), have)
+	})
+}
@@ -15,6 +15,11 @@ func NewRemotes(remotes ...string) Remotes {
 	return result
 }
 
+// HasRemote indicates whether this repo has a remote with the given name.
+func (self Remotes) HasRemote(remote Remote) bool {
+	return slice.Contains(self, remote)
+}
+
 func (self Remotes) HasOrigin() bool {
 	return slice.Contains(self, RemoteOrigin)
 }
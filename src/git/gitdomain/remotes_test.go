@@ -24,6 +24,13 @@ func TestRemotes(t *testing.T) {
 		})
 	})
 
+	t.Run("HasRemote", func(t *testing.T) {
+		t.Parallel()
+		remotes := gitdomain.Remotes{gitdomain.RemoteOrigin, gitdomain.Remote("fork")}
+		must.True(t, remotes.HasRemote(gitdomain.Remote("fork")))
+		must.False(t, remotes.HasRemote(gitdomain.RemoteUpstream))
+	})
+
 	t.Run("HasUpstream", func(t *testing.T) {
 		t.Parallel()
 		t.Run("upstream remote exists", func(t *testing.T) {
@@ -2,6 +2,8 @@ package gitdomain
 
 type Runner interface {
 	Run(executable string, args ...string) error
+	// RunWithEnv runs the given command with the given additional environment variables.
+	RunWithEnv(env []string, executable string, args ...string) error
 }
 
 type RunnerQuerier interface {
@@ -2,6 +2,8 @@ package gitdomain
 
 import (
 	"fmt"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 )
 
 // SHA represents a Git SHA as a dedicated data type.
@@ -17,6 +19,15 @@ func NewSHA(id string) SHA {
 	return SHA(id)
 }
 
+// NewSHAOption provides the SHA contained in the given text,
+// or None if the text isn't a valid Git SHA.
+func NewSHAOption(id string) Option[SHA] {
+	if !validateSHA(id) {
+		return None[SHA]()
+	}
+	return Some(SHA(id))
+}
+
 // validateSHA indicates whether the given SHA content is a valid Git SHA.
 func validateSHA(content string) bool {
 	if len(content) < 6 {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/test/asserts"
 	"github.com/shoenig/test/must"
 )
@@ -51,6 +52,22 @@ func TestSHA(t *testing.T) {
 		})
 	})
 
+	t.Run("NewSHAOption", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid SHA", func(t *testing.T) {
+			t.Parallel()
+			must.Eq(t, Some(gitdomain.NewSHA("123456")), gitdomain.NewSHAOption("123456"))
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			must.Eq(t, None[gitdomain.SHA](), gitdomain.NewSHAOption(""))
+		})
+		t.Run("invalid characters", func(t *testing.T) {
+			t.Parallel()
+			must.Eq(t, None[gitdomain.SHA](), gitdomain.NewSHAOption("abc def"))
+		})
+	})
+
 	t.Run("TruncateTo", func(t *testing.T) {
 		t.Parallel()
 		t.Run("SHA is longer than the new length", func(t *testing.T) {
@@ -0,0 +1,7 @@
+package gitdomain
+
+// Worktree describes a Git worktree that has a branch checked out.
+type Worktree struct {
+	Branch LocalBranchName // the branch checked out in this worktree
+	Path   string          // location of this worktree, relative to the root directory of the current worktree if possible
+}
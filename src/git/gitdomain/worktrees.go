@@ -0,0 +1,24 @@
+package gitdomain
+
+import . "github.com/git-town/git-town/v14/src/gohacks/prelude"
+
+// Worktrees describes the other worktrees of a repo and the branches checked out in them.
+type Worktrees []Worktree
+
+// ForBranch provides the worktree that has the given branch checked out.
+func (self Worktrees) ForBranch(branch LocalBranchName) Option[Worktree] {
+	for _, worktree := range self {
+		if worktree.Branch == branch {
+			return Some(worktree)
+		}
+	}
+	return None[Worktree]()
+}
+
+// PathFor provides the location of the worktree that has the given branch checked out.
+func (self Worktrees) PathFor(branch LocalBranchName) Option[string] {
+	if worktree, hasWorktree := self.ForBranch(branch).Get(); hasWorktree {
+		return Some(worktree.Path)
+	}
+	return None[string]()
+}
@@ -0,0 +1,47 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestWorktrees(t *testing.T) {
+	t.Parallel()
+
+	worktrees := gitdomain.Worktrees{
+		{Branch: gitdomain.NewLocalBranchName("feature"), Path: "../feature"},
+		{Branch: gitdomain.NewLocalBranchName("main"), Path: "../main"},
+	}
+
+	t.Run("ForBranch", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch is checked out in a worktree", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.ForBranch(gitdomain.NewLocalBranchName("main"))
+			want := Some(gitdomain.Worktree{Branch: gitdomain.NewLocalBranchName("main"), Path: "../main"})
+			must.Eq(t, want, have)
+		})
+		t.Run("branch is not checked out in a worktree", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.ForBranch(gitdomain.NewLocalBranchName("other"))
+			must.Eq(t, None[gitdomain.Worktree](), have)
+		})
+	})
+
+	t.Run("PathFor", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch is checked out in a worktree", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.PathFor(gitdomain.NewLocalBranchName("feature"))
+			must.Eq(t, Some("../feature"), have)
+		})
+		t.Run("branch is not checked out in a worktree", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.PathFor(gitdomain.NewLocalBranchName("other"))
+			must.Eq(t, None[string](), have)
+		})
+	})
+}
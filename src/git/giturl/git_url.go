@@ -16,6 +16,9 @@ type Parts struct {
 }
 
 func Parse(url string) Option[Parts] {
+	if parts, isAzure := parseAzureDevOps(url).Get(); isAzure {
+		return Some(parts)
+	}
 	pattern := `^` +
 		// ignore transport protocol
 		`(?:[^:]+://)?` +
@@ -47,6 +50,29 @@ func Parse(url string) Option[Parts] {
 	})
 }
 
+// parseAzureDevOps parses the URL formats of Azure DevOps,
+// which contain the project in addition to the organization.
+// The returned Org contains both the organization and the project, separated by a slash.
+func parseAzureDevOps(url string) Option[Parts] {
+	// HTTPS: https://[user@]dev.azure.com/org/project/_git/repo
+	httpsRegex := regexp.MustCompile(`^(?:https?://)?(?:(?P<user>[^@/]+)@)?(?P<host>dev\.azure\.com)/(?P<org>[^/]+/[^/]+)/_git/(?P<repo>[^/]+?)(?:\.git)?/?$`)
+	// SSH: [ssh://]git@ssh.dev.azure.com[:/]v3/org/project/repo
+	sshRegex := regexp.MustCompile(`^(?:ssh://)?(?:(?P<user>[^@/]+)@)?(?P<host>ssh\.dev\.azure\.com)[:/]v3/(?P<org>[^/]+/[^/]+)/(?P<repo>[^/]+?)(?:\.git)?$`)
+	for _, regex := range []*regexp.Regexp{httpsRegex, sshRegex} {
+		matches := regex.FindStringSubmatch(url)
+		if matches == nil {
+			continue
+		}
+		return Some(Parts{
+			Host: matches[2],
+			Org:  matches[3],
+			Repo: matches[4],
+			User: NewStringOption(matches[1]),
+		})
+	}
+	return None[Parts]()
+}
+
 // trimLast trims the last character of the given text.
 // Handles empty strings gracefully.
 func trimLast(text string) string {
@@ -0,0 +1,29 @@
+package hosting
+
+import (
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/hosting/github"
+)
+
+// HasAPIToken indicates whether the given config contains the credentials
+// to talk to the API of the given hosting platform.
+func HasAPIToken(config configdomain.UnvalidatedConfig, platform configdomain.HostingPlatform) bool {
+	switch platform {
+	case configdomain.HostingPlatformAzureDevOps:
+		return config.AzureDevOpsToken.IsSome()
+	case configdomain.HostingPlatformBitbucket:
+		return config.BitbucketUsername.IsSome() && config.BitbucketAppPassword.IsSome()
+	case configdomain.HostingPlatformExternal:
+		// the external hosting command takes care of authentication
+		return config.HostingExternalCommand.IsSome()
+	case configdomain.HostingPlatformForgejo:
+		return config.ForgejoToken.IsSome()
+	case configdomain.HostingPlatformGitea:
+		return config.GiteaToken.IsSome()
+	case configdomain.HostingPlatformGitHub:
+		return github.GetAPIToken(config.GitHubToken).IsSome()
+	case configdomain.HostingPlatformGitLab:
+		return config.GitLabToken.IsSome()
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package hosting_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting"
+	"github.com/shoenig/test/must"
+)
+
+func TestHasAPIToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("token for the given platform", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.GitLabToken = Some(configdomain.GitLabToken("token"))
+		must.True(t, hosting.HasAPIToken(config, configdomain.HostingPlatformGitLab))
+	})
+
+	t.Run("token for another platform", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.GitLabToken = Some(configdomain.GitLabToken("token"))
+		must.False(t, hosting.HasAPIToken(config, configdomain.HostingPlatformGitea))
+	})
+
+	t.Run("Bitbucket needs username and app password", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.BitbucketAppPassword = Some(configdomain.BitbucketAppPassword("password"))
+		must.False(t, hosting.HasAPIToken(config, configdomain.HostingPlatformBitbucket))
+		config.BitbucketUsername = Some(configdomain.BitbucketUsername("user"))
+		must.True(t, hosting.HasAPIToken(config, configdomain.HostingPlatformBitbucket))
+	})
+}
@@ -0,0 +1,77 @@
+package azuredevops
+
+// This file contains the parts of the Azure DevOps REST API data structures that Git Town uses.
+// See https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests.
+
+type commitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+type completionOptions struct {
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	MergeCommitMessage string `json:"mergeCommitMessage,omitempty"`
+	MergeStrategy      string `json:"mergeStrategy"`
+}
+
+type completeRequest struct {
+	CompletionOptions     completionOptions `json:"completionOptions"`
+	LastMergeSourceCommit commitRef         `json:"lastMergeSourceCommit"`
+	Status                string            `json:"status"`
+}
+
+type createRequest struct {
+	Description   string `json:"description"`
+	IsDraft       bool   `json:"isDraft"`
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+}
+
+type pullRequest struct {
+	Description           string     `json:"description"`
+	IsDraft               bool       `json:"isDraft"`
+	LastMergeSourceCommit commitRef  `json:"lastMergeSourceCommit"`
+	MergeStatus           string     `json:"mergeStatus"`
+	PullRequestID         int        `json:"pullRequestId"`
+	Reviewers             []reviewer `json:"reviewers"`
+	SourceRefName         string     `json:"sourceRefName"`
+	Status                string     `json:"status"`
+	TargetRefName         string     `json:"targetRefName"`
+	Title                 string     `json:"title"`
+}
+
+type pullRequestList struct {
+	Count int           `json:"count"`
+	Value []pullRequest `json:"value"`
+}
+
+type reviewer struct {
+	IsRequired bool `json:"isRequired"`
+	Vote       int  `json:"vote"`
+}
+
+type status struct {
+	Context statusContext `json:"context"`
+	State   string        `json:"state"`
+}
+
+type statusContext struct {
+	Genre string `json:"genre"`
+	Name  string `json:"name"`
+}
+
+type statusList struct {
+	Value []status `json:"value"`
+}
+
+type updateBodyRequest struct {
+	Description string `json:"description"`
+}
+
+type updateStatusRequest struct {
+	Status string `json:"status"`
+}
+
+type updateTargetRequest struct {
+	TargetRefName string `json:"targetRefName"`
+}
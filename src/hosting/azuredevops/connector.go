@@ -0,0 +1,415 @@
+package azuredevops
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+const (
+	// DefaultAPIURL is the address of the Azure DevOps Services REST API.
+	DefaultAPIURL = "https://dev.azure.com"
+
+	// apiVersion is the version of the Azure DevOps REST API that Git Town uses.
+	apiVersion = "7.1"
+
+	// branchRefPrefix is the prefix of the Git refs that Azure DevOps uses to identify branches.
+	branchRefPrefix = "refs/heads/"
+
+	// pageSize is the number of pull requests to load per API request.
+	pageSize = 100
+
+	// sshHostname is the hostname of the SSH endpoint of Azure DevOps Services.
+	sshHostname = "ssh.dev.azure.com"
+
+	// webHostname is the hostname of the web interface of Azure DevOps Services.
+	webHostname = "dev.azure.com"
+)
+
+// Connector provides access to the API of Azure DevOps.
+// The Organization field contains the Azure DevOps organization and project, separated by a slash.
+type Connector struct {
+	hostingdomain.Data
+	APIToken Option[configdomain.AzureDevOpsToken]
+	apiURL   string
+	client   *http.Client
+	log      print.Logger
+}
+
+// NewConnector provides an Azure DevOps connector instance.
+func NewConnector(args NewConnectorArgs) Connector {
+	return Connector{
+		APIToken: args.APIToken,
+		Data:     hostingdomain.NewData(args.OriginURL, args.PushURL),
+		apiURL:   args.APIURL.GetOrElse(DefaultAPIURL),
+		client:   &http.Client{}, //exhaustruct:ignore
+		log:      args.Log,
+	}
+}
+
+type NewConnectorArgs struct {
+	APIToken  Option[configdomain.AzureDevOpsToken]
+	APIURL    Option[string] // overrides the address of the Azure DevOps API, used for testing
+	Log       print.Logger
+	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
+
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsAbandoningViaAPI, number)
+	err := self.request(http.MethodPatch, self.pullRequestPath(number), url.Values{}, updateStatusRequest{Status: "abandoned"}, nil)
+	return self.logResult(err)
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingAzureDevOpsCreatingViaAPI, branch)
+	payload := createRequest{
+		Description:   body,
+		IsDraft:       draft,
+		SourceRefName: branchRef(branch),
+		TargetRefName: branchRef(target),
+		Title:         title,
+	}
+	var response pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsPath(), url.Values{}, payload, &response)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //exhaustruct:ignore
+	}
+	self.log.Success()
+	return self.parsePullRequest(response), nil
+}
+
+func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("Merged PR %d: %s", proposal.Number, proposal.Title)
+}
+
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	if self.APIToken.IsNone() {
+		return None[hostingdomain.Proposal](), nil
+	}
+	pullRequests, err := self.searchPullRequests(branch, target, "active")
+	if err != nil {
+		return None[hostingdomain.Proposal](), err
+	}
+	if len(pullRequests) == 0 {
+		return None[hostingdomain.Proposal](), nil
+	}
+	if len(pullRequests) > 1 {
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
+	}
+	return Some(self.parsePullRequest(pullRequests[0])), nil
+}
+
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	pullRequests, err := self.findPullRequests(branches)
+	if err != nil {
+		return result, err
+	}
+	for branch, pullRequest := range pullRequests {
+		proposal := self.parsePullRequest(pullRequest)
+		var statuses statusList
+		err = self.request(http.MethodGet, self.pullRequestPath(pullRequest.PullRequestID)+"/statuses", url.Values{}, nil, &statuses)
+		if err != nil {
+			return result, err
+		}
+		proposal.CIState = parseCIState(statuses.Value)
+		result[branch] = proposal
+	}
+	return result, nil
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	if self.APIToken.IsNone() {
+		return None[gitdomain.SHA](), nil
+	}
+	// Azure DevOps lists the newest pull requests first
+	pullRequests, err := self.searchPullRequests(branch, target, "completed")
+	if err != nil || len(pullRequests) == 0 {
+		return None[gitdomain.SHA](), err
+	}
+	return gitdomain.NewSHAOption(pullRequests[0].LastMergeSourceCommit.CommitID), nil
+}
+
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	pullRequests, err := self.findPullRequests(branches)
+	for branch, pullRequest := range pullRequests {
+		result[branch] = self.parsePullRequest(pullRequest)
+	}
+	return result, err
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	return self.completePullRequest(number, message.GetOrDefault(), "noFastForward")
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName) (string, error) {
+	return fmt.Sprintf("%s/pullrequestcreate?sourceRef=%s&targetRef=%s",
+			self.RepositoryURL(),
+			url.QueryEscape(branch.String()),
+			url.QueryEscape(parentBranch.String())),
+		nil
+}
+
+func (self Connector) ReopenProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsReactivatingViaAPI, number)
+	err := self.request(http.MethodPatch, self.pullRequestPath(number), url.Values{}, updateStatusRequest{Status: "active"}, nil)
+	return self.logResult(err)
+}
+
+func (self Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/%s/_git/%s", self.webHostname(), self.Organization, self.Repository)
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.completePullRequest(number, message, "squash")
+}
+
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRBodyViaAPI, number)
+	err := self.request(http.MethodPatch, self.pullRequestPath(number), url.Values{}, updateBodyRequest{Description: body}, nil)
+	return self.logResult(err)
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRViaAPI, number, target)
+	err := self.request(http.MethodPatch, self.pullRequestPath(number), url.Values{}, updateTargetRequest{TargetRefName: branchRef(target)}, nil)
+	return self.logResult(err)
+}
+
+// completePullRequest merges the pull request with the given number using the given merge strategy.
+// Azure DevOps requires the SHA of the last commit of the pull request to complete it.
+func (self Connector) completePullRequest(number int, message gitdomain.CommitMessage, strategy string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsMergingViaAPI, number)
+	var current pullRequest
+	err := self.request(http.MethodGet, self.pullRequestPath(number), url.Values{}, nil, &current)
+	if err != nil {
+		return self.logResult(err)
+	}
+	err = self.request(http.MethodPatch, self.pullRequestPath(number), url.Values{}, completeRequest{
+		CompletionOptions: completionOptions{
+			DeleteSourceBranch: false, // the branch will be deleted by Git Town
+			MergeCommitMessage: message.String(),
+			MergeStrategy:      strategy,
+		},
+		LastMergeSourceCommit: current.LastMergeSourceCommit,
+		Status:                "completed",
+	}, nil)
+	return self.logResult(err)
+}
+
+// logResult logs the outcome of an API call that has logged its start.
+// findPullRequests provides the active pull requests whose source is one of the given branches.
+func (self Connector) findPullRequests(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]pullRequest, error) {
+	result := map[gitdomain.LocalBranchName]pullRequest{}
+	if self.APIToken.IsNone() || len(branches) == 0 {
+		return result, nil
+	}
+	for skip := 0; ; skip += pageSize {
+		query := url.Values{}
+		query.Set("searchCriteria.status", "active")
+		query.Set("$top", strconv.Itoa(pageSize))
+		query.Set("$skip", strconv.Itoa(skip))
+		var response pullRequestList
+		err := self.request(http.MethodGet, self.pullRequestsPath(), query, nil, &response)
+		if err != nil {
+			return result, err
+		}
+		for _, pullRequest := range response.Value {
+			branch := parseBranchRef(pullRequest.SourceRefName)
+			if branches.Contains(branch) {
+				result[branch] = pullRequest
+			}
+		}
+		if len(response.Value) < pageSize {
+			return result, nil
+		}
+	}
+}
+
+func (self Connector) logResult(err error) error {
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// parsePullRequest extracts standardized proposal data from the given Azure DevOps pull request.
+func (self Connector) parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         pullRequest.Description,
+		CIState:      hostingdomain.CIStateUnknown,
+		Draft:        pullRequest.IsDraft,
+		MergeWithAPI: pullRequest.MergeStatus == "succeeded",
+		Number:       pullRequest.PullRequestID,
+		ReviewState:  parseReviewState(pullRequest.Reviewers),
+		Target:       parseBranchRef(pullRequest.TargetRefName),
+		Title:        pullRequest.Title,
+		URL:          fmt.Sprintf("%s/pullrequest/%d", self.RepositoryURL(), pullRequest.PullRequestID),
+	}
+}
+
+func (self Connector) pullRequestPath(number int) string {
+	return fmt.Sprintf("%s/%d", self.pullRequestsPath(), number)
+}
+
+// pullRequestsPath provides the API path for the pull requests of the current repository.
+// Organization, project, and repository come from the origin URL and are therefore already URL-encoded.
+func (self Connector) pullRequestsPath() string {
+	return fmt.Sprintf("/%s/_apis/git/repositories/%s/pullrequests", self.Organization, self.Repository)
+}
+
+// request sends the given payload to the given path of the Azure DevOps API
+// and decodes the response into the given result if given.
+func (self Connector) request(method, path string, query url.Values, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+	query.Set("api-version", apiVersion)
+	request, err := http.NewRequest(method, self.apiURL+path+"?"+query.Encode(), body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	// Azure DevOps expects personal access tokens as the password of basic authentication
+	request.SetBasicAuth("", self.APIToken.String())
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingAzureDevOpsAPIProblem, response.Status, content)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(content, result)
+}
+
+// searchPullRequests provides the pull requests with the given status from the given branch into the given target.
+func (self Connector) searchPullRequests(branch, target gitdomain.LocalBranchName, status string) ([]pullRequest, error) {
+	query := url.Values{}
+	query.Set("searchCriteria.sourceRefName", branchRef(branch))
+	query.Set("searchCriteria.targetRefName", branchRef(target))
+	query.Set("searchCriteria.status", status)
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsPath(), query, nil, &response)
+	return response.Value, err
+}
+
+// webHostname provides the hostname of the web interface for the current repository.
+func (self Connector) webHostname() string {
+	if self.Hostname == sshHostname {
+		return webHostname
+	}
+	return self.HostnameWithStandardPort()
+}
+
+// branchRef provides the Git ref that Azure DevOps uses to identify the given branch.
+func branchRef(branch gitdomain.LocalBranchName) string {
+	return branchRefPrefix + branch.String()
+}
+
+// parseBranchRef provides the branch identified by the given Git ref.
+func parseBranchRef(ref string) gitdomain.LocalBranchName {
+	return gitdomain.LocalBranchName(strings.TrimPrefix(ref, branchRefPrefix))
+}
+
+// parseCIState determines the state of the CI checks from the given statuses of a pull request.
+// Only the latest status of each check counts.
+func parseCIState(statuses []status) hostingdomain.CIState {
+	latestStates := map[statusContext]string{}
+	for _, status := range statuses {
+		latestStates[status.Context] = status.State
+	}
+	hasPending := false
+	hasSucceeded := false
+	for _, state := range latestStates {
+		switch state {
+		case "error", "failed":
+			return hostingdomain.CIStateFailure
+		case "pending":
+			hasPending = true
+		case "succeeded":
+			hasSucceeded = true
+		}
+	}
+	switch {
+	case hasPending:
+		return hostingdomain.CIStatePending
+	case hasSucceeded:
+		return hostingdomain.CIStateSuccess
+	}
+	return hostingdomain.CIStateUnknown
+}
+
+// parseReviewState determines the review state of a pull request from the votes of its reviewers.
+// Azure DevOps votes are 10 (approved), 5 (approved with suggestions), 0 (no vote),
+// -5 (waiting for author), and -10 (rejected).
+func parseReviewState(reviewers []reviewer) hostingdomain.ReviewState {
+	approved := false
+	reviewRequired := false
+	for _, reviewer := range reviewers {
+		switch {
+		case reviewer.Vote < 0:
+			return hostingdomain.ReviewStateChangesRequested
+		case reviewer.Vote > 0:
+			approved = true
+		case reviewer.IsRequired:
+			reviewRequired = true
+		}
+	}
+	switch {
+	case reviewRequired:
+		return hostingdomain.ReviewStateRequired
+	case approved:
+		return hostingdomain.ReviewStateApproved
+	}
+	return hostingdomain.ReviewStateUnknown
+}
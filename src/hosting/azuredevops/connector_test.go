@@ -0,0 +1,298 @@
+package azuredevops_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/azuredevops"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/test/helpers"
+	"github.com/shoenig/test/must"
+)
+
+func TestAzureDevOpsConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo").Get()
+		must.True(t, has)
+		have := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  None[configdomain.AzureDevOpsToken](),
+			APIURL:    None[string](),
+			Log:       print.Logger{},
+			OriginURL: url,
+			PushURL:   None[giturl.Parts](),
+		})
+		wantConfig := hostingdomain.Data{
+			HeadOrganization: "org/project",
+			HeadRepository:   "repo",
+			Hostname:         "ssh.dev.azure.com",
+			Organization:     "org/project",
+			Repository:       "repo",
+		}
+		must.EqOp(t, wantConfig, have.Data)
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		have, err := connector.NewProposalURL("feature/one", "parent", "main")
+		must.NoError(t, err)
+		want := "https://dev.azure.com/org/project/_git/repo/pullrequestcreate?sourceRef=feature%2Fone&targetRef=parent"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]string{
+			"git@ssh.dev.azure.com:v3/org/project/repo":        "https://dev.azure.com/org/project/_git/repo",
+			"https://org@dev.azure.com/org/project/_git/repo":  "https://dev.azure.com/org/project/_git/repo",
+			"https://dev.azure.com/org/my%20project/_git/repo": "https://dev.azure.com/org/my%20project/_git/repo",
+		}
+		for give, want := range tests {
+			url, has := giturl.Parse(give).Get()
+			must.True(t, has)
+			connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+				APIToken:  None[configdomain.AzureDevOpsToken](),
+				APIURL:    None[string](),
+				Log:       print.Logger{},
+				OriginURL: url,
+				PushURL:   None[giturl.Parts](),
+			})
+			must.EqOp(t, want, connector.RepositoryURL())
+		}
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"pullRequestId": 12, "title": "my title", "isDraft": true, "status": "active", "mergeStatus": "queued", "sourceRefName": "refs/heads/feature", "targetRefName": "refs/heads/main"}`))
+		connector := newTestConnector(t, server.URL)
+		have, err := connector.CreateProposal("feature", "main", "my title", "my body", true)
+		must.NoError(t, err)
+		want := hostingdomain.Proposal{
+			Body:         "",
+			CIState:      hostingdomain.CIStateUnknown,
+			Draft:        true,
+			MergeWithAPI: false,
+			Number:       12,
+			ReviewState:  hostingdomain.ReviewStateUnknown,
+			Target:       "main",
+			Title:        "my title",
+			URL:          "https://dev.azure.com/org/project/_git/repo/pullrequest/12",
+		}
+		must.Eq(t, want, have)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodPost, request.Method)
+		must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests", request.Path)
+		must.EqOp(t, "7.1", request.Query.Get("api-version"))
+		must.Eq(t, map[string]any{
+			"description":   "my body",
+			"isDraft":       true,
+			"sourceRefName": "refs/heads/feature",
+			"targetRefName": "refs/heads/main",
+			"title":         "my title",
+		}, request.JSON(t))
+	})
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		give := hostingdomain.Proposal{ //exhaustruct:ignore
+			Number: 12,
+			Title:  "my title",
+		}
+		must.EqOp(t, "Merged PR 12: my title", connector.DefaultProposalMessage(give))
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"count": 1, "value": [{"pullRequestId": 12, "title": "my title", "mergeStatus": "succeeded", "sourceRefName": "refs/heads/feature", "targetRefName": "refs/heads/main", "reviewers": [{"vote": 10}]}]}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			want := Some(hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStateUnknown,
+				Draft:        false,
+				MergeWithAPI: true,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateApproved,
+				Target:       "main",
+				Title:        "my title",
+				URL:          "https://dev.azure.com/org/project/_git/repo/pullrequest/12",
+			})
+			must.Eq(t, want, have)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodGet, request.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests", request.Path)
+			must.EqOp(t, "refs/heads/feature", request.Query.Get("searchCriteria.sourceRefName"))
+			must.EqOp(t, "refs/heads/main", request.Query.Get("searchCriteria.targetRefName"))
+			must.EqOp(t, "active", request.Query.Get("searchCriteria.status"))
+			must.EqOp(t, "", request.Username)
+			must.EqOp(t, "secret", request.Password)
+		})
+
+		t.Run("no proposal", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"count": 0, "value": []}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusUnauthorized, ""))
+			connector := newTestConnector(t, server.URL)
+			_, err := connector.FindProposal("feature", "main")
+			must.ErrorContains(t, err, "401 Unauthorized")
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			url, has := giturl.Parse("https://dev.azure.com/org/project/_git/repo").Get()
+			must.True(t, has)
+			connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+				APIToken:  None[configdomain.AzureDevOpsToken](),
+				APIURL:    Some("http://127.0.0.1:0"),
+				Log:       print.Logger{},
+				OriginURL: url,
+				PushURL:   None[giturl.Parts](),
+			})
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+	})
+
+	t.Run("FindProposals", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, func(request helpers.RecordedRequest) (int, string) {
+			switch request.Path {
+			case "/org/project/_apis/git/repositories/repo/pullrequests":
+				return http.StatusOK, `{"count": 2, "value": [
+					{"pullRequestId": 1, "title": "alpha", "sourceRefName": "refs/heads/alpha", "targetRefName": "refs/heads/main", "reviewers": [{"vote": 10}, {"vote": -5}]},
+					{"pullRequestId": 2, "title": "other", "sourceRefName": "refs/heads/other", "targetRefName": "refs/heads/main"}
+				]}`
+			case "/org/project/_apis/git/repositories/repo/pullrequests/1/statuses":
+				return http.StatusOK, `{"value": [
+					{"state": "failed", "context": {"genre": "ci", "name": "build"}},
+					{"state": "succeeded", "context": {"genre": "ci", "name": "build"}},
+					{"state": "pending", "context": {"genre": "ci", "name": "lint"}}
+				]}`
+			}
+			return http.StatusNotFound, ""
+		})
+		connector := newTestConnector(t, server.URL)
+		have, err := connector.FindProposals(gitdomain.NewLocalBranchNames("alpha", "beta"))
+		must.NoError(t, err)
+		must.MapLen(t, 1, have)
+		proposal := have[gitdomain.NewLocalBranchName("alpha")]
+		must.EqOp(t, 1, proposal.Number)
+		must.EqOp(t, hostingdomain.ReviewStateChangesRequested, proposal.ReviewState)
+		must.EqOp(t, hostingdomain.CIStatePending, proposal.CIState)
+		requests := server.Requests()
+		must.SliceLen(t, 2, requests)
+		must.EqOp(t, "active", requests[0].Query.Get("searchCriteria.status"))
+	})
+
+	t.Run("MergedProposalSHA", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("merged proposal exists", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"count": 2, "value": [
+				{"pullRequestId": 13, "status": "completed", "lastMergeSourceCommit": {"commitId": "222222"}},
+				{"pullRequestId": 12, "status": "completed", "lastMergeSourceCommit": {"commitId": "111111"}}
+			]}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.MergedProposalSHA("feature", "main")
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.NewSHA("222222")), have)
+			must.EqOp(t, "completed", server.OnlyRequest(t).Query.Get("searchCriteria.status"))
+		})
+
+		t.Run("no merged proposal", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"count": 0, "value": []}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.MergedProposalSHA("feature", "main")
+			must.NoError(t, err)
+			must.Eq(t, None[gitdomain.SHA](), have)
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("happy path", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, func(request helpers.RecordedRequest) (int, string) {
+				if request.Method == http.MethodGet {
+					return http.StatusOK, `{"pullRequestId": 12, "lastMergeSourceCommit": {"commitId": "abc123"}}`
+				}
+				return http.StatusOK, `{"pullRequestId": 12, "status": "completed"}`
+			})
+			connector := newTestConnector(t, server.URL)
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+			requests := server.Requests()
+			must.SliceLen(t, 2, requests)
+			must.EqOp(t, http.MethodGet, requests[0].Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", requests[0].Path)
+			must.EqOp(t, http.MethodPatch, requests[1].Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", requests[1].Path)
+			must.Eq(t, map[string]any{
+				"completionOptions": map[string]any{
+					"deleteSourceBranch": false,
+					"mergeCommitMessage": "title\n\nbody",
+					"mergeStrategy":      "squash",
+				},
+				"lastMergeSourceCommit": map[string]any{"commitId": "abc123"},
+				"status":                "completed",
+			}, requests[1].JSON(t))
+		})
+
+		t.Run("no proposal number", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "http://127.0.0.1:0")
+			err := connector.SquashMergeProposal(0, "title")
+			must.ErrorContains(t, err, "no proposal number given")
+		})
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"pullRequestId": 12}`))
+		connector := newTestConnector(t, server.URL)
+		err := connector.UpdateProposalTarget(12, "new-target")
+		must.NoError(t, err)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodPatch, request.Method)
+		must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", request.Path)
+		must.EqOp(t, `{"targetRefName":"refs/heads/new-target"}`, request.Body)
+	})
+}
+
+func newTestConnector(t *testing.T, apiURL string) azuredevops.Connector {
+	t.Helper()
+	url, has := giturl.Parse("https://dev.azure.com/org/project/_git/repo").Get()
+	must.True(t, has)
+	return azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+		APIToken:  Some(configdomain.AzureDevOpsToken("secret")),
+		APIURL:    Some(apiURL),
+		Log:       print.Logger{},
+		OriginURL: url,
+		PushURL:   None[giturl.Parts](),
+	})
+}
@@ -0,0 +1,8 @@
+package azuredevops
+
+import "github.com/git-town/git-town/v14/src/git/giturl"
+
+// Detect indicates whether the current repository is hosted on Azure DevOps.
+func Detect(originURL giturl.Parts) bool {
+	return originURL.Host == "dev.azure.com" || originURL.Host == "ssh.dev.azure.com"
+}
@@ -0,0 +1,25 @@
+package azuredevops_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	"github.com/git-town/git-town/v14/src/hosting/azuredevops"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"https://dev.azure.com/git-town/docs/_git/docs": true,  // HTTPS URL
+		"git@ssh.dev.azure.com:v3/git-town/docs/docs":   true,  // SSH URL
+		"git@custom-url.com:git-town/docs.git":          false, // custom URL
+		"git@github.com:git-town/git-town.git":          false, // other hosting service URL
+	}
+	for give, want := range tests {
+		url, has := giturl.Parse(give).Get()
+		must.True(t, has)
+		have := azuredevops.Detect(url)
+		must.EqOp(t, want, have)
+	}
+}
@@ -0,0 +1,69 @@
+package bitbucket
+
+// This file contains the parts of the Bitbucket REST API data structures that Git Town uses.
+// See https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests.
+
+type branchName struct {
+	Name string `json:"name"`
+}
+
+type branchRef struct {
+	Branch     branchName     `json:"branch"`
+	Commit     *commitRef     `json:"commit,omitempty"`     // only provided by the API
+	Repository *repositoryRef `json:"repository,omitempty"` // only needed for branches in forks
+}
+
+type commitRef struct {
+	Hash string `json:"hash"`
+}
+
+type createRequest struct {
+	Description string    `json:"description"`
+	Destination branchRef `json:"destination"`
+	Draft       bool      `json:"draft"`
+	Source      branchRef `json:"source"`
+	Title       string    `json:"title"`
+}
+
+type link struct {
+	Href string `json:"href"`
+}
+
+type mergeRequest struct {
+	CloseSourceBranch bool   `json:"close_source_branch"`
+	MergeStrategy     string `json:"merge_strategy"`
+	Message           string `json:"message,omitempty"`
+	Type              string `json:"type"`
+}
+
+type pullRequest struct {
+	Description string           `json:"description"`
+	Destination branchRef        `json:"destination"`
+	Draft       bool             `json:"draft"`
+	ID          int              `json:"id"`
+	Links       pullRequestLinks `json:"links"`
+	Source      branchRef        `json:"source"`
+	State       string           `json:"state"`
+	Title       string           `json:"title"`
+}
+
+type pullRequestLinks struct {
+	HTML link `json:"html"`
+}
+
+type pullRequestList struct {
+	Next   string        `json:"next"`
+	Values []pullRequest `json:"values"`
+}
+
+type repositoryRef struct {
+	FullName string `json:"full_name"`
+}
+
+type updateBodyRequest struct {
+	Description string `json:"description"`
+}
+
+type updateRequest struct {
+	Destination branchRef `json:"destination"`
+}
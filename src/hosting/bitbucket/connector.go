@@ -1,10 +1,16 @@
 package bitbucket
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	"github.com/git-town/git-town/v14/src/git/giturl"
@@ -13,39 +19,157 @@ import (
 	"github.com/git-town/git-town/v14/src/messages"
 )
 
+// DefaultAPIURL is the address of the Bitbucket Cloud REST API.
+const DefaultAPIURL = "https://api.bitbucket.org/2.0"
+
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Data
+	AppPassword Option[configdomain.BitbucketAppPassword]
+	Username    Option[configdomain.BitbucketUsername]
+	apiURL      string
+	client      *http.Client
+	log         print.Logger
 }
 
 // NewConnector provides a Bitbucket connector instance if the current repo is hosted on Bitbucket,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) Connector {
 	return Connector{
-		Data: hostingdomain.Data{
-			Hostname:     args.OriginURL.Host,
-			Organization: args.OriginURL.Org,
-			Repository:   args.OriginURL.Repo,
-		},
+		AppPassword: args.AppPassword,
+		Data:        hostingdomain.NewData(args.OriginURL, args.PushURL),
+		Username:    args.Username,
+		apiURL:      args.APIURL.GetOrElse(DefaultAPIURL),
+		client:      &http.Client{}, //exhaustruct:ignore
+		log:         args.Log,
 	}
 }
 
 type NewConnectorArgs struct {
+	APIURL          Option[string] // overrides the address of the Bitbucket API, used for testing
+	AppPassword     Option[configdomain.BitbucketAppPassword]
 	HostingPlatform Option[configdomain.HostingPlatform]
+	Log             print.Logger
 	OriginURL       giturl.Parts
+	PushURL         Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+	Username        Option[configdomain.BitbucketUsername]
+}
+
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	if !self.hasCredentials() {
+		return errors.New(messages.HostingBitbucketNoCredentials)
+	}
+	self.log.Start(messages.HostingBitbucketDecliningViaAPI, number)
+	err := self.request(http.MethodPost, fmt.Sprintf("%s/%d/decline", self.pullRequestsPath(), number), nil, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	if !self.hasCredentials() {
+		return hostingdomain.Proposal{}, errors.New(messages.HostingBitbucketNoCredentials) //exhaustruct:ignore
+	}
+	self.log.Start(messages.HostingBitbucketCreatingViaAPI, branch)
+	payload := createRequest{
+		Description: body,
+		Destination: branchRef{Branch: branchName{Name: target.String()}, Repository: nil},
+		Draft:       draft,
+		Source:      branchRef{Branch: branchName{Name: branch.String()}, Repository: self.headRepository()},
+		Title:       title,
+	}
+	var response pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsPath(), payload, &response)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //exhaustruct:ignore
+	}
+	self.log.Success()
+	return parsePullRequest(response), nil
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
-func (self Connector) FindProposal(_, _ gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
-	return None[hostingdomain.Proposal](), errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	if !self.hasCredentials() {
+		return None[hostingdomain.Proposal](), nil
+	}
+	query := url.Values{}
+	query.Set("state", "OPEN")
+	query.Set("q", fmt.Sprintf(`source.branch.name = %q AND destination.branch.name = %q`, branch.String(), target.String()))
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsPath()+"?"+query.Encode(), nil, &response)
+	if err != nil {
+		return None[hostingdomain.Proposal](), err
+	}
+	if len(response.Values) == 0 {
+		return None[hostingdomain.Proposal](), nil
+	}
+	if len(response.Values) > 1 {
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(response.Values), branch, target)
+	}
+	return Some(parsePullRequest(response.Values[0])), nil
+}
+
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	if !self.hasCredentials() || len(branches) == 0 {
+		return result, nil
+	}
+	conditions := make([]string, len(branches))
+	for b, branch := range branches {
+		conditions[b] = fmt.Sprintf(`source.branch.name = %q`, branch.String())
+	}
+	query := url.Values{}
+	query.Set("state", "OPEN")
+	query.Set("pagelen", "50")
+	query.Set("q", strings.Join(conditions, " OR "))
+	path := self.pullRequestsPath() + "?" + query.Encode()
+	for path != "" {
+		var response pullRequestList
+		err := self.request(http.MethodGet, path, nil, &response)
+		if err != nil {
+			return result, err
+		}
+		for _, pullRequest := range response.Values {
+			result[gitdomain.NewLocalBranchName(pullRequest.Source.Branch.Name)] = parsePullRequest(pullRequest)
+		}
+		path = strings.TrimPrefix(response.Next, self.apiURL)
+	}
+	return result, nil
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	if !self.hasCredentials() {
+		return None[gitdomain.SHA](), nil
+	}
+	query := url.Values{}
+	query.Set("state", "MERGED")
+	query.Set("q", fmt.Sprintf(`source.branch.name = %q AND destination.branch.name = %q`, branch.String(), target.String()))
+	query.Set("sort", "-updated_on")
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsPath()+"?"+query.Encode(), nil, &response)
+	if err != nil || len(response.Values) == 0 || response.Values[0].Source.Commit == nil {
+		return None[gitdomain.SHA](), err
+	}
+	// Bitbucket provides abbreviated commit hashes
+	return gitdomain.NewSHAOption(response.Values[0].Source.Commit.Hash), nil
 }
 
 func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName) (string, error) {
-	return fmt.Sprintf("%s/pull-requests/new?source=%s&dest=%s%%2F%s%%3A%s",
-			self.RepositoryURL(),
+	// pull requests from forks get created in the fork
+	return fmt.Sprintf("https://%s/%s/%s/pull-requests/new?source=%s&dest=%s%%2F%s%%3A%s",
+			self.HostnameWithStandardPort(),
+			self.HeadOrganization,
+			self.HeadRepository,
 			url.QueryEscape(branch.String()),
 			url.QueryEscape(self.Organization),
 			url.QueryEscape(self.Repository),
@@ -53,14 +177,154 @@ func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBran
 		nil
 }
 
+func (self Connector) ReopenProposal(number int) error {
+	// the Bitbucket API doesn't allow reopening declined pull requests
+	return fmt.Errorf(messages.HostingBitbucketCannotReopen, number)
+}
+
 func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self Connector) SquashMergeProposal(_ int, _ gitdomain.CommitMessage) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	return self.FindProposals(branches)
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	return self.mergePullRequest(number, message.GetOrDefault(), "merge_commit")
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.mergePullRequest(number, message, "squash")
 }
 
-func (self Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) mergePullRequest(number int, message gitdomain.CommitMessage, strategy string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	if !self.hasCredentials() {
+		return errors.New(messages.HostingBitbucketNoCredentials)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	err := self.request(http.MethodPost, fmt.Sprintf("%s/%d/merge", self.pullRequestsPath(), number), mergeRequest{
+		CloseSourceBranch: false, // the branch will be deleted by Git Town
+		MergeStrategy:     strategy,
+		Message:           message.String(),
+		Type:              "pullrequest",
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	if !self.hasCredentials() {
+		return errors.New(messages.HostingBitbucketNoCredentials)
+	}
+	self.log.Start(messages.HostingBitbucketUpdatePRBodyViaAPI, number)
+	err := self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), updateBodyRequest{
+		Description: body,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	if !self.hasCredentials() {
+		return errors.New(messages.HostingBitbucketNoCredentials)
+	}
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	err := self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), updateRequest{
+		Destination: branchRef{
+			Branch: branchName{
+				Name: target.String(),
+			},
+			Repository: nil,
+		},
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// headRepository provides the repository that contains the branches to propose, if it is a fork.
+func (self Connector) headRepository() *repositoryRef {
+	if !self.IsCrossRepo() {
+		return nil
+	}
+	return &repositoryRef{FullName: self.HeadOrganization + "/" + self.HeadRepository}
+}
+
+func (self Connector) hasCredentials() bool {
+	return self.Username.IsSome() && self.AppPassword.IsSome()
+}
+
+func (self Connector) pullRequestsPath() string {
+	return fmt.Sprintf("/repositories/%s/%s/pullrequests", url.PathEscape(self.Organization), url.PathEscape(self.Repository))
+}
+
+// request sends the given payload to the given path of the Bitbucket API
+// and decodes the response into the given result if given.
+func (self Connector) request(method, path string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+	request, err := http.NewRequest(method, self.apiURL+path, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth(self.Username.String(), self.AppPassword.String())
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingBitbucketAPIProblem, response.Status, content)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(content, result)
+}
+
+// parsePullRequest extracts standardized proposal data from the given Bitbucket pull request.
+func parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         pullRequest.Description,
+		CIState:      hostingdomain.CIStateUnknown,
+		Draft:        pullRequest.Draft,
+		MergeWithAPI: true,
+		Number:       pullRequest.ID,
+		ReviewState:  hostingdomain.ReviewStateUnknown,
+		Target:       gitdomain.NewLocalBranchName(pullRequest.Destination.Branch.Name),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.Links.HTML.Href,
+	}
 }
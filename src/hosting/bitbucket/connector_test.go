@@ -1,14 +1,17 @@
 package bitbucket_test
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	"github.com/git-town/git-town/v14/src/git/giturl"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/bitbucket"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/test/helpers"
 	"github.com/shoenig/test/must"
 )
 
@@ -25,11 +28,14 @@ func TestBitbucketConnector(t *testing.T) {
 			have := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
 				HostingPlatform: None[configdomain.HostingPlatform](),
 				OriginURL:       url,
+				PushURL:         None[giturl.Parts](),
 			})
 			wantConfig := hostingdomain.Data{
-				Hostname:     "bitbucket.org",
-				Organization: "git-town",
-				Repository:   "docs",
+				HeadOrganization: "git-town",
+				HeadRepository:   "docs",
+				Hostname:         "bitbucket.org",
+				Organization:     "git-town",
+				Repository:       "docs",
 			}
 			must.EqOp(t, wantConfig, have.Data)
 		})
@@ -41,11 +47,14 @@ func TestBitbucketConnector(t *testing.T) {
 			have := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
 				HostingPlatform: Some(configdomain.HostingPlatformBitbucket),
 				OriginURL:       url,
+				PushURL:         None[giturl.Parts](),
 			})
 			wantConfig := hostingdomain.Data{
-				Hostname:     "custom-url.com",
-				Organization: "git-town",
-				Repository:   "docs",
+				HeadOrganization: "git-town",
+				HeadRepository:   "docs",
+				Hostname:         "custom-url.com",
+				Organization:     "git-town",
+				Repository:       "docs",
 			}
 			must.EqOp(t, wantConfig, have.Data)
 		})
@@ -58,6 +67,7 @@ func TestBitbucketConnector(t *testing.T) {
 		connector := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
 			HostingPlatform: None[configdomain.HostingPlatform](),
 			OriginURL:       url,
+			PushURL:         None[giturl.Parts](),
 		})
 		main := gitdomain.NewLocalBranchName("main")
 		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"), main)
@@ -65,4 +75,288 @@ func TestBitbucketConnector(t *testing.T) {
 		want := "https://bitbucket.org/org/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
 		must.EqOp(t, want, have)
 	})
+	t.Run("NewProposalURL from a fork", func(t *testing.T) {
+		t.Parallel()
+		originURL, has := giturl.Parse("username@bitbucket.org:org/repo.git").Get()
+		must.True(t, has)
+		pushURL, has := giturl.Parse("username@bitbucket.org:me/repo.git").Get()
+		must.True(t, has)
+		connector := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			HostingPlatform: None[configdomain.HostingPlatform](),
+			OriginURL:       originURL,
+			PushURL:         Some(pushURL),
+		})
+		have, err := connector.NewProposalURL("branch", "parent-branch", "main")
+		must.NoError(t, err)
+		want := "https://bitbucket.org/me/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("happy path", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"id": 12, "title": "my title", "draft": true, "state": "OPEN", "source": {"branch": {"name": "feature"}}, "destination": {"branch": {"name": "main"}}, "links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.CreateProposal("feature", "main", "my title", "my body", true)
+			must.NoError(t, err)
+			want := hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStateUnknown,
+				Draft:        true,
+				MergeWithAPI: true,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateUnknown,
+				Target:       "main",
+				Title:        "my title",
+				URL:          "https://bitbucket.org/org/repo/pull-requests/12",
+			}
+			must.Eq(t, want, have)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests", request.Path)
+			must.Eq(t, map[string]any{
+				"description": "my body",
+				"destination": map[string]any{"branch": map[string]any{"name": "main"}},
+				"draft":       true,
+				"source":      map[string]any{"branch": map[string]any{"name": "feature"}},
+				"title":       "my title",
+			}, request.JSON(t))
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+			connector := newTestConnectorWithoutCredentials(t, server.URL)
+			_, err := connector.CreateProposal("feature", "main", "my title", "my body", false)
+			must.ErrorContains(t, err, "missing credentials")
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"values": [{"id": 12, "title": "my title", "state": "OPEN", "source": {"branch": {"name": "feature"}}, "destination": {"branch": {"name": "main"}}}]}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			want := Some(hostingdomain.Proposal{
+				MergeWithAPI: true,
+				Number:       12,
+				Target:       "main",
+				Title:        "my title",
+			})
+			must.Eq(t, want, have)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodGet, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests", request.Path)
+			must.EqOp(t, "OPEN", request.Query.Get("state"))
+			must.EqOp(t, `source.branch.name = "feature" AND destination.branch.name = "main"`, request.Query.Get("q"))
+			must.EqOp(t, "user", request.Username)
+			must.EqOp(t, "secret", request.Password)
+		})
+
+		t.Run("no proposal", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"values": []}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("multiple proposals", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"values": [{"id": 1}, {"id": 2}]}`))
+			connector := newTestConnector(t, server.URL)
+			_, err := connector.FindProposal("feature", "main")
+			must.ErrorContains(t, err, "found 2 proposals")
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusForbidden, `{"error": {"message": "Access denied"}}`))
+			connector := newTestConnector(t, server.URL)
+			_, err := connector.FindProposal("feature", "main")
+			must.ErrorContains(t, err, "403 Forbidden")
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+			connector := newTestConnectorWithoutCredentials(t, server.URL)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+
+	t.Run("FindProposals", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposals exist", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"values": [{"id": 12, "title": "one title", "draft": true, "source": {"branch": {"name": "one"}}, "destination": {"branch": {"name": "main"}}, "links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}}]}`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposals(gitdomain.NewLocalBranchNames("one", "two"))
+			must.NoError(t, err)
+			want := map[gitdomain.LocalBranchName]hostingdomain.Proposal{
+				"one": {
+					Body:         "",
+					CIState:      hostingdomain.CIStateUnknown,
+					Draft:        true,
+					MergeWithAPI: true,
+					Number:       12,
+					ReviewState:  hostingdomain.ReviewStateUnknown,
+					Target:       "main",
+					Title:        "one title",
+					URL:          "https://bitbucket.org/org/repo/pull-requests/12",
+				},
+			}
+			must.Eq(t, want, have)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, "OPEN", request.Query.Get("state"))
+			must.EqOp(t, `source.branch.name = "one" OR source.branch.name = "two"`, request.Query.Get("q"))
+		})
+
+		t.Run("no branches", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.FindProposals(gitdomain.LocalBranchNames{})
+			must.NoError(t, err)
+			must.MapEmpty(t, have)
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+
+	t.Run("MergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("with commit message", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"id": 12, "state": "MERGED"}`))
+			connector := newTestConnector(t, server.URL)
+			err := connector.MergeProposal(12, Some(gitdomain.CommitMessage("title")))
+			must.NoError(t, err)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12/merge", request.Path)
+			must.Eq(t, map[string]any{
+				"close_source_branch": false,
+				"merge_strategy":      "merge_commit",
+				"message":             "title",
+				"type":                "pullrequest",
+			}, request.JSON(t))
+		})
+
+		t.Run("without commit message", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"id": 12, "state": "MERGED"}`))
+			connector := newTestConnector(t, server.URL)
+			err := connector.MergeProposal(12, None[gitdomain.CommitMessage]())
+			must.NoError(t, err)
+			must.Eq(t, map[string]any{
+				"close_source_branch": false,
+				"merge_strategy":      "merge_commit",
+				"type":                "pullrequest",
+			}, server.OnlyRequest(t).JSON(t))
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("happy path", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"id": 12, "state": "MERGED"}`))
+			connector := newTestConnector(t, server.URL)
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12/merge", request.Path)
+			must.Eq(t, map[string]any{
+				"close_source_branch": false,
+				"merge_strategy":      "squash",
+				"message":             "title\n\nbody",
+				"type":                "pullrequest",
+			}, request.JSON(t))
+		})
+
+		t.Run("no proposal number", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "http://127.0.0.1:0")
+			err := connector.SquashMergeProposal(0, "title")
+			must.ErrorContains(t, err, "no proposal number given")
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+			connector := newTestConnectorWithoutCredentials(t, server.URL)
+			err := connector.SquashMergeProposal(12, "title")
+			must.ErrorContains(t, err, "missing credentials")
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("happy path", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"id": 12}`))
+			connector := newTestConnector(t, server.URL)
+			err := connector.UpdateProposalTarget(12, "new-target")
+			must.NoError(t, err)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodPut, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12", request.Path)
+			must.EqOp(t, `{"destination":{"branch":{"name":"new-target"}}}`, request.Body)
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+			connector := newTestConnectorWithoutCredentials(t, server.URL)
+			err := connector.UpdateProposalTarget(12, "new-target")
+			must.ErrorContains(t, err, "missing credentials")
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+}
+
+func newTestConnector(t *testing.T, apiURL string) bitbucket.Connector {
+	t.Helper()
+	url, has := giturl.Parse("git@bitbucket.org:org/repo.git").Get()
+	must.True(t, has)
+	return bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+		APIURL:          Some(apiURL),
+		AppPassword:     Some(configdomain.BitbucketAppPassword("secret")),
+		HostingPlatform: None[configdomain.HostingPlatform](),
+		Log:             print.Logger{},
+		OriginURL:       url,
+		PushURL:         None[giturl.Parts](),
+		Username:        Some(configdomain.BitbucketUsername("user")),
+	})
+}
+
+func newTestConnectorWithoutCredentials(t *testing.T, apiURL string) bitbucket.Connector {
+	t.Helper()
+	url, has := giturl.Parse("git@bitbucket.org:org/repo.git").Get()
+	must.True(t, has)
+	return bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+		APIURL:          Some(apiURL),
+		AppPassword:     None[configdomain.BitbucketAppPassword](),
+		HostingPlatform: None[configdomain.HostingPlatform](),
+		Log:             print.Logger{},
+		OriginURL:       url,
+		PushURL:         None[giturl.Parts](),
+		Username:        None[configdomain.BitbucketUsername](),
+	})
 }
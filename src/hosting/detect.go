@@ -4,7 +4,9 @@ import (
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/giturl"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/azuredevops"
 	"github.com/git-town/git-town/v14/src/hosting/bitbucket"
+	"github.com/git-town/git-town/v14/src/hosting/forgejo"
 	"github.com/git-town/git-town/v14/src/hosting/gitea"
 	"github.com/git-town/git-town/v14/src/hosting/github"
 	"github.com/git-town/git-town/v14/src/hosting/gitlab"
@@ -15,10 +17,12 @@ func Detect(originURL giturl.Parts, userOverride Option[configdomain.HostingPlat
 		return userOverride
 	}
 	detectors := map[configdomain.HostingPlatform]func(giturl.Parts) bool{
-		configdomain.HostingPlatformBitbucket: bitbucket.Detect,
-		configdomain.HostingPlatformGitea:     gitea.Detect,
-		configdomain.HostingPlatformGitHub:    github.Detect,
-		configdomain.HostingPlatformGitLab:    gitlab.Detect,
+		configdomain.HostingPlatformAzureDevOps: azuredevops.Detect,
+		configdomain.HostingPlatformBitbucket:   bitbucket.Detect,
+		configdomain.HostingPlatformForgejo:     forgejo.Detect,
+		configdomain.HostingPlatformGitea:       gitea.Detect,
+		configdomain.HostingPlatformGitHub:      github.Detect,
+		configdomain.HostingPlatformGitLab:      gitlab.Detect,
 	}
 	for platform, detector := range detectors {
 		if detector(originURL) {
@@ -13,6 +13,17 @@ import (
 func TestDetect(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Azure DevOps SAAS, no override", func(t *testing.T) {
+		t.Parallel()
+		for _, give := range []string{"git@ssh.dev.azure.com:v3/git-town/docs/docs", "https://dev.azure.com/git-town/docs/_git/docs"} {
+			url, has := giturl.Parse(give).Get()
+			must.True(t, has)
+			have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+			want := Some(configdomain.HostingPlatformAzureDevOps)
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("BitBucket SAAS, no override", func(t *testing.T) {
 		t.Parallel()
 		url, has := giturl.Parse("username@bitbucket.org:git-town/docs.git").Get()
@@ -31,6 +42,24 @@ func TestDetect(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("Codeberg, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("git@codeberg.org:git-town/docs.git").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+		want := Some(configdomain.HostingPlatformForgejo)
+		must.Eq(t, want, have)
+	})
+
+	t.Run("custom URL, override to Forgejo", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("username@custom.org:git-town/docs.git").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, Some(configdomain.HostingPlatformForgejo))
+		want := Some(configdomain.HostingPlatformForgejo)
+		must.Eq(t, want, have)
+	})
+
 	t.Run("Gitea SAAS, no override", func(t *testing.T) {
 		t.Parallel()
 		url, has := giturl.Parse("username@gitea.com:git-town/docs.git").Get()
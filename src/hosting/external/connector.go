@@ -0,0 +1,234 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// Connector talks to code hosting platforms that Git Town doesn't support natively
+// through a user-provided shell command.
+// For each operation, it runs the shell command, writes a JSON-encoded Request to its STDIN,
+// and reads a JSON-encoded Response from its STDOUT.
+type Connector struct {
+	hostingdomain.Data
+	command configdomain.HostingExternalCommand
+	log     print.Logger
+}
+
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalClosing, number)
+	_, err := self.run(Request{Number: number, Operation: OperationCloseProposal}) //exhaustruct:ignore
+	return self.logResult(err)
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingExternalCreating, branch)
+	response, err := self.run(Request{ //exhaustruct:ignore
+		Body:      body,
+		Branch:    branch.String(),
+		Draft:     draft,
+		Operation: OperationCreateProposal,
+		Target:    target.String(),
+		Title:     title,
+	})
+	if err != nil {
+		return hostingdomain.Proposal{}, self.logResult(err) //exhaustruct:ignore
+	}
+	proposal, hasProposal := response.Proposal.Get()
+	if !hasProposal {
+		return hostingdomain.Proposal{}, self.logResult(fmt.Errorf(messages.HostingExternalNoProposal, OperationCreateProposal)) //exhaustruct:ignore
+	}
+	self.log.Success()
+	return proposal.Proposal(), nil
+}
+
+func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+}
+
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	response, err := self.run(Request{ //exhaustruct:ignore
+		Branch:    branch.String(),
+		Operation: OperationFindProposal,
+		Target:    target.String(),
+	})
+	if err != nil {
+		return None[hostingdomain.Proposal](), err
+	}
+	if proposal, hasProposal := response.Proposal.Get(); hasProposal {
+		return Some(proposal.Proposal()), nil
+	}
+	return None[hostingdomain.Proposal](), nil
+}
+
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	response, err := self.run(Request{ //exhaustruct:ignore
+		Branches:  branches.Strings(),
+		Operation: OperationFindProposals,
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, proposal := range response.Proposals {
+		branch := gitdomain.LocalBranchName(proposal.Branch)
+		if branches.Contains(branch) {
+			result[branch] = proposal.Proposal()
+		}
+	}
+	return result, nil
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	response, err := self.run(Request{ //exhaustruct:ignore
+		Branch:    branch.String(),
+		Operation: OperationMergedProposalSHA,
+		Target:    target.String(),
+	})
+	return gitdomain.NewSHAOption(response.SHA), err
+}
+
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	return self.FindProposals(branches)
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	return self.mergeProposal(OperationMergeProposal, number, message.GetOrDefault())
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName) (string, error) {
+	response, err := self.run(Request{ //exhaustruct:ignore
+		Branch:     branch.String(),
+		MainBranch: mainBranch.String(),
+		Operation:  OperationNewProposalURL,
+		Target:     parentBranch.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.URL == "" {
+		return "", fmt.Errorf(messages.HostingExternalNoURL, OperationNewProposalURL)
+	}
+	return response.URL, nil
+}
+
+func (self Connector) ReopenProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalReopening, number)
+	_, err := self.run(Request{Number: number, Operation: OperationReopenProposal}) //exhaustruct:ignore
+	return self.logResult(err)
+}
+
+// RepositoryURL provides the URL of the repository that the shell command reports.
+// Since the Connector interface doesn't allow errors here,
+// it falls back to the web URL of the origin remote if the shell command doesn't provide one.
+func (self Connector) RepositoryURL() string {
+	response, err := self.run(Request{Operation: OperationRepositoryURL}) //exhaustruct:ignore
+	if err != nil || response.URL == "" {
+		return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+	}
+	return response.URL
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.mergeProposal(OperationSquashMergeProposal, number, message)
+}
+
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalUpdateBody, number)
+	_, err := self.run(Request{Body: body, Number: number, Operation: OperationUpdateProposalBody}) //exhaustruct:ignore
+	return self.logResult(err)
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalUpdateTarget, number, target)
+	_, err := self.run(Request{Number: number, Operation: OperationUpdateProposalTarget, Target: target.String()}) //exhaustruct:ignore
+	return self.logResult(err)
+}
+
+// logResult logs the outcome of an operation that has logged its start.
+func (self Connector) logResult(err error) error {
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) mergeProposal(operation Operation, number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalMerging, number)
+	_, err := self.run(Request{Message: message.String(), Number: number, Operation: operation}) //exhaustruct:ignore
+	return self.logResult(err)
+}
+
+// run executes the given request via the configured shell command.
+func (self Connector) run(request Request) (Response, error) {
+	request.HeadOrganization = self.HeadOrganization
+	request.HeadRepository = self.HeadRepository
+	request.Hostname = self.Hostname
+	request.Organization = self.Organization
+	request.Repository = self.Repository
+	input, err := json.Marshal(request)
+	if err != nil {
+		return Response{}, err //exhaustruct:ignore
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("sh", "-c", self.command.String())
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return Response{}, fmt.Errorf(messages.HostingExternalFailed, request.Operation, err, strings.TrimSpace(stderr.String())) //exhaustruct:ignore
+	}
+	var response Response
+	if err = json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return Response{}, fmt.Errorf(messages.HostingExternalInvalidResponse, request.Operation, err) //exhaustruct:ignore
+	}
+	if response.Error != "" {
+		return Response{}, fmt.Errorf(messages.HostingExternalError, request.Operation, response.Error) //exhaustruct:ignore
+	}
+	return response, nil
+}
+
+// NewConnector provides a Connector that talks to the hosting platform through the given shell command.
+func NewConnector(args NewConnectorArgs) Connector {
+	return Connector{
+		Data:    hostingdomain.NewData(args.OriginURL, args.PushURL),
+		command: args.Command,
+		log:     args.Log,
+	}
+}
+
+type NewConnectorArgs struct {
+	Command   configdomain.HostingExternalCommand
+	Log       print.Logger
+	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
@@ -0,0 +1,168 @@
+package external_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/external"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestConnector(t *testing.T) {
+	t.Parallel()
+
+	// newConnector provides a Connector whose shell command records the request it receives
+	// in the returned file and responds with the given text.
+	newConnector := func(t *testing.T, response string) (external.Connector, string) {
+		t.Helper()
+		dir := t.TempDir()
+		requestPath := filepath.Join(dir, "request.json")
+		responsePath := filepath.Join(dir, "response.json")
+		must.NoError(t, os.WriteFile(responsePath, []byte(response), 0o600))
+		connector := external.NewConnector(external.NewConnectorArgs{
+			Command:   configdomain.HostingExternalCommand("cat > " + requestPath + " && cat " + responsePath),
+			Log:       print.Logger{},
+			OriginURL: giturl.Parts{Host: "review.example.com", Org: "org", Repo: "repo", User: None[string]()},
+			PushURL:   None[giturl.Parts](),
+		})
+		return connector, requestPath
+	}
+
+	readRequest := func(t *testing.T, path string) external.Request {
+		t.Helper()
+		content, err := os.ReadFile(path)
+		must.NoError(t, err)
+		var request external.Request
+		must.NoError(t, json.Unmarshal(content, &request))
+		return request
+	}
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		connector := external.Connector{}
+		give := hostingdomain.Proposal{
+			Number: 1,
+			Title:  "my title",
+		}
+		must.EqOp(t, "my title (#1)", connector.DefaultProposalMessage(give))
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			connector, requestPath := newConnector(t, `{"proposal": {"number": 12, "title": "my title", "target": "main", "url": "https://review.example.com/12", "mergeable": true, "review_state": "approved", "ci_state": "pending"}}`)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			want := Some(hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStatePending,
+				Draft:        false,
+				MergeWithAPI: true,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateApproved,
+				Target:       gitdomain.NewLocalBranchName("main"),
+				Title:        "my title",
+				URL:          "https://review.example.com/12",
+			})
+			must.Eq(t, want, have)
+			wantRequest := external.Request{ //exhaustruct:ignore
+				Branch:           "feature",
+				HeadOrganization: "org",
+				HeadRepository:   "repo",
+				Hostname:         "review.example.com",
+				Operation:        external.OperationFindProposal,
+				Organization:     "org",
+				Repository:       "repo",
+				Target:           "main",
+			}
+			must.Eq(t, wantRequest, readRequest(t, requestPath))
+		})
+		t.Run("no proposal", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newConnector(t, `{}`)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+		t.Run("error response", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newConnector(t, `{"error": "access denied"}`)
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "access denied")
+		})
+		t.Run("invalid response", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newConnector(t, `zonk`)
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "invalid JSON")
+		})
+	})
+
+	t.Run("FindProposals", func(t *testing.T) {
+		t.Parallel()
+		connector, requestPath := newConnector(t, `{"proposals": [{"branch": "alpha", "number": 1, "target": "main"}, {"branch": "other", "number": 2, "target": "main"}]}`)
+		have, err := connector.FindProposals(gitdomain.NewLocalBranchNames("alpha", "beta"))
+		must.NoError(t, err)
+		must.MapLen(t, 1, have)
+		must.EqOp(t, 1, have[gitdomain.NewLocalBranchName("alpha")].Number)
+		must.Eq(t, []string{"alpha", "beta"}, readRequest(t, requestPath).Branches)
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector, requestPath := newConnector(t, `{"url": "https://review.example.com/new?source=feature"}`)
+		have, err := connector.NewProposalURL(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"), gitdomain.NewLocalBranchName("main"))
+		must.NoError(t, err)
+		must.EqOp(t, "https://review.example.com/new?source=feature", have)
+		request := readRequest(t, requestPath)
+		must.EqOp(t, external.OperationNewProposalURL, request.Operation)
+		must.EqOp(t, "parent", request.Target)
+		must.EqOp(t, "main", request.MainBranch)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provided by the shell command", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newConnector(t, `{"url": "https://review.example.com/org/repo"}`)
+			must.EqOp(t, "https://review.example.com/org/repo", connector.RepositoryURL())
+		})
+		t.Run("shell command provides no URL", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newConnector(t, `{}`)
+			must.EqOp(t, "https://review.example.com/org/repo", connector.RepositoryURL())
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+		connector, requestPath := newConnector(t, `{}`)
+		err := connector.SquashMergeProposal(3, "title\n\nbody")
+		must.NoError(t, err)
+		request := readRequest(t, requestPath)
+		must.EqOp(t, external.OperationSquashMergeProposal, request.Operation)
+		must.EqOp(t, 3, request.Number)
+		must.EqOp(t, "title\n\nbody", request.Message)
+	})
+
+	t.Run("shell command fails", func(t *testing.T) {
+		t.Parallel()
+		connector := external.NewConnector(external.NewConnectorArgs{
+			Command:   "echo 'cannot connect' >&2 && exit 1",
+			Log:       print.Logger{},
+			OriginURL: giturl.Parts{Host: "review.example.com", Org: "org", Repo: "repo", User: None[string]()},
+			PushURL:   None[giturl.Parts](),
+		})
+		err := connector.UpdateProposalTarget(3, gitdomain.NewLocalBranchName("main"))
+		must.ErrorContains(t, err, "cannot connect")
+	})
+}
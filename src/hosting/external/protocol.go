@@ -0,0 +1,110 @@
+package external
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+)
+
+// Operation describes the activity that Git Town asks the external shell command to perform.
+type Operation string
+
+func (self Operation) String() string { return string(self) }
+
+const (
+	OperationCloseProposal        = Operation("close-proposal")         // close the proposal with the given number without merging it
+	OperationCreateProposal       = Operation("create-proposal")        // create a proposal for the given branch into the given target, responds with the proposal
+	OperationFindProposal         = Operation("find-proposal")          // responds with the open proposal for the given branch into the given target, if one exists
+	OperationFindProposals        = Operation("find-proposals")         // responds with the open proposals whose source branch is one of the given branches
+	OperationMergeProposal        = Operation("merge-proposal")         // merge the proposal with the given number using a merge commit
+	OperationMergedProposalSHA    = Operation("merged-proposal-sha")    // responds with the head SHA of the most recently merged proposal for the given branch into the given target
+	OperationNewProposalURL       = Operation("new-proposal-url")       // responds with the URL of the web page to create a proposal for the given branch into the given target
+	OperationReopenProposal       = Operation("reopen-proposal")        // reopen the closed proposal with the given number
+	OperationRepositoryURL        = Operation("repository-url")         // responds with the URL of the repository web page
+	OperationSquashMergeProposal  = Operation("squash-merge-proposal")  // squash-merge the proposal with the given number using the given message
+	OperationUpdateProposalBody   = Operation("update-proposal-body")   // replace the body of the proposal with the given number
+	OperationUpdateProposalTarget = Operation("update-proposal-target") // change the target branch of the proposal with the given number
+)
+
+// Request is the data that Git Town sends to the STDIN of the external shell command.
+// Only the fields relevant to the respective operation are populated.
+type Request struct { //nolint:tagliatelle // the documented protocol of external hosting commands defines these field names
+	Body             string    `json:"body,omitempty"`
+	Branch           string    `json:"branch,omitempty"`
+	Branches         []string  `json:"branches,omitempty"`
+	Draft            bool      `json:"draft,omitempty"`
+	HeadOrganization string    `json:"head_organization"` // owner of the repo containing the branches, differs from Organization for forks
+	HeadRepository   string    `json:"head_repository"`   // name of the repo containing the branches
+	Hostname         string    `json:"hostname"`
+	MainBranch       string    `json:"main_branch,omitempty"`
+	Message          string    `json:"message,omitempty"`
+	Number           int       `json:"number,omitempty"`
+	Operation        Operation `json:"operation"`
+	Organization     string    `json:"organization"`
+	Repository       string    `json:"repository"`
+	Target           string    `json:"target,omitempty"`
+	Title            string    `json:"title,omitempty"`
+}
+
+// Response is the data that the external shell command prints to STDOUT.
+// Operations that don't provide data respond with an empty JSON object.
+type Response struct { //nolint:tagliatelle // the documented protocol of external hosting commands defines these field names
+	Error     string               `json:"error"`     // if populated, the operation failed with this error message
+	Proposal  Option[ProposalData] `json:"proposal"`  // response to "create-proposal" and "find-proposal"
+	Proposals []ProposalData       `json:"proposals"` // response to "find-proposals"
+	SHA       string               `json:"sha"`       // response to "merged-proposal-sha", empty if no proposal was merged
+	URL       string               `json:"url"`       // response to "new-proposal-url" and "repository-url"
+}
+
+// ProposalData describes a proposal in responses of the external shell command.
+type ProposalData struct { //nolint:tagliatelle // the documented protocol of external hosting commands defines these field names
+	Body        string `json:"body"`
+	Branch      string `json:"branch"`   // the source branch, required in responses to "find-proposals"
+	CIState     string `json:"ci_state"` // "passed", "pending", or "failed"
+	Draft       bool   `json:"draft"`
+	Mergeable   bool   `json:"mergeable"` // whether Git Town can merge this proposal via the external shell command
+	Number      int    `json:"number"`
+	ReviewState string `json:"review_state"` // "approved", "changes-requested", or "review-required"
+	Target      string `json:"target"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+}
+
+// Proposal provides the Git Town representation of this proposal.
+func (self ProposalData) Proposal() hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         self.Body,
+		CIState:      parseCIState(self.CIState),
+		Draft:        self.Draft,
+		MergeWithAPI: self.Mergeable,
+		Number:       self.Number,
+		ReviewState:  parseReviewState(self.ReviewState),
+		Target:       gitdomain.LocalBranchName(self.Target),
+		Title:        self.Title,
+		URL:          self.URL,
+	}
+}
+
+func parseCIState(text string) hostingdomain.CIState {
+	switch text {
+	case "passed":
+		return hostingdomain.CIStateSuccess
+	case "pending":
+		return hostingdomain.CIStatePending
+	case "failed":
+		return hostingdomain.CIStateFailure
+	}
+	return hostingdomain.CIStateUnknown
+}
+
+func parseReviewState(text string) hostingdomain.ReviewState {
+	switch text {
+	case "approved":
+		return hostingdomain.ReviewStateApproved
+	case "changes-requested":
+		return hostingdomain.ReviewStateChangesRequested
+	case "review-required":
+		return hostingdomain.ReviewStateRequired
+	}
+	return hostingdomain.ReviewStateUnknown
+}
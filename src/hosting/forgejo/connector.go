@@ -0,0 +1,54 @@
+package forgejo
+
+import (
+	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/gitea"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// Connector provides access to the API of Forgejo installations.
+// Forgejo's API is compatible with the one of Gitea,
+// hence this connector is the Gitea connector with Forgejo's API token and log messages.
+type Connector struct {
+	gitea.Connector
+	APIToken Option[configdomain.ForgejoToken]
+}
+
+// NewConnector provides a Forgejo connector instance.
+func NewConnector(args NewConnectorArgs) Connector {
+	// Forgejo uses its own version numbers, which don't match the Gitea versions that the Gitea SDK checks for.
+	// Ignoring the server version also avoids an API call when creating the connector.
+	client, _ := giteasdk.NewClient(args.APIURL.GetOrElse("https://"+args.OriginURL.Host),
+		giteasdk.SetGiteaVersion(""),
+		giteasdk.SetToken(args.APIToken.String()),
+	)
+	return Connector{
+		APIToken: args.APIToken,
+		Connector: gitea.NewConnectorWithClient(gitea.NewConnectorWithClientArgs{
+			Client: client,
+			Data:   hostingdomain.NewData(args.OriginURL, args.PushURL),
+			Log:    args.Log,
+			LogMessages: gitea.LogMessages{
+				Closing:        messages.HostingForgejoClosingViaAPI,
+				Creating:       messages.HostingForgejoCreatingViaAPI,
+				Merging:        messages.HostingForgejoMergingViaAPI,
+				Reopening:      messages.HostingForgejoReopeningViaAPI,
+				UpdatingBody:   messages.HostingForgejoUpdatePRBodyViaAPI,
+				UpdatingTarget: messages.HostingForgejoUpdatePRViaAPI,
+			},
+		}),
+	}
+}
+
+type NewConnectorArgs struct {
+	APIToken  Option[configdomain.ForgejoToken]
+	APIURL    Option[string] // overrides the address of the Forgejo API, used for testing
+	Log       print.Logger
+	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
@@ -0,0 +1,178 @@
+package forgejo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/forgejo"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/test/helpers"
+	"github.com/shoenig/test/must"
+)
+
+func TestForgejoConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		wantConfig := hostingdomain.Data{
+			HeadOrganization: "org",
+			HeadRepository:   "repo",
+			Hostname:         "codeberg.org",
+			Organization:     "org",
+			Repository:       "repo",
+		}
+		must.EqOp(t, wantConfig, connector.Data)
+	})
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		give := hostingdomain.Proposal{ //exhaustruct:ignore
+			Number: 1,
+			Title:  "my title",
+		}
+		must.EqOp(t, "my title (#1)", connector.DefaultProposalMessage(give))
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		have, err := connector.NewProposalURL("feature", "parent", "main")
+		must.NoError(t, err)
+		must.EqOp(t, "https://codeberg.org/org/repo/compare/parent...feature", have)
+	})
+
+	t.Run("NewProposalURL from a fork", func(t *testing.T) {
+		t.Parallel()
+		connector := newForkTestConnector(t, "http://127.0.0.1:0")
+		have, err := connector.NewProposalURL("feature", "parent", "main")
+		must.NoError(t, err)
+		must.EqOp(t, "https://codeberg.org/org/repo/compare/parent...me:feature", have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "http://127.0.0.1:0")
+		must.EqOp(t, "https://codeberg.org/org/repo", connector.RepositoryURL())
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusCreated, `{"number": 12, "title": "WIP: my title", "body": "my body", "mergeable": true, "html_url": "https://codeberg.org/org/repo/pulls/12", "base": {"ref": "main"}, "head": {"ref": "feature"}}`))
+		connector := newTestConnector(t, server.URL)
+		have, err := connector.CreateProposal("feature", "main", "my title", "my body", true)
+		must.NoError(t, err)
+		want := hostingdomain.Proposal{
+			Body:         "my body",
+			CIState:      hostingdomain.CIStateUnknown,
+			Draft:        true,
+			MergeWithAPI: true,
+			Number:       12,
+			ReviewState:  hostingdomain.ReviewStateUnknown,
+			Target:       "main",
+			Title:        "WIP: my title",
+			URL:          "https://codeberg.org/org/repo/pulls/12",
+		}
+		must.Eq(t, want, have)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodPost, request.Method)
+		must.EqOp(t, "/api/v1/repos/org/repo/pulls", request.Path)
+		must.EqOp(t, "token secret", request.Header.Get("Authorization"))
+		body := request.JSON(t)
+		must.EqOp(t, "WIP: my title", body["title"].(string))
+		must.EqOp(t, "main", body["base"].(string))
+		must.EqOp(t, "feature", body["head"].(string))
+	})
+
+	t.Run("CreateProposal from a fork", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusCreated, `{"number": 12, "title": "my title", "base": {"ref": "main"}, "head": {"ref": "feature"}}`))
+		connector := newForkTestConnector(t, server.URL)
+		have, err := connector.CreateProposal("feature", "main", "my title", "", false)
+		must.NoError(t, err)
+		must.EqOp(t, 12, have.Number)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, "/api/v1/repos/org/repo/pulls", request.Path)
+		must.EqOp(t, "me:feature", request.JSON(t)["head"].(string))
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `[
+			{"number": 12, "title": "my title", "base": {"label": "main", "ref": "main"}, "head": {"label": "org/feature", "ref": "feature"}},
+			{"number": 13, "title": "other", "base": {"label": "main", "ref": "main"}, "head": {"label": "org/other", "ref": "other"}}
+		]`))
+		connector := newTestConnector(t, server.URL)
+		have, err := connector.FindProposal("feature", "main")
+		must.NoError(t, err)
+		proposal, hasProposal := have.Get()
+		must.True(t, hasProposal)
+		must.EqOp(t, 12, proposal.Number)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodGet, request.Method)
+		must.EqOp(t, "/api/v1/repos/org/repo/pulls", request.Path)
+		must.EqOp(t, "open", request.Query.Get("state"))
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"number": 12}`))
+		connector := newTestConnector(t, server.URL)
+		err := connector.SquashMergeProposal(12, "title\n\nbody")
+		must.NoError(t, err)
+		request := server.Requests()[0]
+		must.EqOp(t, http.MethodPost, request.Method)
+		must.EqOp(t, "/api/v1/repos/org/repo/pulls/12/merge", request.Path)
+		body := request.JSON(t)
+		must.EqOp(t, "squash", body["Do"].(string))
+		must.EqOp(t, "title", body["MergeTitleField"].(string))
+		must.EqOp(t, "body", body["MergeMessageField"].(string))
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusCreated, `{"number": 12}`))
+		connector := newTestConnector(t, server.URL)
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		request := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodPatch, request.Method)
+		must.EqOp(t, "/api/v1/repos/org/repo/pulls/12", request.Path)
+		must.EqOp(t, "new-target", request.JSON(t)["base"].(string))
+	})
+}
+
+func newTestConnector(t *testing.T, apiURL string) forgejo.Connector {
+	t.Helper()
+	url, has := giturl.Parse("git@codeberg.org:org/repo.git").Get()
+	must.True(t, has)
+	return forgejo.NewConnector(forgejo.NewConnectorArgs{
+		APIToken:  Some(configdomain.ForgejoToken("secret")),
+		APIURL:    Some(apiURL),
+		Log:       print.Logger{},
+		OriginURL: url,
+		PushURL:   None[giturl.Parts](),
+	})
+}
+
+func newForkTestConnector(t *testing.T, apiURL string) forgejo.Connector {
+	t.Helper()
+	originURL, has := giturl.Parse("git@codeberg.org:org/repo.git").Get()
+	must.True(t, has)
+	pushURL, has := giturl.Parse("git@codeberg.org:me/repo.git").Get()
+	must.True(t, has)
+	return forgejo.NewConnector(forgejo.NewConnectorArgs{
+		APIToken:  Some(configdomain.ForgejoToken("secret")),
+		APIURL:    Some(apiURL),
+		Log:       print.Logger{},
+		OriginURL: originURL,
+		PushURL:   Some(pushURL),
+	})
+}
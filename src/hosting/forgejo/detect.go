@@ -0,0 +1,9 @@
+package forgejo
+
+import "github.com/git-town/git-town/v14/src/git/giturl"
+
+// Detect indicates whether the current repository is hosted on a Forgejo server.
+// Codeberg is the largest public Forgejo installation.
+func Detect(originURL giturl.Parts) bool {
+	return originURL.Host == "codeberg.org"
+}
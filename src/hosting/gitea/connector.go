@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/git-town/git-town/v14/src/cli/print"
@@ -19,9 +20,60 @@ import (
 
 type Connector struct {
 	hostingdomain.Data
-	APIToken Option[configdomain.GiteaToken]
-	client   *gitea.Client
-	log      print.Logger
+	APIToken    Option[configdomain.GiteaToken]
+	client      *gitea.Client
+	log         print.Logger
+	logMessages LogMessages
+}
+
+// LogMessages contains the messages that a Connector logs when it changes data via the API.
+// They allow hosting platforms with a Gitea-compatible API, like Forgejo, to reuse the Connector.
+type LogMessages struct {
+	Closing        string
+	Creating       string
+	Merging        string
+	Reopening      string
+	UpdatingBody   string
+	UpdatingTarget string
+}
+
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(self.logMessages.Closing, number)
+	closed := gitea.StateClosed
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		State: &closed,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(self.logMessages.Creating, branch)
+	if draft {
+		// Gitea marks pull requests as work in progress via a prefix in their title
+		title = "WIP: " + title
+	}
+	pullRequest, _, err := self.client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{
+		Base:  target.String(),
+		Body:  body,
+		Head:  self.HeadRef(branch),
+		Title: title,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //exhaustruct:ignore
+	}
+	self.log.Success()
+	proposal := parsePullRequest(pullRequest)
+	proposal.Draft = draft
+	return proposal, nil
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
@@ -38,57 +90,188 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	if err != nil {
 		return None[hostingdomain.Proposal](), err
 	}
-	pullRequests := FilterPullRequests(openPullRequests, self.Organization, branch, target)
+	pullRequests := FilterPullRequests(openPullRequests, self.HeadOrganization, branch, target)
 	if len(pullRequests) == 0 {
 		return None[hostingdomain.Proposal](), nil
 	}
 	if len(pullRequests) > 1 {
 		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
 	}
-	pullRequest := pullRequests[0]
-	return Some(hostingdomain.Proposal{
-		MergeWithAPI: pullRequest.Mergeable,
-		Number:       int(pullRequest.Index),
-		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
-		Title:        pullRequest.Title,
-	}), nil
+	return Some(parsePullRequest(pullRequests[0])), nil
+}
+
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	pullRequests, err := self.findPullRequests(branches)
+	if err != nil {
+		return result, err
+	}
+	for branch, pullRequest := range pullRequests {
+		proposal := parsePullRequest(pullRequest)
+		reviews, _, err := self.client.ListPullReviews(self.Organization, self.Repository, pullRequest.Index, gitea.ListPullReviewsOptions{}) //exhaustruct:ignore
+		if err != nil {
+			return result, err
+		}
+		proposal.ReviewState = ParseReviewState(reviews)
+		combinedStatus, _, err := self.client.GetCombinedStatus(self.Organization, self.Repository, pullRequest.Head.Sha)
+		if err != nil {
+			return result, err
+		}
+		proposal.CIState = ParseCIState(combinedStatus)
+		result[branch] = proposal
+	}
+	return result, nil
 }
 
 func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName) (string, error) {
-	toCompare := parentBranch.String() + "..." + branch.String()
+	toCompare := parentBranch.String() + "..." + self.HeadRef(branch)
 	return fmt.Sprintf("%s/compare/%s", self.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
+func (self Connector) ReopenProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(self.logMessages.Reopening, number)
+	open := gitea.StateOpen
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		State: &open,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	pullRequests, err := self.findPullRequests(branches)
+	for branch, pullRequest := range pullRequests {
+		result[branch] = parsePullRequest(pullRequest)
+	}
+	return result, err
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	return self.mergePullRequest(number, message.GetOrDefault(), gitea.MergeStyleMerge)
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	result := None[gitdomain.SHA]()
+	latestMerge := time.Time{}
+	options := gitea.ListPullRequestsOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 50,
+		},
+		State: gitea.StateClosed,
+	}
+	for {
+		closedPullRequests, response, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, options)
+		if err != nil {
+			return None[gitdomain.SHA](), err
+		}
+		for _, pullRequest := range FilterPullRequests(closedPullRequests, self.HeadOrganization, branch, target) {
+			if pullRequest.HasMerged && pullRequest.Merged != nil && pullRequest.Merged.After(latestMerge) {
+				latestMerge = *pullRequest.Merged
+				result = gitdomain.NewSHAOption(pullRequest.Head.Sha)
+			}
+		}
+		if response.NextPage == 0 {
+			return result, nil
+		}
+		options.Page = response.NextPage
+	}
+}
+
 func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.mergePullRequest(number, message, gitea.MergeStyleSquash)
+}
+
+// findPullRequests provides the open pull requests whose head is one of the given branches.
+func (self Connector) findPullRequests(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]*gitea.PullRequest, error) {
+	result := map[gitdomain.LocalBranchName]*gitea.PullRequest{}
+	options := gitea.ListPullRequestsOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 50,
+		},
+		State: gitea.StateOpen,
+	}
+	for {
+		openPullRequests, response, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, options)
+		if err != nil {
+			return result, err
+		}
+		for _, pullRequest := range openPullRequests {
+			branch := gitdomain.NewLocalBranchName(pullRequest.Head.Ref)
+			if pullRequest.Head.Name == self.HeadOrganization+"/"+branch.String() && branches.Contains(branch) {
+				result[branch] = pullRequest
+			}
+		}
+		if response.NextPage == 0 {
+			return result, nil
+		}
+		options.Page = response.NextPage
+	}
+}
+
+func (self Connector) mergePullRequest(number int, message gitdomain.CommitMessage, style gitea.MergeStyle) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
+	self.log.Start(self.logMessages.Merging, number)
 	commitMessageParts := message.Parts()
 	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), gitea.MergePullRequestOption{
-		Style:   gitea.MergeStyleSquash,
+		Style:   style,
 		Title:   commitMessageParts.Subject,
 		Message: commitMessageParts.Text,
 	})
 	if err != nil {
+		self.log.Failed(err)
 		return err
 	}
+	self.log.Success()
 	_, _, err = self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
 	return err
 }
 
-func (self Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	// if self.log != nil {
-	// 	self.log(message.HostingGiteaUpdateBasebranchViaAPI, number, target)
-	// }
-	// _, err := self.client.EditPullRequest(self.owner, self.repository, int64(number), gitea.EditPullRequestOption{
-	// 	Base: newBaseName,
-	// })
-	// return err
-	return errors.New(messages.HostingGiteaNotImplemented)
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(self.logMessages.UpdatingBody, number)
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Body: body,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(self.logMessages.UpdatingTarget, number, target)
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Base: target.String(),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
 }
 
 func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string, branch, target gitdomain.LocalBranchName) []*gitea.PullRequest {
@@ -103,26 +286,116 @@ func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string,
 	return result
 }
 
+// ParseCIState determines the state of the CI checks from the given combined commit status of a pull request.
+func ParseCIState(combinedStatus *gitea.CombinedStatus) hostingdomain.CIState {
+	if combinedStatus == nil || combinedStatus.TotalCount == 0 {
+		return hostingdomain.CIStateUnknown
+	}
+	switch combinedStatus.State {
+	case gitea.StatusSuccess, gitea.StatusWarning:
+		return hostingdomain.CIStateSuccess
+	case gitea.StatusPending:
+		return hostingdomain.CIStatePending
+	case gitea.StatusError, gitea.StatusFailure:
+		return hostingdomain.CIStateFailure
+	}
+	return hostingdomain.CIStateUnknown
+}
+
+// ParseReviewState determines the review state of a pull request from its reviews.
+func ParseReviewState(reviews []*gitea.PullReview) hostingdomain.ReviewState {
+	// only the latest review of each reviewer counts
+	latestReviews := map[string]gitea.ReviewStateType{}
+	for _, review := range reviews {
+		if review.Dismissed || review.Reviewer == nil {
+			continue
+		}
+		switch review.State {
+		case gitea.ReviewStateApproved, gitea.ReviewStateRequestChanges, gitea.ReviewStateRequestReview:
+			latestReviews[review.Reviewer.UserName] = review.State
+		case gitea.ReviewStateComment, gitea.ReviewStatePending, gitea.ReviewStateUnknown:
+		}
+	}
+	approved := false
+	reviewRequested := false
+	for _, state := range latestReviews {
+		switch state {
+		case gitea.ReviewStateRequestChanges:
+			return hostingdomain.ReviewStateChangesRequested
+		case gitea.ReviewStateRequestReview:
+			reviewRequested = true
+		case gitea.ReviewStateApproved:
+			approved = true
+		}
+	}
+	switch {
+	case reviewRequested:
+		return hostingdomain.ReviewStateRequired
+	case approved:
+		return hostingdomain.ReviewStateApproved
+	}
+	return hostingdomain.ReviewStateUnknown
+}
+
 // NewGiteaConfig provides Gitea configuration data if the current repo is hosted on Gitea,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) Connector {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
-	giteaClient := gitea.NewClientWithHTTP("https://"+args.OriginURL.Host, httpClient)
+	giteaClient := gitea.NewClientWithHTTP(args.APIURL.GetOrElse("https://"+args.OriginURL.Host), httpClient)
 	return Connector{
 		APIToken: args.APIToken,
-		Data: hostingdomain.Data{
-			Hostname:     args.OriginURL.Host,
-			Organization: args.OriginURL.Org,
-			Repository:   args.OriginURL.Repo,
+		Data:     hostingdomain.NewData(args.OriginURL, args.PushURL),
+		client:   giteaClient,
+		log:      args.Log,
+		logMessages: LogMessages{
+			Closing:        messages.HostingGiteaClosingViaAPI,
+			Creating:       messages.HostingGiteaCreatingViaAPI,
+			Merging:        messages.HostingGiteaMergingViaAPI,
+			Reopening:      messages.HostingGiteaReopeningViaAPI,
+			UpdatingBody:   messages.HostingGiteaUpdatePRBodyViaAPI,
+			UpdatingTarget: messages.HostingGiteaUpdatePRViaAPI,
 		},
-		client: giteaClient,
-		log:    args.Log,
 	}
 }
 
 type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GiteaToken]
+	APIURL    Option[string] // overrides the address of the Gitea API, used for testing
 	Log       print.Logger
 	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
+
+// NewConnectorWithClient provides a Connector that talks to a Gitea-compatible API through the given client.
+func NewConnectorWithClient(args NewConnectorWithClientArgs) Connector {
+	return Connector{
+		APIToken:    None[configdomain.GiteaToken](),
+		Data:        args.Data,
+		client:      args.Client,
+		log:         args.Log,
+		logMessages: args.LogMessages,
+	}
+}
+
+type NewConnectorWithClientArgs struct {
+	Client      *gitea.Client
+	Data        hostingdomain.Data
+	Log         print.Logger
+	LogMessages LogMessages
+}
+
+// parsePullRequest extracts standardized proposal data from the given Gitea pull request.
+func parsePullRequest(pullRequest *gitea.PullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         pullRequest.Body,
+		CIState:      hostingdomain.CIStateUnknown,
+		Draft:        false,
+		MergeWithAPI: pullRequest.Mergeable,
+		Number:       int(pullRequest.Index),
+		ReviewState:  hostingdomain.ReviewStateUnknown,
+		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.HTMLURL,
+	}
 }
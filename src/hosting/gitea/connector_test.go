@@ -1,12 +1,18 @@
 package gitea_test
 
 import (
+	"net/http"
 	"testing"
 
 	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v14/src/cli/print"
+	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/gitea"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/test/helpers"
 	"github.com/shoenig/test/must"
 )
 
@@ -65,8 +71,89 @@ func TestFilterGiteaPullRequests(t *testing.T) {
 }
 
 //nolint:paralleltest  // mocks HTTP
+func TestParseCIState(t *testing.T) {
+	t.Parallel()
+	tests := map[*giteasdk.CombinedStatus]hostingdomain.CIState{
+		nil: hostingdomain.CIStateUnknown,
+		{State: giteasdk.StatusSuccess, TotalCount: 0}: hostingdomain.CIStateUnknown,
+		{State: giteasdk.StatusSuccess, TotalCount: 2}: hostingdomain.CIStateSuccess,
+		{State: giteasdk.StatusWarning, TotalCount: 1}: hostingdomain.CIStateSuccess,
+		{State: giteasdk.StatusPending, TotalCount: 1}: hostingdomain.CIStatePending,
+		{State: giteasdk.StatusFailure, TotalCount: 1}: hostingdomain.CIStateFailure,
+		{State: giteasdk.StatusError, TotalCount: 1}:   hostingdomain.CIStateFailure,
+	}
+	for give, want := range tests {
+		have := gitea.ParseCIState(give)
+		must.EqOp(t, want, have)
+	}
+}
+
+func TestParseReviewState(t *testing.T) {
+	t.Parallel()
+	alice := &giteasdk.User{UserName: "alice"}
+	bob := &giteasdk.User{UserName: "bob"}
+
+	t.Run("no reviews", func(t *testing.T) {
+		t.Parallel()
+		have := gitea.ParseReviewState([]*giteasdk.PullReview{})
+		must.EqOp(t, hostingdomain.ReviewStateUnknown, have)
+	})
+
+	t.Run("approved", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullReview{
+			{Reviewer: alice, State: giteasdk.ReviewStateApproved},
+			{Reviewer: bob, State: giteasdk.ReviewStateComment},
+		}
+		have := gitea.ParseReviewState(give)
+		must.EqOp(t, hostingdomain.ReviewStateApproved, have)
+	})
+
+	t.Run("changes requested by one reviewer", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullReview{
+			{Reviewer: alice, State: giteasdk.ReviewStateApproved},
+			{Reviewer: bob, State: giteasdk.ReviewStateRequestChanges},
+		}
+		have := gitea.ParseReviewState(give)
+		must.EqOp(t, hostingdomain.ReviewStateChangesRequested, have)
+	})
+
+	t.Run("approval after requesting changes", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullReview{
+			{Reviewer: alice, State: giteasdk.ReviewStateRequestChanges},
+			{Reviewer: alice, State: giteasdk.ReviewStateApproved},
+		}
+		have := gitea.ParseReviewState(give)
+		must.EqOp(t, hostingdomain.ReviewStateApproved, have)
+	})
+
+	t.Run("dismissed review", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullReview{
+			{Reviewer: alice, State: giteasdk.ReviewStateRequestChanges, Dismissed: true},
+		}
+		have := gitea.ParseReviewState(give)
+		must.EqOp(t, hostingdomain.ReviewStateUnknown, have)
+	})
+
+	t.Run("review requested", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullReview{
+			{Reviewer: alice, State: giteasdk.ReviewStateApproved},
+			{Reviewer: bob, State: giteasdk.ReviewStateRequestReview},
+		}
+		have := gitea.ParseReviewState(give)
+		must.EqOp(t, hostingdomain.ReviewStateRequired, have)
+	})
+}
+
 func TestGitea(t *testing.T) {
+	t.Parallel()
+
 	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
 		give := hostingdomain.Proposal{
 			Number: 1,
 			Title:  "my title",
@@ -109,6 +196,72 @@ func TestGitea(t *testing.T) {
 	// 	have := connector.RepositoryURL()
 	// 	must.EqOp(t, "https://gitea.com/git-town/docs", have)
 	// })
+
+	t.Run("MergedProposalSHA", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provides the most recently merged pull request", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, giteaResponder(`[
+				{"number": 11, "merged": true, "merged_at": "2024-01-01T00:00:00Z", "base": {"label": "main", "ref": "main"}, "head": {"label": "git-town/feature", "ref": "feature", "sha": "111111"}},
+				{"number": 12, "merged": true, "merged_at": "2024-03-01T00:00:00Z", "base": {"label": "main", "ref": "main"}, "head": {"label": "git-town/feature", "ref": "feature", "sha": "222222"}},
+				{"number": 13, "merged": false, "base": {"label": "main", "ref": "main"}, "head": {"label": "git-town/feature", "ref": "feature", "sha": "333333"}},
+				{"number": 14, "merged": true, "merged_at": "2024-04-01T00:00:00Z", "base": {"label": "main", "ref": "main"}, "head": {"label": "git-town/other", "ref": "other", "sha": "444444"}}
+			]`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.MergedProposalSHA("feature", "main")
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.NewSHA("222222")), have)
+		})
+
+		t.Run("no merged pull request", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, giteaResponder(`[
+				{"number": 13, "merged": false, "base": {"label": "main", "ref": "main"}, "head": {"label": "git-town/feature", "ref": "feature", "sha": "333333"}}
+			]`))
+			connector := newTestConnector(t, server.URL)
+			have, err := connector.MergedProposalSHA("feature", "main")
+			must.NoError(t, err)
+			must.Eq(t, None[gitdomain.SHA](), have)
+		})
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		t.Run("happy path", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, giteaResponder(`{"number": 12, "base": {"ref": "new-target"}}`))
+			connector := newTestConnector(t, server.URL)
+			err := connector.UpdateProposalTarget(12, "new-target")
+			must.NoError(t, err)
+			edits := requestsWithMethod(server.Requests(), http.MethodPatch)
+			must.SliceLen(t, 1, edits)
+			must.EqOp(t, "/api/v1/repos/git-town/docs/pulls/12", edits[0].Path)
+			must.EqOp(t, "Bearer secret", edits[0].Header.Get("Authorization"))
+			must.EqOp(t, "new-target", edits[0].JSON(t)["base"])
+		})
+
+		t.Run("no proposal number", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, giteaResponder(""))
+			connector := newTestConnector(t, server.URL)
+			err := connector.UpdateProposalTarget(0, "new-target")
+			must.ErrorContains(t, err, "no proposal number given")
+			must.SliceEmpty(t, requestsWithMethod(server.Requests(), http.MethodPatch))
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, func(request helpers.RecordedRequest) (int, string) {
+				if request.Method == http.MethodPatch {
+					return http.StatusForbidden, `{"message": "access denied"}`
+				}
+				return giteaResponder("")(request)
+			})
+			connector := newTestConnector(t, server.URL)
+			err := connector.UpdateProposalTarget(12, "new-target")
+			must.ErrorContains(t, err, "access denied")
+		})
+	})
 }
 
 func TestNewGiteaConnector(t *testing.T) {
@@ -168,3 +321,37 @@ func TestNewGiteaConnector(t *testing.T) {
 	// 	must.NoError(t, err)
 	// })
 }
+
+// giteaResponder answers the version check of the Gitea SDK and all other requests with the given body.
+func giteaResponder(body string) helpers.Responder {
+	return func(request helpers.RecordedRequest) (int, string) {
+		if request.Path == "/api/v1/version" {
+			return http.StatusOK, `{"version": "1.22.0"}`
+		}
+		return http.StatusOK, body
+	}
+}
+
+func newTestConnector(t *testing.T, apiURL string) gitea.Connector {
+	t.Helper()
+	url, has := giturl.Parse("git@gitea.com:git-town/docs.git").Get()
+	must.True(t, has)
+	return gitea.NewConnector(gitea.NewConnectorArgs{
+		APIToken:  Some(configdomain.GiteaToken("secret")),
+		APIURL:    Some(apiURL),
+		Log:       print.Logger{},
+		OriginURL: url,
+		PushURL:   None[giturl.Parts](),
+	})
+}
+
+// requestsWithMethod provides the given requests that use the given HTTP method.
+func requestsWithMethod(requests []helpers.RecordedRequest, method string) []helpers.RecordedRequest {
+	result := []helpers.RecordedRequest{}
+	for _, request := range requests {
+		if request.Method == method {
+			result = append(result, request)
+		}
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+)
+
+// This file contains the parts of the GitHub GraphQL API data structures that Git Town uses.
+// See https://docs.github.com/en/graphql/reference/objects#pullrequest.
+
+// graphqlURL is the address of the GraphQL API relative to the address of the REST API.
+// This resolves to https://api.github.com/graphql for github.com
+// and to https://<host>/api/graphql for GitHub Enterprise.
+const graphqlURL = "../graphql"
+
+// pullRequestFragment defines the pull request fields that Git Town queries.
+const pullRequestFragment = `
+fragment pullRequestFields on PullRequest {
+  baseRefName
+  body
+  commits(last: 1) { nodes { commit { statusCheckRollup { state } } } }
+  headRepositoryOwner { login }
+  isDraft
+  latestReviews(first: 100) { nodes { author { login } state } }
+  mergeStateStatus
+  number
+  reviewRequests { totalCount }
+  title
+  url
+}`
+
+type graphqlAccount struct {
+	Login string `json:"login"`
+}
+
+type graphqlCheckRollup struct {
+	State string `json:"state"`
+}
+
+type graphqlCommit struct {
+	StatusCheckRollup *graphqlCheckRollup `json:"statusCheckRollup"`
+}
+
+type graphqlCommitNode struct {
+	Commit graphqlCommit `json:"commit"`
+}
+
+type graphqlCommits struct {
+	Nodes []graphqlCommitNode `json:"nodes"`
+}
+
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+type graphqlPullRequest struct {
+	BaseRefName         string          `json:"baseRefName"`
+	Body                string          `json:"body"`
+	Commits             graphqlCommits  `json:"commits"`
+	HeadRepositoryOwner *graphqlAccount `json:"headRepositoryOwner"` // missing if the head repository was deleted
+	IsDraft             bool            `json:"isDraft"`
+	LatestReviews       graphqlReviews  `json:"latestReviews"`
+	MergeStateStatus    string          `json:"mergeStateStatus"`
+	Number              int             `json:"number"`
+	ReviewRequests      graphqlCount    `json:"reviewRequests"`
+	Title               string          `json:"title"`
+	URL                 string          `json:"url"`
+}
+
+type graphqlCount struct {
+	TotalCount int `json:"totalCount"`
+}
+
+type graphqlPullRequests struct {
+	Nodes []graphqlPullRequest `json:"nodes"`
+}
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphqlResponse struct {
+	Data   graphqlResponseData `json:"data"`
+	Errors []graphqlError      `json:"errors"`
+}
+
+type graphqlResponseData struct {
+	// the open pull requests for each queried branch, keyed by the alias of the branch
+	Repository map[string]graphqlPullRequests `json:"repository"`
+}
+
+type graphqlReview struct {
+	Author graphqlAccount `json:"author"`
+	State  string         `json:"state"`
+}
+
+type graphqlReviews struct {
+	Nodes []graphqlReview `json:"nodes"`
+}
+
+// ciState provides the state of the CI checks of the latest commit of this pull request.
+func (self graphqlPullRequest) ciState() string {
+	if len(self.Commits.Nodes) == 0 || self.Commits.Nodes[0].Commit.StatusCheckRollup == nil {
+		return ""
+	}
+	return self.Commits.Nodes[0].Commit.StatusCheckRollup.State
+}
+
+// headOwner provides the login of the owner of the repository containing the head branch of this pull request.
+func (self graphqlPullRequest) headOwner() string {
+	if self.HeadRepositoryOwner == nil {
+		return ""
+	}
+	return self.HeadRepositoryOwner.Login
+}
+
+// branchAlias provides the GraphQL alias under which the query returns the pull requests of the branch with the given index.
+func branchAlias(index int) string {
+	return fmt.Sprintf("branch%d", index)
+}
+
+// openPullRequestsQuery provides a GraphQL query that loads the open pull requests of all given branches at once.
+func openPullRequestsQuery(owner, repo string, branches gitdomain.LocalBranchNames) graphqlRequest {
+	parameters := []string{"$owner: String!", "$repo: String!"}
+	fields := []string{}
+	variables := map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	}
+	for b, branch := range branches {
+		alias := branchAlias(b)
+		parameters = append(parameters, fmt.Sprintf("$%s: String!", alias))
+		fields = append(fields, fmt.Sprintf("    %s: pullRequests(headRefName: $%s, states: OPEN, first: 10) { nodes { ...pullRequestFields } }", alias, alias))
+		variables[alias] = branch.String()
+	}
+	query := fmt.Sprintf("query(%s) {\n  repository(owner: $owner, name: $repo) {\n%s\n  }\n}\n%s", strings.Join(parameters, ", "), strings.Join(fields, "\n"), pullRequestFragment)
+	return graphqlRequest{
+		Query:     query,
+		Variables: variables,
+	}
+}
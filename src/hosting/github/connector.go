@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 
 	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
@@ -27,13 +28,46 @@ type Connector struct {
 	log      print.Logger
 }
 
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubClosingViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		State: github.String("closed"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGithubCreatingViaAPI, branch)
+	pullRequest, _, err := self.client.PullRequests.Create(context.Background(), self.Organization, self.Repository, &github.NewPullRequest{
+		Base:  github.String(target.String()),
+		Body:  github.String(body),
+		Draft: github.Bool(draft),
+		Head:  github.String(self.HeadRef(branch)),
+		Title: github.String(title),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //exhaustruct:ignore
+	}
+	self.log.Success()
+	return parsePullRequest(pullRequest), nil
+}
+
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
 func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
-		Head:  self.Organization + ":" + branch.String(),
+		Head:  self.HeadOrganization + ":" + branch.String(),
 		Base:  target.String(),
 		State: "open",
 	})
@@ -50,32 +84,148 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	return Some(proposal), nil
 }
 
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	if len(branches) == 0 || self.APIToken.IsNone() {
+		// the GraphQL API requires authentication
+		return result, nil
+	}
+	// a single GraphQL query loads the pull requests of all branches including their review and CI state
+	request, err := self.client.NewRequest("POST", graphqlURL, openPullRequestsQuery(self.Organization, self.Repository, branches))
+	if err != nil {
+		return result, err
+	}
+	response := graphqlResponse{} //exhaustruct:ignore
+	if _, err = self.client.Do(context.Background(), request, &response); err != nil {
+		return result, err
+	}
+	if len(response.Errors) > 0 {
+		return result, fmt.Errorf(messages.HostingGithubGraphQLError, response.Errors[0].Message)
+	}
+	for b, branch := range branches {
+		for _, pullRequest := range response.Data.Repository[branchAlias(b)].Nodes {
+			// pull requests from forks can have the same head branch name
+			if strings.EqualFold(pullRequest.headOwner(), self.HeadOrganization) {
+				result[branch] = parseGraphQLPullRequest(pullRequest)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	// GitHub lists the newest pull requests first
+	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+		Head:  self.HeadOrganization + ":" + branch.String(),
+		Base:  target.String(),
+		State: "closed",
+	})
+	if err != nil {
+		return None[gitdomain.SHA](), err
+	}
+	for _, pullRequest := range pullRequests {
+		if pullRequest.MergedAt != nil {
+			return gitdomain.NewSHAOption(pullRequest.GetHead().GetSHA()), nil
+		}
+	}
+	return None[gitdomain.SHA](), nil
+}
+
 func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName) (string, error) {
-	toCompare := branch.String()
+	toCompare := self.HeadRef(branch)
 	if parentBranch != mainBranch {
-		toCompare = parentBranch.String() + "..." + branch.String()
+		toCompare = parentBranch.String() + "..." + self.HeadRef(branch)
 	}
 	return fmt.Sprintf("%s/compare/%s?expand=1", self.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
+func (self Connector) ReopenProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubReopeningViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		State: github.String("open"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) (err error) {
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	pullRequests, err := self.findPullRequests(branches)
+	for branch, pullRequest := range pullRequests {
+		result[branch] = parsePullRequest(pullRequest)
+	}
+	return result, err
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	return self.mergeProposal(number, message.GetOrDefault(), "merge")
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.mergeProposal(number, message, "squash")
+}
+
+// findPullRequests provides the open pull requests whose head is one of the given branches.
+func (self Connector) findPullRequests(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]*github.PullRequest, error) {
+	result := map[gitdomain.LocalBranchName]*github.PullRequest{}
+	for _, branch := range branches {
+		// querying by head branch avoids paging through all open pull requests of large repositories
+		pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+			Head:  self.HeadOrganization + ":" + branch.String(),
+			State: "open",
+		})
+		if err != nil {
+			return result, err
+		}
+		if len(pullRequests) > 0 {
+			result[branch] = pullRequests[0]
+		}
+	}
+	return result, nil
+}
+
+func (self Connector) mergeProposal(number int, message gitdomain.CommitMessage, mergeMethod string) (err error) {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	self.log.Start(messages.HostingGithubMergingViaAPI, number)
 	commitMessageParts := message.Parts()
 	_, _, err = self.client.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number, commitMessageParts.Text, &github.PullRequestOptions{
-		MergeMethod: "squash",
+		MergeMethod: mergeMethod,
 		CommitTitle: commitMessageParts.Subject,
 	})
 	self.log.Success()
 	return err
 }
 
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubUpdatePRBodyViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		Body: github.String(body),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	self.log.Start(messages.HostingGithubUpdatePRViaAPI, number)
 	targetName := target.String()
@@ -114,38 +264,109 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
 	githubClient := github.NewClient(httpClient)
-	if args.OriginURL.Host != "github.com" {
-		url := "https://" + args.OriginURL.Host
+	apiURL, hasAPIURL := args.APIURL.Get()
+	if !hasAPIURL && args.OriginURL.Host != "github.com" {
+		apiURL = "https://" + args.OriginURL.Host
+		hasAPIURL = true
+	}
+	if hasAPIURL {
 		var err error
-		githubClient, err = githubClient.WithEnterpriseURLs(url, url)
+		githubClient, err = githubClient.WithEnterpriseURLs(apiURL, apiURL)
 		if err != nil {
 			return Connector{}, fmt.Errorf(messages.GitHubEnterpriseInitializeError, err)
 		}
 	}
 	return Connector{
 		APIToken: args.APIToken,
-		Data: hostingdomain.Data{
-			Hostname:     args.OriginURL.Host,
-			Organization: args.OriginURL.Org,
-			Repository:   args.OriginURL.Repo,
-		},
-		client: githubClient,
-		log:    args.Log,
+		Data:     hostingdomain.NewData(args.OriginURL, args.PushURL),
+		client:   githubClient,
+		log:      args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GitHubToken]
+	APIURL    Option[string] // overrides the address of the GitHub API, used for testing
 	Log       print.Logger
 	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
+
+// ParseCIState determines the state of the CI checks from the given state of the status check rollup of a pull request.
+func ParseCIState(rollupState string) hostingdomain.CIState {
+	switch rollupState {
+	case "SUCCESS":
+		return hostingdomain.CIStateSuccess
+	case "ERROR", "FAILURE":
+		return hostingdomain.CIStateFailure
+	case "EXPECTED", "PENDING":
+		return hostingdomain.CIStatePending
+	}
+	return hostingdomain.CIStateUnknown
+}
+
+// ParseReviewState determines the review state of a pull request from its reviews
+// and the number of reviewers whose review was requested but is still outstanding.
+func ParseReviewState(reviews []*github.PullRequestReview, requestedReviewers int) hostingdomain.ReviewState {
+	// only the latest review of each reviewer counts
+	latestReviews := map[string]string{}
+	for _, review := range reviews {
+		switch review.GetState() {
+		case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+			latestReviews[review.GetUser().GetLogin()] = review.GetState()
+		}
+	}
+	approved := false
+	for _, state := range latestReviews {
+		switch state {
+		case "CHANGES_REQUESTED":
+			return hostingdomain.ReviewStateChangesRequested
+		case "APPROVED":
+			approved = true
+		}
+	}
+	switch {
+	case requestedReviewers > 0:
+		return hostingdomain.ReviewStateRequired
+	case approved:
+		return hostingdomain.ReviewStateApproved
+	}
+	return hostingdomain.ReviewStateUnknown
+}
+
+// parseGraphQLPullRequest extracts standardized proposal data including the review and CI state from the given GitHub pull request.
+func parseGraphQLPullRequest(pullRequest graphqlPullRequest) hostingdomain.Proposal {
+	reviews := make([]*github.PullRequestReview, len(pullRequest.LatestReviews.Nodes))
+	for r, review := range pullRequest.LatestReviews.Nodes {
+		reviews[r] = &github.PullRequestReview{
+			State: github.String(review.State),
+			User:  &github.User{Login: github.String(review.Author.Login)},
+		}
+	}
+	return hostingdomain.Proposal{
+		Body:         pullRequest.Body,
+		CIState:      ParseCIState(pullRequest.ciState()),
+		Draft:        pullRequest.IsDraft,
+		MergeWithAPI: pullRequest.MergeStateStatus == "CLEAN",
+		Number:       pullRequest.Number,
+		ReviewState:  ParseReviewState(reviews, pullRequest.ReviewRequests.TotalCount),
+		Target:       gitdomain.NewLocalBranchName(pullRequest.BaseRefName),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.URL,
+	}
 }
 
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         pullRequest.GetBody(),
+		CIState:      hostingdomain.CIStateUnknown,
+		Draft:        pullRequest.GetDraft(),
+		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
 		Number:       pullRequest.GetNumber(),
+		ReviewState:  hostingdomain.ReviewStateUnknown,
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
 		Title:        pullRequest.GetTitle(),
-		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
+		URL:          pullRequest.GetHTMLURL(),
 	}
 }
@@ -1,14 +1,18 @@
 package github_test
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/github"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/test/helpers"
+	gh "github.com/google/go-github/v58/github"
 	"github.com/shoenig/test/must"
 )
 
@@ -27,27 +31,156 @@ func TestConnector(t *testing.T) {
 		must.EqOp(t, want, have)
 	})
 
+	t.Run("FindProposals", func(t *testing.T) {
+		t.Parallel()
+		newConnector := func(t *testing.T, server helpers.RecordingServer, apiToken Option[configdomain.GitHubToken]) github.Connector {
+			t.Helper()
+			originURL, has := giturl.Parse("git@github.com:git-town/docs.git").Get()
+			must.True(t, has)
+			connector, err := github.NewConnector(github.NewConnectorArgs{
+				APIToken:  apiToken,
+				APIURL:    Some(server.URL),
+				Log:       print.Logger{},
+				OriginURL: originURL,
+				PushURL:   None[giturl.Parts](),
+			})
+			must.NoError(t, err)
+			return connector
+		}
+
+		t.Run("loads all branches with a single query", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{
+				"data": {
+					"repository": {
+						"branch0": {
+							"nodes": [
+								{
+									"number": 2,
+									"title": "fork title",
+									"headRepositoryOwner": {"login": "someone-else"},
+									"baseRefName": "main"
+								},
+								{
+									"number": 1,
+									"title": "my title",
+									"url": "https://github.com/git-town/docs/pull/1",
+									"headRepositoryOwner": {"login": "git-town"},
+									"baseRefName": "main",
+									"mergeStateStatus": "CLEAN",
+									"latestReviews": {"nodes": [{"author": {"login": "alice"}, "state": "APPROVED"}]},
+									"reviewRequests": {"totalCount": 0},
+									"commits": {"nodes": [{"commit": {"statusCheckRollup": {"state": "SUCCESS"}}}]}
+								}
+							]
+						},
+						"branch1": {"nodes": []}
+					}
+				}
+			}`))
+			connector := newConnector(t, server, configdomain.NewGitHubTokenOption("apiToken"))
+			have, err := connector.FindProposals(gitdomain.NewLocalBranchNames("feature", "other"))
+			must.NoError(t, err)
+			must.MapLen(t, 1, have)
+			proposal := have[gitdomain.NewLocalBranchName("feature")]
+			must.EqOp(t, 1, proposal.Number)
+			must.EqOp(t, "my title", proposal.Title)
+			must.True(t, proposal.MergeWithAPI)
+			must.EqOp(t, hostingdomain.ReviewStateApproved, proposal.ReviewState)
+			must.EqOp(t, hostingdomain.CIStateSuccess, proposal.CIState)
+			request := server.OnlyRequest(t)
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/api/graphql", request.Path)
+			variables := request.JSON(t)["variables"]
+			must.Eq[any](t, map[string]any{"owner": "git-town", "repo": "docs", "branch0": "feature", "branch1": "other"}, variables)
+		})
+
+		t.Run("query error", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, `{"data": null, "errors": [{"message": "Something went wrong"}]}`))
+			connector := newConnector(t, server, configdomain.NewGitHubTokenOption("apiToken"))
+			_, err := connector.FindProposals(gitdomain.NewLocalBranchNames("feature"))
+			must.ErrorContains(t, err, "Something went wrong")
+		})
+
+		t.Run("no API token", func(t *testing.T) {
+			t.Parallel()
+			server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusInternalServerError, ""))
+			connector := newConnector(t, server, None[configdomain.GitHubToken]())
+			have, err := connector.FindProposals(gitdomain.NewLocalBranchNames("feature"))
+			must.NoError(t, err)
+			must.MapEmpty(t, have)
+			must.SliceEmpty(t, server.Requests())
+		})
+	})
+
+	t.Run("ListProposals", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, func(request helpers.RecordedRequest) (int, string) {
+			if request.Query.Get("head") == "git-town:feature" {
+				return http.StatusOK, `[{"number": 1, "body": "my body", "head": {"ref": "feature", "sha": "111111"}, "base": {"ref": "main"}}]`
+			}
+			return http.StatusOK, `[]`
+		})
+		originURL, has := giturl.Parse("git@github.com:git-town/docs.git").Get()
+		must.True(t, has)
+		connector, err := github.NewConnector(github.NewConnectorArgs{
+			APIToken:  configdomain.NewGitHubTokenOption("apiToken"),
+			APIURL:    Some(server.URL),
+			Log:       print.Logger{},
+			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
+		})
+		must.NoError(t, err)
+		have, err := connector.ListProposals(gitdomain.NewLocalBranchNames("feature", "other"))
+		must.NoError(t, err)
+		must.MapLen(t, 1, have)
+		proposal := have[gitdomain.NewLocalBranchName("feature")]
+		must.EqOp(t, 1, proposal.Number)
+		must.EqOp(t, "my body", proposal.Body)
+		// listing proposals doesn't load their review and CI state
+		for _, request := range server.Requests() {
+			must.EqOp(t, "/api/v3/repos/git-town/docs/pulls", request.Path)
+		}
+	})
+
 	t.Run("NewProposalURL", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]struct {
-			branch gitdomain.LocalBranchName
-			parent gitdomain.LocalBranchName
-			want   string
+			branch  gitdomain.LocalBranchName
+			headOrg string
+			parent  gitdomain.LocalBranchName
+			want    string
 		}{
 			"top-level branch": {
-				branch: gitdomain.NewLocalBranchName("feature"),
-				parent: gitdomain.NewLocalBranchName("main"),
-				want:   "https://github.com/organization/repo/compare/feature?expand=1",
+				branch:  gitdomain.NewLocalBranchName("feature"),
+				headOrg: "organization",
+				parent:  gitdomain.NewLocalBranchName("main"),
+				want:    "https://github.com/organization/repo/compare/feature?expand=1",
 			},
 			"stacked change": {
-				branch: gitdomain.NewLocalBranchName("feature-3"),
-				parent: gitdomain.NewLocalBranchName("feature-2"),
-				want:   "https://github.com/organization/repo/compare/feature-2...feature-3?expand=1",
+				branch:  gitdomain.NewLocalBranchName("feature-3"),
+				headOrg: "organization",
+				parent:  gitdomain.NewLocalBranchName("feature-2"),
+				want:    "https://github.com/organization/repo/compare/feature-2...feature-3?expand=1",
 			},
 			"special characters in branch name": {
-				branch: gitdomain.NewLocalBranchName("feature-#"),
-				parent: gitdomain.NewLocalBranchName("main"),
-				want:   "https://github.com/organization/repo/compare/feature-%23?expand=1",
+				branch:  gitdomain.NewLocalBranchName("feature-#"),
+				headOrg: "organization",
+				parent:  gitdomain.NewLocalBranchName("main"),
+				want:    "https://github.com/organization/repo/compare/feature-%23?expand=1",
+			},
+			"branch in a fork": {
+				branch:  gitdomain.NewLocalBranchName("feature"),
+				headOrg: "me",
+				parent:  gitdomain.NewLocalBranchName("main"),
+				want:    "https://github.com/organization/repo/compare/me:feature?expand=1",
+			},
+			"stacked change in a fork": {
+				branch:  gitdomain.NewLocalBranchName("feature-3"),
+				headOrg: "me",
+				parent:  gitdomain.NewLocalBranchName("feature-2"),
+				want:    "https://github.com/organization/repo/compare/feature-2...me:feature-3?expand=1",
 			},
 		}
 		for name, tt := range tests {
@@ -55,9 +188,11 @@ func TestConnector(t *testing.T) {
 				main := gitdomain.NewLocalBranchName("main")
 				connector := github.Connector{
 					Data: hostingdomain.Data{
-						Hostname:     "github.com",
-						Organization: "organization",
-						Repository:   "repo",
+						HeadOrganization: tt.headOrg,
+						HeadRepository:   "repo",
+						Hostname:         "github.com",
+						Organization:     "organization",
+						Repository:       "repo",
 					},
 					APIToken: configdomain.NewGitHubTokenOption("apiToken"),
 				}
@@ -68,13 +203,75 @@ func TestConnector(t *testing.T) {
 		}
 	})
 
+	t.Run("ParseCIState", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]hostingdomain.CIState{
+			"":         hostingdomain.CIStateUnknown,
+			"SUCCESS":  hostingdomain.CIStateSuccess,
+			"PENDING":  hostingdomain.CIStatePending,
+			"EXPECTED": hostingdomain.CIStatePending,
+			"FAILURE":  hostingdomain.CIStateFailure,
+			"ERROR":    hostingdomain.CIStateFailure,
+		}
+		for give, want := range tests {
+			have := github.ParseCIState(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("ParseReviewState", func(t *testing.T) {
+		t.Parallel()
+		review := func(user, state string) *gh.PullRequestReview {
+			return &gh.PullRequestReview{User: &gh.User{Login: gh.String(user)}, State: gh.String(state)}
+		}
+		tests := map[string]struct {
+			reviews            []*gh.PullRequestReview
+			requestedReviewers int
+			want               hostingdomain.ReviewState
+		}{
+			"no reviews": {
+				reviews:            []*gh.PullRequestReview{},
+				requestedReviewers: 0,
+				want:               hostingdomain.ReviewStateUnknown,
+			},
+			"review requested": {
+				reviews:            []*gh.PullRequestReview{review("alice", "COMMENTED")},
+				requestedReviewers: 1,
+				want:               hostingdomain.ReviewStateRequired,
+			},
+			"approved": {
+				reviews:            []*gh.PullRequestReview{review("alice", "COMMENTED"), review("bob", "APPROVED")},
+				requestedReviewers: 0,
+				want:               hostingdomain.ReviewStateApproved,
+			},
+			"changes requested": {
+				reviews:            []*gh.PullRequestReview{review("alice", "APPROVED"), review("bob", "CHANGES_REQUESTED")},
+				requestedReviewers: 0,
+				want:               hostingdomain.ReviewStateChangesRequested,
+			},
+			"approved after requesting changes": {
+				reviews:            []*gh.PullRequestReview{review("alice", "CHANGES_REQUESTED"), review("alice", "APPROVED")},
+				requestedReviewers: 0,
+				want:               hostingdomain.ReviewStateApproved,
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				have := github.ParseReviewState(tt.reviews, tt.requestedReviewers)
+				must.EqOp(t, tt.want, have)
+			})
+		}
+	})
+
 	t.Run("RepositoryURL", func(t *testing.T) {
 		t.Parallel()
 		connector := github.Connector{
 			Data: hostingdomain.Data{
-				Hostname:     "github.com",
-				Organization: "organization",
-				Repository:   "repo",
+				HeadOrganization: "organization",
+				HeadRepository:   "repo",
+				Hostname:         "github.com",
+				Organization:     "organization",
+				Repository:       "repo",
 			},
 		}
 		have := connector.RepositoryURL()
@@ -92,14 +289,18 @@ func TestNewConnector(t *testing.T) {
 		must.True(t, has)
 		have, err := github.NewConnector(github.NewConnectorArgs{
 			APIToken:  configdomain.NewGitHubTokenOption("apiToken"),
+			APIURL:    None[string](),
 			Log:       print.Logger{},
 			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Data{
-			Hostname:     "github.com",
-			Organization: "git-town",
-			Repository:   "docs",
+			HeadOrganization: "git-town",
+			HeadRepository:   "docs",
+			Hostname:         "github.com",
+			Organization:     "git-town",
+			Repository:       "docs",
 		}
 		must.EqOp(t, wantConfig, have.Data)
 	})
@@ -110,14 +311,18 @@ func TestNewConnector(t *testing.T) {
 		must.True(t, has)
 		have, err := github.NewConnector(github.NewConnectorArgs{
 			APIToken:  configdomain.NewGitHubTokenOption("apiToken"),
+			APIURL:    None[string](),
 			Log:       print.Logger{},
 			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Data{
-			Hostname:     "custom-url.com",
-			Organization: "git-town",
-			Repository:   "docs",
+			HeadOrganization: "git-town",
+			HeadRepository:   "docs",
+			Hostname:         "custom-url.com",
+			Organization:     "git-town",
+			Repository:       "docs",
 		}
 		must.EqOp(t, wantConfig, have.Data)
 	})
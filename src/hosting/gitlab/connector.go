@@ -23,6 +23,52 @@ type Connector struct {
 	log print.Logger
 }
 
+func (self Connector) CloseProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGitlabClosingViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.Ptr("close"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGitlabCreatingViaAPI, branch)
+	if draft {
+		// GitLab marks merge requests as drafts via a prefix in their title
+		title = "Draft: " + title
+	}
+	options := &gitlab.CreateMergeRequestOptions{
+		Description:  gitlab.Ptr(body),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(target.String()),
+		Title:        gitlab.Ptr(title),
+	}
+	if self.IsCrossRepo() {
+		// merge requests from forks get created in the fork and target the upstream project
+		project, _, err := self.client.Projects.GetProject(self.projectPath(), nil)
+		if err != nil {
+			self.log.Failed(err)
+			return hostingdomain.Proposal{}, err //exhaustruct:ignore
+		}
+		options.TargetProjectID = gitlab.Ptr(project.ID)
+	}
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.headProjectPath(), options)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //exhaustruct:ignore
+	}
+	self.log.Success()
+	return parseMergeRequest(mergeRequest), nil
+}
+
 func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	opts := &gitlab.ListProjectMergeRequestsOptions{
 		State:        gitlab.Ptr("opened"),
@@ -43,18 +89,111 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	return Some(proposal), nil
 }
 
-func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+func (self Connector) FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]hostingdomain.Proposal{}
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		State:       gitlab.Ptr("opened"),
+	}
+	for {
+		mergeRequests, response, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), opts)
+		if err != nil {
+			return result, err
+		}
+		for _, mergeRequest := range mergeRequests {
+			branch := gitdomain.NewLocalBranchName(mergeRequest.SourceBranch)
+			// ignore merge requests from forks
+			if mergeRequest.SourceProjectID != mergeRequest.TargetProjectID || !branches.Contains(branch) {
+				continue
+			}
+			result[branch] = parseMergeRequest(mergeRequest)
+		}
+		if response.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = response.NextPage
+	}
+}
+
+func (self Connector) ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]hostingdomain.Proposal, error) {
+	return self.FindProposals(branches)
+}
+
+func (self Connector) MergeProposal(number int, message Option[gitdomain.CommitMessage]) error {
+	options := gitlab.AcceptMergeRequestOptions{
+		Squash: gitlab.Ptr(false),
+		// the branch will be deleted by Git Town
+		ShouldRemoveSourceBranch: gitlab.Ptr(false),
+	}
+	if message, hasMessage := message.Get(); hasMessage {
+		// the GitLab API wants the full commit message in the body
+		options.MergeCommitMessage = gitlab.Ptr(message.String())
+	}
+	return self.acceptMergeRequest(number, &options)
+}
+
+func (self Connector) MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error) {
+	// GitLab lists the newest merge requests first
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("merged"),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(target.String()),
+	}
+	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), opts)
+	if err != nil || len(mergeRequests) == 0 {
+		return None[gitdomain.SHA](), err
+	}
+	return gitdomain.NewSHAOption(mergeRequests[0].SHA), nil
+}
+
+func (self Connector) ReopenProposal(number int) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
-	self.log.Start(messages.HostingGitlabMergingViaAPI, number)
+	self.log.Start(messages.HostingGitlabReopeningViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.Ptr("reopen"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
 	// the GitLab API wants the full commit message in the body
-	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, &gitlab.AcceptMergeRequestOptions{
+	return self.acceptMergeRequest(number, &gitlab.AcceptMergeRequestOptions{
 		SquashCommitMessage: gitlab.Ptr(message.String()),
 		Squash:              gitlab.Ptr(true),
 		// the branch will be deleted by Git Town
 		ShouldRemoveSourceBranch: gitlab.Ptr(false),
 	})
+}
+
+func (self Connector) acceptMergeRequest(number int, options *gitlab.AcceptMergeRequestOptions) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGitlabMergingViaAPI, number)
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalBody(number int, body string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGitlabUpdateMRBodyViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		Description: gitlab.Ptr(body),
+	})
 	if err != nil {
 		self.log.Failed(err)
 		return err
@@ -81,11 +220,7 @@ func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBra
 func NewConnector(args NewConnectorArgs) (Connector, error) {
 	gitlabData := Data{
 		APIToken: args.APIToken,
-		Data: hostingdomain.Data{
-			Hostname:     args.OriginURL.Host,
-			Organization: args.OriginURL.Org,
-			Repository:   args.OriginURL.Repo,
-		},
+		Data:     hostingdomain.NewData(args.OriginURL, args.PushURL),
 	}
 	clientOptFunc := gitlab.WithBaseURL(gitlabData.baseURL())
 	httpClient := gitlab.WithHTTPClient(&http.Client{}) //exhaustruct:ignore
@@ -105,13 +240,38 @@ type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GitLabToken]
 	Log       print.Logger
 	OriginURL giturl.Parts
+	PushURL   Option[giturl.Parts] // URL of the fork containing the branches to propose, if different from OriginURL
+}
+
+// ParseDetailedMergeStatus provides the review and CI state encoded in the given detailed merge status of a GitLab merge request.
+// See https://docs.gitlab.com/ee/api/merge_requests.html#merge-status.
+func ParseDetailedMergeStatus(status string) (hostingdomain.ReviewState, hostingdomain.CIState) {
+	switch status {
+	case "not_approved":
+		return hostingdomain.ReviewStateRequired, hostingdomain.CIStateUnknown
+	case "requested_changes":
+		return hostingdomain.ReviewStateChangesRequested, hostingdomain.CIStateUnknown
+	case "ci_still_running":
+		return hostingdomain.ReviewStateUnknown, hostingdomain.CIStatePending
+	case "ci_must_pass":
+		return hostingdomain.ReviewStateUnknown, hostingdomain.CIStateFailure
+	case "mergeable":
+		return hostingdomain.ReviewStateUnknown, hostingdomain.CIStateUnknown
+	}
+	return hostingdomain.ReviewStateUnknown, hostingdomain.CIStateUnknown
 }
 
 func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
+	reviewState, ciState := ParseDetailedMergeStatus(mergeRequest.DetailedMergeStatus)
 	return hostingdomain.Proposal{
+		Body:         mergeRequest.Description,
+		CIState:      ciState,
+		Draft:        mergeRequest.Draft,
+		MergeWithAPI: true,
 		Number:       mergeRequest.IID,
+		ReviewState:  reviewState,
 		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
 		Title:        mergeRequest.Title,
-		MergeWithAPI: true,
+		URL:          mergeRequest.WebURL,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/gitlab"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
@@ -19,9 +20,11 @@ func TestGitlabConnector(t *testing.T) {
 		t.Parallel()
 		config := gitlab.Data{
 			Data: hostingdomain.Data{
-				Hostname:     "",
-				Organization: "",
-				Repository:   "",
+				HeadOrganization: "",
+				HeadRepository:   "",
+				Hostname:         "",
+				Organization:     "",
+				Repository:       "",
 			},
 			APIToken: configdomain.NewGitLabTokenOption(""),
 		}
@@ -40,24 +43,34 @@ func TestGitlabConnector(t *testing.T) {
 		t.Parallel()
 		main := gitdomain.NewLocalBranchName("main")
 		tests := map[string]struct {
-			branch gitdomain.LocalBranchName
-			parent gitdomain.LocalBranchName
-			want   string
+			branch  gitdomain.LocalBranchName
+			headOrg string
+			parent  gitdomain.LocalBranchName
+			want    string
 		}{
 			"top-level branch": {
-				branch: gitdomain.NewLocalBranchName("feature"),
-				parent: main,
-				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main",
+				branch:  gitdomain.NewLocalBranchName("feature"),
+				headOrg: "organization",
+				parent:  main,
+				want:    "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main",
 			},
 			"stacked change": {
-				branch: gitdomain.NewLocalBranchName("feature-3"),
-				parent: gitdomain.NewLocalBranchName("feature-2"),
-				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature-3&merge_request%5Btarget_branch%5D=feature-2",
+				branch:  gitdomain.NewLocalBranchName("feature-3"),
+				headOrg: "organization",
+				parent:  gitdomain.NewLocalBranchName("feature-2"),
+				want:    "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature-3&merge_request%5Btarget_branch%5D=feature-2",
 			},
 			"special characters in branch name": {
-				branch: gitdomain.NewLocalBranchName("feature-#"),
-				parent: main,
-				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature-%23&merge_request%5Btarget_branch%5D=main",
+				branch:  gitdomain.NewLocalBranchName("feature-#"),
+				headOrg: "organization",
+				parent:  main,
+				want:    "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature-%23&merge_request%5Btarget_branch%5D=main",
+			},
+			"branch in a fork": {
+				branch:  gitdomain.NewLocalBranchName("feature"),
+				headOrg: "me",
+				parent:  main,
+				want:    "https://gitlab.com/me/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main",
 			},
 		}
 		for name, tt := range tests {
@@ -66,9 +79,11 @@ func TestGitlabConnector(t *testing.T) {
 					Data: gitlab.Data{
 						APIToken: configdomain.NewGitLabTokenOption("apiToken"),
 						Data: hostingdomain.Data{
-							Hostname:     "gitlab.com",
-							Organization: "organization",
-							Repository:   "repo",
+							HeadOrganization: tt.headOrg,
+							HeadRepository:   "repo",
+							Hostname:         "gitlab.com",
+							Organization:     "organization",
+							Repository:       "repo",
 						},
 					},
 				}
@@ -80,6 +95,27 @@ func TestGitlabConnector(t *testing.T) {
 	})
 }
 
+func TestParseDetailedMergeStatus(t *testing.T) {
+	t.Parallel()
+	type result struct {
+		ci     hostingdomain.CIState
+		review hostingdomain.ReviewState
+	}
+	tests := map[string]result{
+		"mergeable":         {hostingdomain.CIStateUnknown, hostingdomain.ReviewStateUnknown},
+		"not_approved":      {hostingdomain.CIStateUnknown, hostingdomain.ReviewStateRequired},
+		"requested_changes": {hostingdomain.CIStateUnknown, hostingdomain.ReviewStateChangesRequested},
+		"ci_still_running":  {hostingdomain.CIStatePending, hostingdomain.ReviewStateUnknown},
+		"ci_must_pass":      {hostingdomain.CIStateFailure, hostingdomain.ReviewStateUnknown},
+		"draft_status":      {hostingdomain.CIStateUnknown, hostingdomain.ReviewStateUnknown},
+	}
+	for give, want := range tests {
+		haveReview, haveCI := gitlab.ParseDetailedMergeStatus(give)
+		must.EqOp(t, want.review, haveReview)
+		must.EqOp(t, want.ci, haveCI)
+	}
+}
+
 func TestNewGitlabConnector(t *testing.T) {
 	t.Parallel()
 
@@ -91,13 +127,16 @@ func TestNewGitlabConnector(t *testing.T) {
 			APIToken:  configdomain.NewGitLabTokenOption("apiToken"),
 			Log:       print.Logger{},
 			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
 			Data: hostingdomain.Data{
-				Hostname:     "gitlab.com",
-				Organization: "git-town",
-				Repository:   "docs",
+				HeadOrganization: "git-town",
+				HeadRepository:   "docs",
+				Hostname:         "gitlab.com",
+				Organization:     "git-town",
+				Repository:       "docs",
 			},
 			APIToken: configdomain.NewGitLabTokenOption("apiToken"),
 		}
@@ -112,13 +151,16 @@ func TestNewGitlabConnector(t *testing.T) {
 			APIToken:  configdomain.NewGitLabTokenOption("apiToken"),
 			Log:       print.Logger{},
 			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
 			Data: hostingdomain.Data{
-				Hostname:     "custom-url.com",
-				Organization: "git-town",
-				Repository:   "docs",
+				HeadOrganization: "git-town",
+				HeadRepository:   "docs",
+				Hostname:         "custom-url.com",
+				Organization:     "git-town",
+				Repository:       "docs",
 			},
 			APIToken: configdomain.NewGitLabTokenOption("apiToken"),
 		}
@@ -133,13 +175,16 @@ func TestNewGitlabConnector(t *testing.T) {
 			APIToken:  configdomain.NewGitLabTokenOption("apiToken"),
 			Log:       print.Logger{},
 			OriginURL: originURL,
+			PushURL:   None[giturl.Parts](),
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
 			Data: hostingdomain.Data{
-				Hostname:     "gitlab.domain",
-				Organization: "group",
-				Repository:   "project",
+				HeadOrganization: "group",
+				HeadRepository:   "project",
+				Hostname:         "gitlab.domain",
+				Organization:     "group",
+				Repository:       "project",
 			},
 			APIToken: configdomain.NewGitLabTokenOption("apiToken"),
 		}
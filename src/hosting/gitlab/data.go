@@ -23,7 +23,8 @@ func (self Data) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchNam
 	query := url.Values{}
 	query.Add("merge_request[source_branch]", branch.String())
 	query.Add("merge_request[target_branch]", parentBranch.String())
-	return fmt.Sprintf("%s/-/merge_requests/new?%s", self.RepositoryURL(), query.Encode()), nil
+	// merge requests from forks get created in the fork, GitLab targets the upstream project by default
+	return fmt.Sprintf("%s/%s/-/merge_requests/new?%s", self.baseURL(), self.headProjectPath(), query.Encode()), nil
 }
 
 func (self Data) RepositoryURL() string {
@@ -34,6 +35,10 @@ func (self Data) baseURL() string {
 	return "https://" + self.HostnameWithStandardPort()
 }
 
+func (self Data) headProjectPath() string {
+	return fmt.Sprintf("%s/%s", self.HeadOrganization, self.HeadRepository)
+}
+
 func (self Data) projectPath() string {
 	return fmt.Sprintf("%s/%s", self.Organization, self.Repository)
 }
@@ -8,6 +8,13 @@ import (
 // Connector describes the activities that Git Town can perform on code hosting platforms.
 // Individual implementations exist to talk to specific hosting platforms.
 type Connector interface {
+	// CloseProposal closes the proposal with the given number without merging it.
+	CloseProposal(number int) error
+
+	// CreateProposal creates a new proposal for the given branch into the given target branch
+	// and provides the created proposal.
+	CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (Proposal, error)
+
 	// DefaultProposalMessage provides the text that the form for creating new proposals
 	// on the respective hosting platform is prepopulated with.
 	DefaultProposalMessage(proposal Proposal) string
@@ -16,6 +23,24 @@ type Connector interface {
 	// Returns nil if no proposal exists.
 	FindProposal(branch, target gitdomain.LocalBranchName) (Option[Proposal], error)
 
+	// FindProposals provides the open proposals whose source branch is one of the given branches,
+	// including their review and CI state if the hosting platform provides it.
+	FindProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]Proposal, error)
+
+	// ListProposals provides the open proposals whose source branch is one of the given branches,
+	// without their review and CI state. This is faster than FindProposals
+	// because it doesn't need additional API calls for each proposal.
+	ListProposals(branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]Proposal, error)
+
+	// MergeProposal merges the proposal with the given number using a merge commit.
+	// If no commit message is given, the hosting platform uses its default merge commit message.
+	MergeProposal(number int, message Option[gitdomain.CommitMessage]) error
+
+	// MergedProposalSHA provides the SHA of the head commit of the most recently merged proposal
+	// for the given branch into the given target branch, or None if no such proposal was merged.
+	// Git Town compares it against the branch to detect branch names that were reused after merging.
+	MergedProposalSHA(branch, target gitdomain.LocalBranchName) (Option[gitdomain.SHA], error)
+
 	// SquashMergeProposal squash-merges the proposal with the given number
 	// using the given commit message.
 	SquashMergeProposal(number int, message gitdomain.CommitMessage) error
@@ -24,9 +49,15 @@ type Connector interface {
 	// to create a new proposal online.
 	NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName) (string, error)
 
+	// ReopenProposal reopens the closed proposal with the given number.
+	ReopenProposal(number int) error
+
 	// RepositoryURL provides the URL where the current repository can be found online.
 	RepositoryURL() string
 
+	// UpdateProposalBody replaces the body of the given proposal with the given text.
+	UpdateProposalBody(number int, body string) error
+
 	// UpdateProposalTarget updates the target branch of the given proposal.
 	UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error
 }
@@ -1,9 +1,22 @@
 package hostingdomain
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
 
 // Data contains data needed by all platform connectors.
 type Data struct {
+	// the organization that owns the repo containing the branches to propose,
+	// differs from Organization when proposing from a fork
+	HeadOrganization string
+
+	// name of the repo containing the branches to propose
+	HeadRepository string
+
 	// Hostname override
 	Hostname string
 
@@ -14,6 +27,28 @@ type Data struct {
 	Repository string
 }
 
+// NewData provides the Data for the repository at the given URL,
+// which receives proposals for branches in the repository at the given push URL.
+func NewData(originURL giturl.Parts, pushURL Option[giturl.Parts]) Data {
+	headURL := pushURL.GetOrElse(originURL)
+	return Data{
+		HeadOrganization: headURL.Org,
+		HeadRepository:   headURL.Repo,
+		Hostname:         originURL.Host,
+		Organization:     originURL.Org,
+		Repository:       originURL.Repo,
+	}
+}
+
+// HeadRef provides the reference to the given branch in the "owner:branch" notation
+// that GitHub, Gitea, and Forgejo use for proposals from forks.
+func (self Data) HeadRef(branch gitdomain.LocalBranchName) string {
+	if self.IsCrossRepo() {
+		return self.HeadOrganization + ":" + branch.String()
+	}
+	return branch.String()
+}
+
 func (self Data) HostnameWithStandardPort() string {
 	index := strings.IndexRune(self.Hostname, ':')
 	if index == -1 {
@@ -21,3 +56,8 @@ func (self Data) HostnameWithStandardPort() string {
 	}
 	return self.Hostname[:index]
 }
+
+// IsCrossRepo indicates whether the proposed branches live in a different repository than the one receiving the proposals.
+func (self Data) IsCrossRepo() bool {
+	return self.HeadOrganization != self.Organization || self.HeadRepository != self.Repository
+}
@@ -3,19 +3,75 @@ package hostingdomain_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/git/giturl"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
 )
 
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HeadRef", func(t *testing.T) {
+		t.Parallel()
+		t.Run("same repo", func(t *testing.T) {
+			t.Parallel()
+			data := hostingdomain.Data{
+				HeadOrganization: "org",
+				HeadRepository:   "repo",
+				Hostname:         "github.com",
+				Organization:     "org",
+				Repository:       "repo",
+			}
+			have := data.HeadRef(gitdomain.NewLocalBranchName("feature"))
+			must.EqOp(t, "feature", have)
+		})
+		t.Run("fork", func(t *testing.T) {
+			t.Parallel()
+			data := hostingdomain.Data{
+				HeadOrganization: "me",
+				HeadRepository:   "repo",
+				Hostname:         "github.com",
+				Organization:     "org",
+				Repository:       "repo",
+			}
+			have := data.HeadRef(gitdomain.NewLocalBranchName("feature"))
+			must.EqOp(t, "me:feature", have)
+		})
+	})
+
+	t.Run("NewData", func(t *testing.T) {
+		t.Parallel()
+		originURL := giturl.Parts{Host: "github.com", Org: "org", Repo: "repo", User: Some("git")}
+		t.Run("no push URL", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.NewData(originURL, None[giturl.Parts]())
+			must.False(t, have.IsCrossRepo())
+			must.EqOp(t, "org", have.HeadOrganization)
+		})
+		t.Run("push URL of a fork", func(t *testing.T) {
+			t.Parallel()
+			pushURL := giturl.Parts{Host: "github.com", Org: "me", Repo: "repo", User: Some("git")}
+			have := hostingdomain.NewData(originURL, Some(pushURL))
+			must.True(t, have.IsCrossRepo())
+			must.EqOp(t, "me", have.HeadOrganization)
+			must.EqOp(t, "org", have.Organization)
+		})
+	})
+}
+
 func TestHostnameWithStandardPort(t *testing.T) {
 	t.Parallel()
 
 	t.Run("no port in hostname", func(t *testing.T) {
 		t.Parallel()
 		config := hostingdomain.Data{
-			Hostname:     "git.example.com",
-			Organization: "org",
-			Repository:   "repo",
+			HeadOrganization: "org",
+			HeadRepository:   "repo",
+			Hostname:         "git.example.com",
+			Organization:     "org",
+			Repository:       "repo",
 		}
 		have := config.HostnameWithStandardPort()
 		want := "git.example.com"
@@ -25,9 +81,11 @@ func TestHostnameWithStandardPort(t *testing.T) {
 	t.Run("port in hostname", func(t *testing.T) {
 		t.Parallel()
 		config := hostingdomain.Data{
-			Hostname:     "git.example.com:4022",
-			Organization: "org",
-			Repository:   "repo",
+			HeadOrganization: "org",
+			HeadRepository:   "repo",
+			Hostname:         "git.example.com:4022",
+			Organization:     "org",
+			Repository:       "repo",
 		}
 		have := config.HostnameWithStandardPort()
 		want := "git.example.com"
@@ -0,0 +1,54 @@
+package hostingdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+)
+
+const (
+	lineageSectionStart = "<!-- branch-stack-start -->"
+	lineageSectionEnd   = "<!-- branch-stack-end -->"
+)
+
+// LineageSection provides the section of a proposal body that lists all branches in the stack of the given branch,
+// linking to their proposals and marking the given branch.
+func LineageSection(lineage configdomain.Lineage, branch gitdomain.LocalBranchName, proposals map[gitdomain.LocalBranchName]Proposal) string {
+	s := strings.Builder{}
+	s.WriteString(lineageSectionStart + "\n")
+	s.WriteString("| | Branch | Proposal |\n")
+	s.WriteString("| - | - | - |\n")
+	for _, stackBranch := range lineage.BranchLineage(branch) {
+		marker := ""
+		branchText := "`" + stackBranch.String() + "`"
+		if stackBranch == branch {
+			marker = "→"
+			branchText = "**" + branchText + "**"
+		}
+		indentation := strings.Repeat("&nbsp;&nbsp;", len(lineage.Ancestors(stackBranch)))
+		proposalText := ""
+		if proposal, hasProposal := proposals[stackBranch]; hasProposal {
+			proposalText = fmt.Sprintf("[#%d](%s) %s", proposal.Number, proposal.URL, strings.ReplaceAll(proposal.Title, "|", `\|`))
+		}
+		s.WriteString(fmt.Sprintf("| %s | %s%s | %s |\n", marker, indentation, branchText, proposalText))
+	}
+	s.WriteString(lineageSectionEnd)
+	return s.String()
+}
+
+// UpdateLineageSection provides the given proposal body with its lineage section replaced by the given one.
+// Appends the given section if the body doesn't contain a lineage section yet.
+func UpdateLineageSection(body, section string) string {
+	start := strings.Index(body, lineageSectionStart)
+	end := strings.Index(body, lineageSectionEnd)
+	if start >= 0 && end > start {
+		return body[:start] + section + body[end+len(lineageSectionEnd):]
+	}
+	trimmed := strings.TrimRight(body, "\n")
+	if strings.TrimSpace(trimmed) == "" {
+		return section
+	}
+	return trimmed + "\n\n" + section
+}
@@ -0,0 +1,92 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestLineageSection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("LineageSection", func(t *testing.T) {
+		t.Parallel()
+		main := gitdomain.NewLocalBranchName("main")
+		one := gitdomain.NewLocalBranchName("one")
+		two := gitdomain.NewLocalBranchName("two")
+		other := gitdomain.NewLocalBranchName("other")
+		lineage := configdomain.NewLineage()
+		lineage.Add(one, main)
+		lineage.Add(two, one)
+		lineage.Add(other, main)
+		proposals := map[gitdomain.LocalBranchName]hostingdomain.Proposal{
+			one: {
+				Body:         "",
+				CIState:      hostingdomain.CIStateUnknown,
+				Draft:        false,
+				MergeWithAPI: true,
+				Number:       1,
+				ReviewState:  hostingdomain.ReviewStateUnknown,
+				Target:       main,
+				Title:        "first | part",
+				URL:          "https://github.com/org/repo/pull/1",
+			},
+			two: {
+				Body:         "",
+				CIState:      hostingdomain.CIStateUnknown,
+				Draft:        false,
+				MergeWithAPI: true,
+				Number:       2,
+				ReviewState:  hostingdomain.ReviewStateUnknown,
+				Target:       one,
+				Title:        "second part",
+				URL:          "https://github.com/org/repo/pull/2",
+			},
+		}
+		have := hostingdomain.LineageSection(lineage, two, proposals)
+		want := `<!-- branch-stack-start -->
+| | Branch | Proposal |
+| - | - | - |
+|  | ` + "`main`" + ` |  |
+|  | &nbsp;&nbsp;` + "`one`" + ` | [#1](https://github.com/org/repo/pull/1) first \| part |
+| → | &nbsp;&nbsp;&nbsp;&nbsp;` + "**`two`**" + ` | [#2](https://github.com/org/repo/pull/2) second part |
+<!-- branch-stack-end -->`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("UpdateLineageSection", func(t *testing.T) {
+		t.Parallel()
+		section := "<!-- branch-stack-start -->\nnew\n<!-- branch-stack-end -->"
+
+		t.Run("empty body", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.UpdateLineageSection("", section)
+			must.EqOp(t, section, have)
+		})
+
+		t.Run("body without section", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.UpdateLineageSection("my description\n", section)
+			want := "my description\n\n" + section
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("body with existing section", func(t *testing.T) {
+			t.Parallel()
+			give := "my description\n\n<!-- branch-stack-start -->\nold\n<!-- branch-stack-end -->\n\nmore text"
+			have := hostingdomain.UpdateLineageSection(give, section)
+			want := "my description\n\n" + section + "\n\nmore text"
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("section is up to date", func(t *testing.T) {
+			t.Parallel()
+			give := "my description\n\n" + section
+			have := hostingdomain.UpdateLineageSection(give, section)
+			must.EqOp(t, give, have)
+		})
+	})
+}
@@ -1,19 +1,57 @@
 package hostingdomain
 
-import "github.com/git-town/git-town/v14/src/git/gitdomain"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+)
 
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// textual description of the proposal
+	Body string
+
+	// the state of the CI checks for the latest commit of this proposal
+	CIState CIState
+
+	// whether this proposal is a draft
+	Draft bool
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
 	// the number used to identify the proposal on the hosting platform
 	Number int
 
+	// the state of the code review of this proposal
+	ReviewState ReviewState
+
 	// name of the target branch ("base") of this proposal
 	Target gitdomain.LocalBranchName
 
 	// textual title of the proposal
 	Title string
+
+	// the URL of the web page of this proposal
+	URL string
+}
+
+// Summary provides a short human-readable description of this proposal and its state.
+func (self Proposal) Summary() string {
+	details := []string{}
+	if self.Draft {
+		details = append(details, "draft")
+	}
+	if self.ReviewState != ReviewStateUnknown {
+		details = append(details, self.ReviewState.String())
+	}
+	if self.CIState != CIStateUnknown {
+		details = append(details, self.CIState.String())
+	}
+	if len(details) == 0 {
+		return fmt.Sprintf("#%d", self.Number)
+	}
+	return fmt.Sprintf("#%d (%s)", self.Number, strings.Join(details, ", "))
 }
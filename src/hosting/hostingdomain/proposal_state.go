@@ -0,0 +1,31 @@
+package hostingdomain
+
+// ReviewState describes the state of the code review of a proposal.
+// This is a type-safe enum, see https://npf.io/2022/05/safer-enums.
+type ReviewState string
+
+func (self ReviewState) String() string {
+	return string(self)
+}
+
+const (
+	ReviewStateUnknown          ReviewState = ""                  // the hosting platform provides no review information
+	ReviewStateApproved         ReviewState = "approved"          // the reviewers approved the proposal
+	ReviewStateChangesRequested ReviewState = "changes requested" // a reviewer requested changes
+	ReviewStateRequired         ReviewState = "review required"   // the proposal waits for a review
+)
+
+// CIState describes the state of the CI checks of a proposal.
+// This is a type-safe enum, see https://npf.io/2022/05/safer-enums.
+type CIState string
+
+func (self CIState) String() string {
+	return string(self)
+}
+
+const (
+	CIStateUnknown CIState = ""           // the hosting platform provides no CI information
+	CIStateFailure CIState = "CI failed"  // at least one CI check failed
+	CIStatePending CIState = "CI pending" // at least one CI check is still running
+	CIStateSuccess CIState = "CI passed"  // all CI checks passed
+)
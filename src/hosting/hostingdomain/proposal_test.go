@@ -0,0 +1,46 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Summary", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no details", func(t *testing.T) {
+			t.Parallel()
+			proposal := hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStateUnknown,
+				Draft:        false,
+				MergeWithAPI: false,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateUnknown,
+				Target:       "main",
+				Title:        "title",
+				URL:          "",
+			}
+			must.EqOp(t, "#12", proposal.Summary())
+		})
+		t.Run("all details", func(t *testing.T) {
+			t.Parallel()
+			proposal := hostingdomain.Proposal{
+				Body:         "",
+				CIState:      hostingdomain.CIStateSuccess,
+				Draft:        true,
+				MergeWithAPI: false,
+				Number:       12,
+				ReviewState:  hostingdomain.ReviewStateApproved,
+				Target:       "main",
+				Title:        "title",
+				URL:          "",
+			}
+			must.EqOp(t, "#12 (draft, approved, CI passed)", proposal.Summary())
+		})
+	})
+}
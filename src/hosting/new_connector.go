@@ -1,15 +1,22 @@
 package hosting
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v14/src/cli/print"
 	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/config/gitconfig"
 	"github.com/git-town/git-town/v14/src/git/giturl"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/azuredevops"
 	"github.com/git-town/git-town/v14/src/hosting/bitbucket"
+	"github.com/git-town/git-town/v14/src/hosting/external"
+	"github.com/git-town/git-town/v14/src/hosting/forgejo"
 	"github.com/git-town/git-town/v14/src/hosting/gitea"
 	"github.com/git-town/git-town/v14/src/hosting/github"
 	"github.com/git-town/git-town/v14/src/hosting/gitlab"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
 )
 
 // NewConnector provides an instance of the code hosting connector to use based on the given gitConfig.
@@ -20,25 +27,64 @@ func NewConnector(args NewConnectorArgs) (Option[hostingdomain.Connector], error
 	}
 	var connector hostingdomain.Connector
 	switch platform {
+	case configdomain.HostingPlatformAzureDevOps:
+		connector = azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  args.Config.AzureDevOpsToken,
+			APIURL:    None[string](),
+			Log:       args.Log,
+			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
+		})
+		return Some(connector), nil
 	case configdomain.HostingPlatformBitbucket:
 		connector = bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			APIURL:          None[string](),
+			AppPassword:     args.Config.BitbucketAppPassword,
 			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
 			OriginURL:       args.OriginURL,
+			PushURL:         args.PushURL,
+			Username:        args.Config.BitbucketUsername,
+		})
+		return Some(connector), nil
+	case configdomain.HostingPlatformExternal:
+		command, hasCommand := args.Config.HostingExternalCommand.Get()
+		if !hasCommand {
+			return None[hostingdomain.Connector](), fmt.Errorf(messages.HostingExternalCommandMissing, gitconfig.KeyHostingExternalCommand)
+		}
+		connector = external.NewConnector(external.NewConnectorArgs{
+			Command:   command,
+			Log:       args.Log,
+			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
+		})
+		return Some(connector), nil
+	case configdomain.HostingPlatformForgejo:
+		connector = forgejo.NewConnector(forgejo.NewConnectorArgs{
+			APIToken:  args.Config.ForgejoToken,
+			APIURL:    None[string](),
+			Log:       args.Log,
+			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
 		})
 		return Some(connector), nil
 	case configdomain.HostingPlatformGitea:
 		connector = gitea.NewConnector(gitea.NewConnectorArgs{
 			APIToken:  args.Config.GiteaToken,
+			APIURL:    None[string](),
 			Log:       args.Log,
 			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
 		})
 		return Some(connector), nil
 	case configdomain.HostingPlatformGitHub:
 		var err error
 		connector, err = github.NewConnector(github.NewConnectorArgs{
 			APIToken:  github.GetAPIToken(args.Config.GitHubToken),
+			APIURL:    None[string](),
 			Log:       args.Log,
 			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
 		})
 		return Some(connector), err
 	case configdomain.HostingPlatformGitLab:
@@ -47,6 +93,7 @@ func NewConnector(args NewConnectorArgs) (Option[hostingdomain.Connector], error
 			APIToken:  args.Config.GitLabToken,
 			Log:       args.Log,
 			OriginURL: args.OriginURL,
+			PushURL:   args.PushURL,
 		})
 		return Some(connector), err
 	}
@@ -58,4 +105,5 @@ type NewConnectorArgs struct {
 	HostingPlatform Option[configdomain.HostingPlatform]
 	Log             print.Logger
 	OriginURL       giturl.Parts
+	PushURL         Option[giturl.Parts]
 }
@@ -4,6 +4,9 @@ const (
 	UndoContinueGuidance               = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
 	AliasedCommands                    = "Aliased commands: %s\n"
 	ArgumentUnknown                    = "unknown argument: %q"
+	AzureDevOpsToken                   = "Azure DevOps token: %s\n"
+	BitbucketAppPassword               = "Bitbucket app password: %s\n"
+	BitbucketUsername                  = "Bitbucket username: %s\n"
 	BranchAlreadyExistsLocally         = "there is already a branch %q"
 	BranchAlreadyExistsRemotely        = "there is already a branch %q at the \"origin\" remote"
 	BranchAuthorMultiple               = "\nMultiple people authored the %q branch.\n\n"
@@ -18,9 +21,16 @@ const (
 	BranchIsAlreadyContribution        = "branch %q is already a contribution branch"
 	BranchIsAlreadyObserved            = "branch %q is already observed"
 	BranchIsAlreadyParked              = "branch %q is already parked"
+	BranchIsAlreadyPrototype           = "branch %q is already a prototype branch"
 	BranchLocalSHAProblem              = "cannot determine SHA of local branch %q: %w"
 	BranchLocalProblem                 = "cannot determine whether the local branch %q exists: %w"
+	BranchMergedProblem                = "cannot determine whether branch %q is merged into %q: %w"
+	BranchOtherWorktree                = "branch %q is active in worktree %q"
 	BranchParentChanged                = "branch %q is now a child of %q"
+	BranchPruned                       = "deleted branch %q because it was merged into its parent branch"
+	BranchTypeRuleInvalid              = "invalid branch type rule %q, expected format: <regex>=<type>"
+	BranchTypeRuleInvalidRegex         = "invalid regex %q in branch type rule: %w"
+	BranchTypeRuleUnknownType          = "unknown branch type %q in the rule for %q, allowed types are: contribution, feature, observed, parked, perennial, prototype"
 	BrowserOpen                        = "Please open in a browser: %s\n"
 	CacheUnitialized                   = "using a cached value before initialization"
 	CodeHosting                        = "Code hosting: %s\n"
@@ -41,7 +51,9 @@ const (
 	ConfigLineageParentIsChild         = "removing lineage entry for %q because the parent is the child"
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
 	ConfigNeeded                       = "Git Town needs to be configured\n\n"
+	ConfigRemoteUnknown                = "the %q setting refers to the remote %q, which doesn't exist in this repository, please add this remote via \"git remote add\" or change the setting"
 	ConfigStorage                      = "Config storage: %s\n"
+	ConfigShipStrategyUnknown          = "unknown ship strategy: %q"
 	ConfigSyncFeatureStrategyUnknown   = "unknown sync-feature strategy: %q"
 	ConfigSyncPerennialStrategyUnknown = "unknown sync-perennial strategy: %q"
 	ConfigRemoveError                  = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
@@ -51,6 +63,7 @@ const (
 	ContributionBranchCannotPark       = "cannot park contribution branches"
 	ContributionBranchCannotPropose    = "cannot propose contribution branches"
 	ContributionBranchCannotShip       = "cannot ship contribution branches"
+	ContributionRegex                  = "Contribution regex: %s\n"
 	DiffConflictWithMain               = "conflicts between your uncommmitted changes and the main branch"
 	DryRun                             = "In dry run mode. No commands will be run. When run in normal mode, the command output will appear beneath the command. Some commands will only be run if necessary. For example: 'git push' will run if and only if there are local commits not on origin."
 	ValueInvalid                       = "invalid value for %s: %q. Please provide either \"yes\" or \"no\""
@@ -69,6 +82,7 @@ const (
 	FileReadProblem                    = "cannot read file %q: %w"
 	FileStatProblem                    = "cannot check file %q: %w"
 	FileWriteProblem                   = "cannot write file %q: %w"
+	ForgejoToken                       = "Forgejo token: %s\n"
 	GiteaToken                         = "Gitea token: %s\n"
 	GitHubEnterpriseInitializeError    = "cannot initialize GitHub Enterprise client: %s"
 	GitHubToken                        = "GitHub token: %s\n"
@@ -97,17 +111,74 @@ END OUTPUT FROM 'git branch -vva'
 	HackBranchIsNowFeature                = "branch %q is now a feature branch\n"
 	HackCannotFeatureMainBranch           = "cannot make the main branch a feature branch"
 	HackCannotFeaturePerennialBranch      = "branch %q is a perennial branch and therefore be a feature branch"
-	HostingBitBucketNotImplemented        = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	HistoryBranchCreated                  = "created branch %q"
+	HistoryBranchDeleted                  = "deleted branch %q"
+	HistoryBranchUpdated                  = "updated branch %q from %s to %s"
+	HistoryConfigAdded                    = "added %s setting %q"
+	HistoryConfigChanged                  = "changed %s setting %q from %q to %q"
+	HistoryConfigRemoved                  = "removed %s setting %q"
+	HistoryEntry                          = "%d. %s (%s)\n"
+	HistoryEntryDryRun                    = "%d. %s (dry run)\n"
+	HistoryEntryUnfinished                = "%d. %s (unfinished since %s)\n"
+	HistoryEntryUnknownTime               = "%d. %s\n"
+	HookFailed                            = "the %s hook failed: %w"
+	HostingAzureDevOpsAPIProblem          = "Azure DevOps API: unexpected response %q: %s"
+	HostingAzureDevOpsAbandoningViaAPI    = "Azure DevOps API: abandoning PR #%d ... "
+	HostingAzureDevOpsCreatingViaAPI      = "Azure DevOps API: creating PR for branch %q ... "
+	HostingAzureDevOpsMergingViaAPI       = "Azure DevOps API: completing PR #%d ... "
+	HostingAzureDevOpsReactivatingViaAPI  = "Azure DevOps API: reactivating PR #%d ... "
+	HostingAzureDevOpsUpdatePRBodyViaAPI  = "Azure DevOps API: updating description of PR #%d ... "
+	HostingAzureDevOpsUpdatePRViaAPI      = "Azure DevOps API: updating target branch for PR #%d to %q ... "
+	HostingBitbucketAPIProblem            = "Bitbucket API: unexpected response %q: %s"
+	HostingBitbucketCannotReopen          = "Bitbucket API: cannot reopen declined PR #%d, please recreate it manually"
+	HostingBitbucketCreatingViaAPI        = "Bitbucket API: creating PR for branch %q ... "
+	HostingBitbucketDecliningViaAPI       = "Bitbucket API: declining PR #%d ... "
+	HostingBitbucketMergingViaAPI         = "Bitbucket API: merging PR #%d ... "
+	HostingBitbucketNoCredentials         = "Bitbucket API: missing credentials, please configure your Bitbucket username and app password"
+	HostingBitbucketUpdatePRBodyViaAPI    = "Bitbucket API: updating description of PR #%d ... "
+	HostingBitbucketUpdatePRViaAPI        = "Bitbucket API: updating destination branch for PR #%d to %q ... "
+	HostingExternalClosing                = "External hosting command: closing proposal #%d ... "
+	HostingExternalCommandMissing         = "the \"external\" hosting platform requires the shell command to run, please configure it via \"git config %s <command>\""
+	HostingExternalCreating               = "External hosting command: creating proposal for branch %q ... "
+	HostingExternalError                  = "the external hosting command cannot %s: %s"
+	HostingExternalFailed                 = "the external hosting command failed to %s: %w\n%s"
+	HostingExternalInvalidResponse        = "the external hosting command responded to %s with invalid JSON: %w"
+	HostingExternalMerging                = "External hosting command: merging proposal #%d ... "
+	HostingExternalNoProposal             = "the external hosting command responded to %s without a proposal"
+	HostingExternalNoURL                  = "the external hosting command responded to %s without a URL"
+	HostingExternalReopening              = "External hosting command: reopening proposal #%d ... "
+	HostingExternalUpdateBody             = "External hosting command: updating description of proposal #%d ... "
+	HostingExternalUpdateTarget           = "External hosting command: updating target branch of proposal #%d to %q ... "
+	HostingForgejoClosingViaAPI           = "Forgejo API: closing PR #%d ... "
+	HostingForgejoCreatingViaAPI          = "Forgejo API: creating PR for branch %q ... "
+	HostingForgejoMergingViaAPI           = "Forgejo API: merging PR #%d ... "
+	HostingForgejoReopeningViaAPI         = "Forgejo API: reopening PR #%d ... "
+	HostingForgejoUpdatePRBodyViaAPI      = "Forgejo API: updating description of PR #%d ... "
+	HostingForgejoUpdatePRViaAPI          = "Forgejo API: updating base branch for PR #%d to %q ... "
+	HostingGitlabClosingViaAPI            = "GitLab API: Closing MR !%d ... "
+	HostingGitlabCreatingViaAPI           = "GitLab API: Creating MR for branch %q ... "
 	HostingGitlabMergingViaAPI            = "GitLab API: Merging MR !%d ... "
+	HostingGitlabReopeningViaAPI          = "GitLab API: Reopening MR !%d ... "
+	HostingGitlabUpdateMRBodyViaAPI       = "GitLab API: Updating description of MR !%d ... "
 	HostingGitlabUpdateMRViaAPI           = "GitLab API: Updating target branch for MR !%d to %q ... "
-	HostingGiteaNotImplemented            = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to #%s"
+	HostingGiteaClosingViaAPI             = "Gitea API: closing PR #%d ... "
+	HostingGiteaCreatingViaAPI            = "Gitea API: creating PR for branch %q ... "
+	HostingGiteaMergingViaAPI             = "Gitea API: merging PR #%d ... "
+	HostingGiteaReopeningViaAPI           = "Gitea API: reopening PR #%d ... "
+	HostingGiteaUpdatePRBodyViaAPI        = "Gitea API: updating description of PR #%d ... "
+	HostingGiteaUpdatePRViaAPI            = "Gitea API: updating base branch for PR #%d to %q ... "
+	HostingGithubClosingViaAPI            = "GitHub API: closing PR #%d ... "
+	HostingGithubCreatingViaAPI           = "GitHub API: creating PR for branch %q ... "
+	HostingGithubGraphQLError             = "GitHub API: %s"
 	HostingGithubMergingViaAPI            = "GitHub API: merging PR #%d ... "
+	HostingGithubReopeningViaAPI          = "GitHub API: reopening PR #%d ... "
+	HostingGithubUpdatePRBodyViaAPI       = "GitHub API: updating description of PR #%d ... "
 	HostingGithubUpdatePRViaAPI           = "GitHub API: updating base branch for PR #%d ... "
 	HostingPlatformUnknown                = "unknown hosting platform: %q"
 	InputAddOrRemove                      = `invalid argument %q. Please provide either "add" or "remove"`
 	InputYesOrNo                          = `invalid argument: %q. Please provide either "yes" or "no".\n`
-	KillBranchOtherWorktree               = `branch %q is active in another worktree`
+	KillBranchOtherWorktree               = `branch %q is active in worktree %q`
+	KillBranchWhenDoneOtherWorktree       = "cannot kill the current branch because the branch to switch to afterwards, %q, is active in worktree %q"
 	KillCannotKillMainBranch              = "you cannot kill the main branch"
 	KillCannotKillPerennialBranches       = "you cannot kill perennial branches"
 	MainBranch                            = "Main branch: %s\n"
@@ -115,43 +186,72 @@ END OUTPUT FROM 'git branch -vva'
 	MainBranchCannotObserve               = "cannot observe the main branch"
 	MainBranchCannotPark                  = "cannot park the main branch"
 	MainBranchCannotPropose               = "cannot propose the main branch"
+	MainBranchCannotPrototype             = "cannot make the main branch a prototype branch"
 	MainBranchCannotShip                  = "cannot ship the main branch"
+	MergeBranchNotInSync                  = "branch %q is not in sync with its tracking branch, please run \"git town sync\" first"
+	MergeBranchOtherWorktree              = "branch %q is active in another worktree"
+	MergeBranchWrongType                  = "cannot merge branch %q into its parent because it is a %s"
+	MergeNoParent                         = "branch %q has no parent to merge into"
+	MergeParentWrongType                  = "cannot merge into branch %q because it is a %s, please use \"git town ship\" to ship into it"
+	NavigateAlreadyAtBottom               = "branch %q is already at the bottom of its stack\n"
+	NavigateAlreadyAtTop                  = "branch %q is already at the top of its stack\n"
+	NavigateNoChild                       = "branch %q has no child branches"
+	NavigateNoParent                      = "branch %q has no parent branch"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
 	ObservedBranchIsNowObserved           = "branch %q is now an observed branch\n"
+	ObservedRegex                         = "Observed regex: %s\n"
+	OfflineFallback                       = "Cannot reach the origin remote, synced offline. Run \"git town push-pending\" to push the skipped branches once you are online again."
 	OfflineNotAllowed                     = "this command requires an active internet connection"
 	OpcodeUnknown                         = "unknown opcode: %q, run \"git town status reset\" to reset it"
 	OpenChangesProblem                    = "cannot determine open changes: %w"
 	OriginHostname                        = "Origin hostname: %s\n"
+	OutputFormatUnknown                   = "unknown output format %q, please use \"text\" or \"json\""
 	ParentDialogSelected                  = "Selected parent branch for %q: %s\n"
 	ParkedBranchIsNowParked               = "branch %q is now parked\n"
+	PendingPushes                         = "Pending pushes (run \"git town push-pending\" to push them):"
 	PerennialBranchCannotMakeContribution = "cannot make perennial branches contribution branches"
 	PerennialBranchCannotObserve          = "cannot observe perennial branches"
 	PerennialBranchCannotPark             = "cannot park perennial branches"
 	PerennialBranchCannotPropose          = "cannot propose perennial branches"
+	PerennialBranchCannotPrototype        = "cannot make perennial branches prototype branches"
 	PerennialBranchCannotShip             = "cannot ship perennial branches"
 	PerennialBranches                     = "Perennial branches: %s\n"
 	PerennialBranchRemovedParentEntry     = "Removed parent entry for perennial branch %q\n"
 	PerennialRegex                        = "Perennial regex: %s\n"
+	PlanConfirm                           = "Run the plan: %s\n"
+	PlanHeader                            = "Git Town plans to run these operations:"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
 	PreviousCommandProblem                = "The last Git Town command (%s) hit a problem %v ago.\n"
+	ProposalAlreadyExists                 = "branch %q already has proposal %s"
+	ProposalCloseProblem                  = "cannot close proposal %d via the API"
+	ProposalCreateProblem                 = "cannot create a proposal for branch %q: %w"
+	ProposalCreated                       = "created proposal %s"
+	ProposalLineageUpdateProblem          = "cannot update the branch stack in proposals: %v"
 	ProposalMultipleFound                 = "found %d proposals from branch %q to branch %q"
 	ProposalNoNumberGiven                 = "no proposal number given"
 	ProposalNoParent                      = "branch %q has no parent and can therefore not be proposed"
 	ProposalNotFoundForBranch             = "cannot determine proposal for branch %q: %w"
+	ProposalReopenProblem                 = "cannot reopen proposal %d via the API"
 	ProposalTargetBranchUpdateProblem     = "cannot update the target branch of proposal %d via the API"
 	ProposalURLProblem                    = "cannot determine proposal URL from %q to %q: %w"
+	ProposalsLoadProblem                  = "cannot load proposals: %w"
+	ProposalsNone                         = "no open proposals"
+	ProposeStackTitleOrBody               = "the --title and --body flags cannot be used together with --stack"
+	PrototypeBranchIsNowPrototype         = "branch %q is now a prototype branch\n"
 	PullRequestDeprecation                = `DEPRECATION NOTICE
 
 This command has been renamed to "git town propose"
 nd will be removed in future versions of Git Town.`
 	PushHook                       = "Push hook: %s\n"
 	PushNewBranches                = "Push new branches: %s\n"
+	PushPendingNone                = "there are no pending pushes"
 	RebaseProblem                  = "cannot determine rebase in progress: %w"
 	RemoteExistsProblem            = "cannot determine if remote %q exists: %w"
 	RemotesProblem                 = "cannot determine remotes: %w"
 	RenameBranchNotInSync          = "%q is not in sync with its tracking branch, please sync the branches before renaming"
+	RenameBranchOtherWorktree      = "branch %q is active in worktree %q, please rename it there"
 	RenameMainBranch               = "the main branch cannot be renamed"
 	RenamePerennialBranchWarning   = "%q is a perennial branch. Renaming a perennial branch typically requires other updates. If you are sure you want to do this, use '--force'"
 	RenameToSameName               = "cannot rename branch to current name"
@@ -172,13 +272,20 @@ nd will be removed in future versions of Git Town.`
 	SettingLocalDeprecatedMessage  = "Upgrading deprecated local setting %q to %q."
 	SettingLocalCannotRemove       = "ERROR: cannot remove local Git setting %q: %v"
 	SettingLocalCannotWrite        = "ERROR: cannot write local Git setting %q: %v"
+	ShellCommandFailed             = "command %q failed: %w"
 	ShipAbortedMergeError          = "aborted because commit exited with error"
-	ShipBranchOtherWorktree        = "branch %q is active in another worktree"
+	ShipBranchOtherWorktree        = "branch %q is active in worktree %q"
 	ShipBranchHasNoParent          = "branch %q has no parent to ship into"
 	ShipBranchNothingToDo          = "the branch %q has no shippable changes"
 	ShipChildBranch                = "shipping this branch would ship %s as well,\nplease ship %q first"
 	ShipDeletesTrackingBranches    = "Ship deletes tracking branches: %s\n"
+	ShipFastForwardProblem         = "cannot fast-forward branch %q into %q, please rebase %q onto %q first"
+	ShipFastForwardMessage         = "the fast-forward ship strategy does not create a commit and therefore cannot use a commit message"
 	ShipOpenChanges                = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShipStrategy                   = "Ship strategy: %s\n"
+	ShipStrategyAPINoConnector     = "the %q ship strategy requires a connector to the API of your code hosting platform"
+	ShipStrategyAPINoProposal      = "the %q ship strategy requires a proposal for branch %q"
+	ShipTargetOtherWorktree        = "cannot ship into branch %q because it is active in worktree %q"
 	ShippableChangesProblem        = "cannot determine whether branch %q has shippable changes: %w"
 	SkipBranchHasConflicts         = "cannot skip branch that resulted in conflicts"
 	SkipMessage                    = `You can run "git town skip" to skip the currently failing operation.`
@@ -192,19 +299,29 @@ nd will be removed in future versions of Git Town.`
 	SquashCommitAuthorSelection    = "Selected squash commit author: %s\n"
 	SquashMessageProblem           = "cannot comment out the squash commit message: %w"
 	StatusFileNotFound             = "No status file found for this repository."
+	SwitchBranchOutOfSync          = "[out of sync]"
+	SwitchBranchOtherWorktree      = "[worktree %s]"
 	SwitchUncommittedChanges       = "uncommitted changes\n"
+	SyncAllAndStack                = "please provide either --all or --stack, not both"
 	SyncBeforeShip                 = "Sync before ship: %s\n"
 	SyncFeatureBranches            = "Sync feature branches: %s\n"
 	SyncPerennialBranches          = "Sync perennial branches: %s\n"
 	SyncStatusNotRecognized        = "cannot determine the sync status for Git remote %q and branch name %q"
+	SyncWorktreeConflict           = "syncing branch %q in worktree %q causes conflicts, please sync it in that worktree"
+	SyncWorktreeOpenChanges        = "skipped syncing branch %q because worktree %q has uncommitted changes"
 	SyncWithUpstream               = "Sync with upstream: %s\n"
 	UndoCreateOpcodeProblem        = "cannot create undo operations for %q: %w"
 	UndoMessage                    = `You can run "git town undo" to go back to where you started.`
 	UndoNothingToDo                = "nothing to undo"
+	UndoStepsTooMany               = "cannot undo %d commands, the undo history contains only %d"
+	UndoStepsZero                  = "please provide a positive number of steps to undo"
+	UndoWorktreeOpenChanges        = "cannot undo the changes to branch %q because worktree %q has uncommitted changes"
 	UnfinishedCommandHandle        = "Handle unfinished command: %s\n"
 	UnfinishedRunStateContinue     = "Continue the \"%s\" command after having resolved conflicts"
 	UnfinishedRunStateDiscard      = "Discard the unfinished state and run the new command"
 	UnfinishedRunStateQuit         = "Quit without running anything"
 	UnfinishedRunStateSkip         = "Skip the current branch and continue the \"%s\" command on the next branch"
 	UnfinishedRunStateUndo         = "Undo the previous \"%s\" command"
+	WalkNoBranches                 = "no branches to walk in the stack of branch %q"
+	WorktreeStatusProblem          = "cannot determine the status of worktree %q: %w"
 )
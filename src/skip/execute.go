@@ -3,6 +3,7 @@ package skip
 import (
 	"errors"
 	"fmt"
+	"github.com/git-town/git-town/v14/src/cli/report"
 
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
 	"github.com/git-town/git-town/v14/src/config"
@@ -26,6 +27,7 @@ func Execute(args ExecuteArgs) error {
 	lightInterpreter.Execute(lightInterpreter.ExecuteArgs{
 		Backend:       args.Backend,
 		Config:        args.Config,
+		Connector:     args.Connector,
 		FinalMessages: args.FinalMessages,
 		Frontend:      args.Frontend,
 		Git:           args.Git,
@@ -50,6 +52,7 @@ func Execute(args ExecuteArgs) error {
 		InitialBranchesSnapshot: args.RunState.BeginBranchesSnapshot,
 		InitialConfigSnapshot:   args.RunState.BeginConfigSnapshot,
 		InitialStashSize:        args.RunState.BeginStashSize,
+		Plan:                    None[report.Format](),
 		RootDir:                 args.RootDir,
 		RunState:                args.RunState,
 		Verbose:                 args.Verbose,
@@ -108,10 +111,12 @@ func revertChangesToCurrentBranch(args ExecuteArgs) error {
 		Config:                   args.Config.Config,
 		EndBranch:                args.InitialBranch,
 		UndoablePerennialCommits: args.RunState.UndoablePerennialCommits,
+		Worktrees:                afterSnapshot.Worktrees,
 	})
 	lightInterpreter.Execute(lightInterpreter.ExecuteArgs{
 		Backend:       args.Backend,
 		Config:        args.Config,
+		Connector:     args.Connector,
 		FinalMessages: args.FinalMessages,
 		Frontend:      args.Frontend,
 		Git:           args.Git,
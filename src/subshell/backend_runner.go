@@ -24,20 +24,25 @@ type BackendRunner struct {
 }
 
 func (self BackendRunner) Query(executable string, args ...string) (string, error) {
-	return self.execute(executable, args...)
+	return self.execute([]string{}, executable, args...)
 }
 
 func (self BackendRunner) QueryTrim(executable string, args ...string) (string, error) {
-	output, err := self.execute(executable, args...)
+	output, err := self.execute([]string{}, executable, args...)
 	return strings.TrimSpace(stripansi.Strip(output)), err
 }
 
 func (self BackendRunner) Run(executable string, args ...string) error {
-	_, err := self.execute(executable, args...)
+	_, err := self.execute([]string{}, executable, args...)
 	return err
 }
 
-func (self BackendRunner) execute(executable string, args ...string) (string, error) {
+func (self BackendRunner) RunWithEnv(env []string, executable string, args ...string) error {
+	_, err := self.execute(env, executable, args...)
+	return err
+}
+
+func (self BackendRunner) execute(env []string, executable string, args ...string) (string, error) {
 	self.CommandsCounter.Register()
 	if self.Verbose {
 		printHeader(executable, args...)
@@ -47,6 +52,7 @@ func (self BackendRunner) execute(executable string, args ...string) (string, er
 		subProcess.Dir = dir
 	}
 	subProcess.Env = append(subProcess.Environ(), "LC_ALL=C")
+	subProcess.Env = append(subProcess.Env, env...)
 	outputBytes, err := subProcess.CombinedOutput()
 	if err != nil {
 		err = ErrorDetails(executable, args, err, outputBytes)
@@ -35,3 +35,8 @@ func (self *FrontendDryRunner) Run(executable string, args ...string) error {
 	}
 	return nil
 }
+
+// RunWithEnv prints the given command as if it was executed with the given additional environment variables.
+func (self *FrontendDryRunner) RunWithEnv(_ []string, executable string, args ...string) error {
+	return self.Run(executable, args...)
+}
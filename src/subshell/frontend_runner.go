@@ -54,7 +54,12 @@ func PrintCommand(branch gitdomain.LocalBranchName, omitBranch bool, cmd string,
 }
 
 // Run runs the given command in this ShellRunner's directory.
-func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
+func (self *FrontendRunner) Run(cmd string, args ...string) error {
+	return self.RunWithEnv([]string{}, cmd, args...)
+}
+
+// RunWithEnv runs the given command with the given additional environment variables in this ShellRunner's directory.
+func (self *FrontendRunner) RunWithEnv(env []string, cmd string, args ...string) (err error) {
 	self.CommandsCounter.Register()
 	var branchName gitdomain.LocalBranchName
 	if !self.OmitBranchNames {
@@ -71,6 +76,7 @@ func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
 		cmd = "cmd"
 	}
 	subProcess := exec.Command(cmd, args...) // #nosec
+	subProcess.Env = append(subProcess.Environ(), env...)
 	subProcess.Stderr = os.Stderr
 	subProcess.Stdin = os.Stdin
 	subProcess.Stdout = os.Stdout
@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+)
+
+// AddHook adds the opcode that runs the given lifecycle hook to the given program
+// if the user has configured a shell command for this hook.
+func AddHook(args AddHookArgs) {
+	if args.Hooks.Command(args.Hook).IsNone() {
+		return
+	}
+	args.Program.Add(&opcodes.RunHook{
+		Branch:  args.Branch,
+		Command: args.Command,
+		Hook:    args.Hook,
+		Parent:  args.Parent,
+	})
+}
+
+type AddHookArgs struct {
+	Branch  gitdomain.LocalBranchName
+	Command string
+	Hook    configdomain.Hook
+	Hooks   configdomain.Hooks
+	Parent  Option[gitdomain.LocalBranchName]
+	Program *program.Program
+}
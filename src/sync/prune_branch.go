@@ -0,0 +1,33 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+)
+
+// pruneBranchProgram adds opcodes that remove the given branch, which was merged into its parent branch, locally and at origin.
+// The children of the given branch become children of its parent branch.
+func pruneBranchProgram(list *program.Program, branch gitdomain.BranchInfo, branchesToPrune gitdomain.LocalBranchNames, args BranchProgramArgs) {
+	localName, hasLocalName := branch.LocalName.Get()
+	if !hasLocalName {
+		return
+	}
+	// leave the branch to delete for its closest ancestor that doesn't get pruned
+	parent := args.Config.MainBranch
+	for _, ancestor := range args.Config.Lineage.Ancestors(localName) {
+		if !branchesToPrune.Contains(ancestor) {
+			parent = ancestor
+		}
+	}
+	list.Add(&opcodes.Checkout{Branch: parent})
+	if trackingBranch, hasTrackingBranch := branch.RemoteName.Get(); hasTrackingBranch && branch.HasTrackingBranch() && args.Remotes.HasRemote(trackingBranch.Remote()) && args.Config.IsOnline() {
+		list.Add(&opcodes.DeleteTrackingBranch{Branch: trackingBranch})
+	}
+	list.Add(&opcodes.DeleteLocalBranch{Branch: localName})
+	list.Add(&opcodes.RemoveBranchFromLineage{Branch: localName})
+	list.Add(&opcodes.QueueMessage{Message: fmt.Sprintf(messages.BranchPruned, localName)})
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/vm/opcodes"
 	"github.com/git-town/git-town/v14/src/vm/program"
 )
@@ -14,14 +15,15 @@ func BranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
 	if hasLocalName {
 		if parent, hasParent := args.Config.Lineage.Parent(localName).Get(); hasParent {
 			parentBranchInfo, hasParentBranchInfo := args.BranchInfos.FindByLocalName(parent).Get()
-			parentOtherWorktree = hasParentBranchInfo && parentBranchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
+			// feature branches in other worktrees get synced in place, so syncing against their local branch is fine
+			parentOtherWorktree = hasParentBranchInfo && parentBranchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree && !syncsInWorktree(args.Config.BranchType(parent))
 		}
 	}
 	switch {
 	case branch.SyncStatus == gitdomain.SyncStatusDeletedAtRemote:
 		syncDeletedBranchProgram(args.Program, localName, parentOtherWorktree, args)
 	case branch.SyncStatus == gitdomain.SyncStatusOtherWorktree:
-		// Git Town doesn't sync branches that are active in another worktree
+		otherWorktreeBranchProgram(branch, args)
 	default:
 		ExistingBranchProgram(args.Program, branch, parentOtherWorktree, args)
 	}
@@ -29,12 +31,15 @@ func BranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
 }
 
 type BranchProgramArgs struct {
-	BranchInfos   gitdomain.BranchInfos
-	Config        configdomain.ValidatedConfig
-	InitialBranch gitdomain.LocalBranchName
-	Program       *program.Program
-	PushBranch    bool
-	Remotes       gitdomain.Remotes
+	BranchInfos        gitdomain.BranchInfos
+	Command            string // name of the Git Town command that syncs the branch
+	Config             configdomain.ValidatedConfig
+	InitialBranch      gitdomain.LocalBranchName
+	Program            *program.Program
+	PushBranch         bool
+	QueueSkippedPushes bool // whether to remember the pushes skipped while offline, so that "git town push-pending" can push them later
+	Remotes            gitdomain.Remotes
+	Worktrees          gitdomain.Worktrees // the other worktrees of this repo
 }
 
 // ExistingBranchProgram provides the opcode to sync a particular branch.
@@ -51,7 +56,7 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 	list.Add(&opcodes.Checkout{Branch: localName})
 	branchType := args.Config.BranchType(localName)
 	switch branchType {
-	case configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		FeatureBranchProgram(featureBranchArgs{
 			localName:           localName,
 			offline:             args.Config.Offline,
@@ -76,16 +81,22 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 	case configdomain.BranchTypeObservedBranch:
 		ObservedBranchProgram(branch.RemoteName, args.Program)
 	}
-	if args.PushBranch && args.Remotes.HasOrigin() && args.Config.IsOnline() && branchType.ShouldPush(localName, args.InitialBranch) {
+	if args.PushBranch && args.Remotes.HasRemote(args.Config.TrackingRemote(localName)) && branchType.ShouldPush(localName, args.InitialBranch) {
 		switch {
-		case !branch.HasTrackingBranch():
-			list.Add(&opcodes.CreateTrackingBranch{Branch: localName})
-		case isMainOrPerennialBranch:
-			list.Add(&opcodes.PushCurrentBranch{CurrentBranch: localName})
-		default:
-			pushFeatureBranchProgram(list, localName, args.Config.SyncFeatureStrategy)
+		case args.Config.IsOnline():
+			PushBranchProgram(list, branch, args.Config)
+		case args.QueueSkippedPushes:
+			list.Add(&opcodes.QueuePendingPush{Branch: localName})
 		}
 	}
+	AddHook(AddHookArgs{
+		Branch:  localName,
+		Command: args.Command,
+		Hook:    configdomain.HookPostSyncBranch,
+		Hooks:   args.Config.Hooks,
+		Parent:  args.Config.Lineage.Parent(localName),
+		Program: list,
+	})
 }
 
 // pullParentBranchOfCurrentFeatureBranchOpcode adds the opcode to pull updates from the parent branch of the current feature branch into the current feature branch.
@@ -105,6 +116,22 @@ type pullParentBranchOfCurrentFeatureBranchOpcodeArgs struct {
 	syncStrategy        configdomain.SyncFeatureStrategy
 }
 
+// PushBranchProgram provides the opcodes to push the given branch, which must be checked out, to the origin remote.
+func PushBranchProgram(list *program.Program, branch gitdomain.BranchInfo, config configdomain.ValidatedConfig) {
+	localName, hasLocalName := branch.LocalName.Get()
+	if !hasLocalName {
+		return
+	}
+	switch {
+	case !branch.HasTrackingBranch():
+		list.Add(&opcodes.CreateTrackingBranch{Branch: localName})
+	case config.IsMainOrPerennialBranch(localName):
+		list.Add(&opcodes.PushCurrentBranch{CurrentBranch: localName})
+	default:
+		pushFeatureBranchProgram(list, localName, config.SyncFeatureStrategy)
+	}
+}
+
 func pushFeatureBranchProgram(list *program.Program, branch gitdomain.LocalBranchName, syncFeatureStrategy configdomain.SyncFeatureStrategy) {
 	switch syncFeatureStrategy {
 	case configdomain.SyncFeatureStrategyMerge:
@@ -123,3 +150,39 @@ func updateCurrentPerennialBranchOpcode(list *program.Program, otherBranch gitdo
 		list.Add(&opcodes.RebaseBranch{Branch: otherBranch.BranchName()})
 	}
 }
+
+// otherWorktreeBranchProgram syncs the given branch, which is active in another worktree, in place in that worktree.
+// Git Town leaves branches of other types alone because it cannot check them out.
+func otherWorktreeBranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
+	localName, hasLocalName := branch.LocalName.Get()
+	if !hasLocalName {
+		return
+	}
+	worktree, hasWorktree := args.Worktrees.ForBranch(localName).Get()
+	branchType := args.Config.BranchType(localName)
+	if !hasWorktree || !syncsInWorktree(branchType) {
+		return
+	}
+	trackingBranch := None[gitdomain.RemoteBranchName]()
+	if branch.HasTrackingBranch() {
+		trackingBranch = branch.RemoteName
+	}
+	args.Program.Add(&opcodes.SyncBranchInWorktree{
+		Branch:         localName,
+		PushBranch:     args.PushBranch && args.Config.IsOnline() && args.Remotes.HasRemote(args.Config.TrackingRemote(localName)) && branchType.ShouldPush(localName, args.InitialBranch),
+		SyncStrategy:   args.Config.SyncFeatureStrategy,
+		TrackingBranch: trackingBranch,
+		Worktree:       worktree.Path,
+	})
+}
+
+// syncsInWorktree indicates whether Git Town syncs branches of the given type in place when they are active in another worktree.
+func syncsInWorktree(branchType configdomain.BranchType) bool {
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
+		return true
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePerennialBranch:
+		return false
+	}
+	return false
+}
@@ -10,6 +10,11 @@ import (
 // BranchesProgram syncs all given branches.
 func BranchesProgram(args BranchesProgramArgs) {
 	for _, branch := range args.BranchesToSync {
+		if localName, hasLocalName := branch.LocalName.Get(); hasLocalName && args.BranchesToPrune.Contains(localName) {
+			pruneBranchProgram(args.Program, branch, args.BranchesToPrune, args.BranchProgramArgs)
+			args.Program.Add(&opcodes.EndOfBranchProgram{})
+			continue
+		}
 		BranchProgram(branch, args.BranchProgramArgs)
 	}
 	previousbranchCandidates := gitdomain.LocalBranchNames{}
@@ -35,10 +40,11 @@ func BranchesProgram(args BranchesProgramArgs) {
 
 type BranchesProgramArgs struct {
 	BranchProgramArgs
-	BranchesToSync gitdomain.BranchInfos
-	DryRun         bool
-	HasOpenChanges bool
-	InitialBranch  gitdomain.LocalBranchName
-	PreviousBranch Option[gitdomain.LocalBranchName]
-	ShouldPushTags bool
+	BranchesToPrune gitdomain.LocalBranchNames
+	BranchesToSync  gitdomain.BranchInfos
+	DryRun          bool
+	HasOpenChanges  bool
+	InitialBranch   gitdomain.LocalBranchName
+	PreviousBranch  Option[gitdomain.LocalBranchName]
+	ShouldPushTags  bool
 }
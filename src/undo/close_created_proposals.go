@@ -0,0 +1,16 @@
+package undo
+
+import (
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/program"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+)
+
+// provides the program that closes the proposals that the given Git Town command has created
+func closeCreatedProposalsProgram(runState runstate.RunState) program.Program {
+	result := program.Program{}
+	for _, number := range runState.CreatedProposals {
+		result.Add(&opcodes.CloseProposal{ProposalNumber: number})
+	}
+	return result
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/git-town/git-town/v14/src/git"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	"github.com/git-town/git-town/v14/src/gohacks"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/gohacks/stringslice"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	lightInterpreter "github.com/git-town/git-town/v14/src/vm/interpreter/light"
 	"github.com/git-town/git-town/v14/src/vm/runstate"
@@ -32,6 +34,7 @@ func Execute(args ExecuteArgs) error {
 	lightInterpreter.Execute(lightInterpreter.ExecuteArgs{
 		Backend:       args.Backend,
 		Config:        args.Config,
+		Connector:     args.Connector,
 		FinalMessages: args.FinalMessages,
 		Frontend:      args.Frontend,
 		Git:           args.Git,
@@ -41,6 +44,12 @@ func Execute(args ExecuteArgs) error {
 	if err != nil {
 		return fmt.Errorf(messages.RunstateDeleteProblem, err)
 	}
+	if args.RunState.IsFinished() {
+		err = statefile.RemoveNewestFromHistory(args.RootDir)
+		if err != nil {
+			return fmt.Errorf(messages.RunstateDeleteProblem, err)
+		}
+	}
 	print.Footer(args.Verbose, args.CommandsCounter.Count(), args.FinalMessages.Result())
 	return nil
 }
@@ -49,6 +58,7 @@ type ExecuteArgs struct {
 	Backend          gitdomain.RunnerQuerier
 	CommandsCounter  gohacks.Counter
 	Config           config.ValidatedConfig
+	Connector        Option[hostingdomain.Connector]
 	FinalMessages    stringslice.Collector
 	Frontend         gitdomain.Runner
 	Git              git.Commands
@@ -14,6 +14,7 @@ import (
 func CreateUndoForFinishedProgram(args CreateUndoProgramArgs) program.Program {
 	result := program.Program{}
 	result.AddProgram(args.RunState.AbortProgram)
+	result.AddProgram(closeCreatedProposalsProgram(args.RunState))
 	if !args.RunState.IsFinished() && args.HasOpenChanges {
 		// Open changes in the middle of an unfinished command will be undone as well.
 		// To achieve this, we commit them here so that they are gone when the branch is reset to the original SHA.
@@ -15,6 +15,7 @@ import (
 func CreateUndoForRunningProgram(args CreateUndoProgramArgs) (program.Program, error) {
 	result := program.Program{}
 	result.AddProgram(args.RunState.AbortProgram)
+	result.AddProgram(closeCreatedProposalsProgram(args.RunState))
 	if endConfigSnapshot, hasEndConfigSnapshot := args.RunState.EndConfigSnapshot.Get(); hasEndConfigSnapshot {
 		result.AddProgram(undoconfig.DetermineUndoConfigProgram(args.RunState.BeginConfigSnapshot, endConfigSnapshot))
 	}
@@ -70,6 +70,11 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset omni-changed feature branches
 	for _, branch := range omniChangedFeatures.BranchNames() {
 		change := omniChangedFeatures[branch]
+		if worktree, inOtherWorktree := args.Worktrees.ForBranch(branch).Get(); inOtherWorktree {
+			result.Add(&opcodes.ResetWorktreeToSHA{Branch: branch, MustHaveSHA: change.After, SetToSHA: change.Before, Worktree: worktree.Path})
+			result.Add(&opcodes.ResetRemoteBranchToSHA{Branch: branch.TrackingBranch(args.Config.TrackingRemote(branch)), MustHaveSHA: change.After, SetToSHA: change.Before})
+			continue
+		}
 		result.Add(&opcodes.Checkout{Branch: branch})
 		result.Add(&opcodes.ResetCurrentBranchToSHA{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
 		result.Add(&opcodes.ForcePushCurrentBranch{})
@@ -101,12 +106,21 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		hasBeforeRemote, beforeRemoteName, beforeRemoteSHA := inconsistentChange.Before.GetRemote()
 		hasAfterSHAs, afterLocalSHA, afterRemoteSHA := inconsistentChange.After.GetSHAs()
 		if hasBeforeLocal && hasBeforeRemote && hasAfterSHAs {
-			result.Add(&opcodes.Checkout{Branch: beforeLocalName})
-			result.Add(&opcodes.ResetCurrentBranchToSHA{
-				MustHaveSHA: afterLocalSHA,
-				SetToSHA:    beforeLocalSHA,
-				Hard:        true,
-			})
+			if worktree, inOtherWorktree := args.Worktrees.ForBranch(beforeLocalName).Get(); inOtherWorktree {
+				result.Add(&opcodes.ResetWorktreeToSHA{
+					Branch:      beforeLocalName,
+					MustHaveSHA: afterLocalSHA,
+					SetToSHA:    beforeLocalSHA,
+					Worktree:    worktree.Path,
+				})
+			} else {
+				result.Add(&opcodes.Checkout{Branch: beforeLocalName})
+				result.Add(&opcodes.ResetCurrentBranchToSHA{
+					MustHaveSHA: afterLocalSHA,
+					SetToSHA:    beforeLocalSHA,
+					Hard:        true,
+				})
+			}
 			result.Add(&opcodes.ResetRemoteBranchToSHA{
 				Branch:      beforeRemoteName,
 				MustHaveSHA: afterRemoteSHA,
@@ -117,7 +131,7 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 
 	// remove remotely added branches
 	for _, addedRemoteBranch := range self.RemoteAdded {
-		if addedRemoteBranch.Remote() != gitdomain.RemoteUpstream {
+		if remote := addedRemoteBranch.Remote(); remote != gitdomain.RemoteUpstream || remote == args.Config.PushRemote {
 			result.Add(&opcodes.DeleteTrackingBranch{
 				Branch: addedRemoteBranch,
 			})
@@ -128,8 +142,10 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	_, removedFeatureTrackingBranches := CategorizeRemoteBranchesSHAs(self.RemoteRemoved, args.Config)
 	for _, branch := range removedFeatureTrackingBranches.BranchNames() {
 		sha := removedFeatureTrackingBranches[branch]
+		remote, localBranch := branch.Parts()
 		result.Add(&opcodes.CreateRemoteBranch{
-			Branch: branch.LocalBranchName(),
+			Branch: localBranch,
+			Remote: remote,
 			SHA:    sha,
 		})
 	}
@@ -137,6 +153,10 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset locally changed branches
 	for _, localBranch := range self.LocalChanged.BranchNames() {
 		change := self.LocalChanged[localBranch]
+		if worktree, inOtherWorktree := args.Worktrees.ForBranch(localBranch).Get(); inOtherWorktree {
+			result.Add(&opcodes.ResetWorktreeToSHA{Branch: localBranch, MustHaveSHA: change.After, SetToSHA: change.Before, Worktree: worktree.Path})
+			continue
+		}
 		result.Add(&opcodes.Checkout{Branch: localBranch})
 		result.Add(&opcodes.ResetCurrentBranchToSHA{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
 	}
@@ -183,4 +203,5 @@ type BranchChangesUndoProgramArgs struct {
 	Config                   configdomain.ValidatedConfig
 	EndBranch                gitdomain.LocalBranchName
 	UndoablePerennialCommits []gitdomain.SHA
+	Worktrees                gitdomain.Worktrees // the other worktrees of this repo, in which the undo program cannot check out branches
 }
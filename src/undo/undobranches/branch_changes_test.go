@@ -1395,6 +1395,7 @@ func TestChanges(t *testing.T) {
 			// because those are protected
 			&opcodes.CreateRemoteBranch{
 				Branch: gitdomain.NewLocalBranchName("feature-branch"),
+				Remote: gitdomain.RemoteOrigin,
 				SHA:    gitdomain.NewSHA("222222"),
 			},
 			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("feature-branch")},
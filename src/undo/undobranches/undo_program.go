@@ -14,5 +14,6 @@ func DetermineUndoBranchesProgram(beginBranchesSnapshot, endBranchesSnapshot git
 		Config:                   fullConfig,
 		EndBranch:                endBranchesSnapshot.Active.GetOrDefault(),
 		UndoablePerennialCommits: undoablePerennialCommits,
+		Worktrees:                endBranchesSnapshot.Worktrees,
 	})
 }
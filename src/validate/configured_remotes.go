@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/config/gitconfig"
+	"github.com/git-town/git-town/v14/src/git"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// ConfiguredRemotes verifies that the remotes configured via the "push-remote" and "proposal-remote" settings exist.
+// The origin remote is the default for both settings, repositories without an origin remote are legitimate.
+func ConfiguredRemotes(config *configdomain.UnvalidatedConfig, gitCommands git.Commands, querier gitdomain.Querier) error {
+	if config.PushRemote == gitdomain.RemoteOrigin && config.ProposalRemote == gitdomain.RemoteOrigin {
+		return nil
+	}
+	remotes, err := gitCommands.Remotes(querier)
+	if err != nil {
+		return err
+	}
+	if config.PushRemote != gitdomain.RemoteOrigin && !remotes.HasRemote(config.PushRemote) {
+		return fmt.Errorf(messages.ConfigRemoteUnknown, gitconfig.KeyPushRemote, config.PushRemote)
+	}
+	if config.ProposalRemote != gitdomain.RemoteOrigin && !remotes.HasRemote(config.ProposalRemote) {
+		return fmt.Errorf(messages.ConfigRemoteUnknown, gitconfig.KeyProposalRemote, config.ProposalRemote)
+	}
+	return nil
+}
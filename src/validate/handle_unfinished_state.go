@@ -3,6 +3,7 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"github.com/git-town/git-town/v14/src/cli/report"
 
 	"github.com/git-town/git-town/v14/src/cli/dialog"
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
@@ -107,6 +108,7 @@ func continueRunstate(runState runstate.RunState, args UnfinishedStateArgs) (boo
 		InitialBranchesSnapshot: runState.BeginBranchesSnapshot,
 		InitialConfigSnapshot:   runState.BeginConfigSnapshot,
 		InitialStashSize:        runState.BeginStashSize,
+		Plan:                    None[report.Format](),
 		RootDir:                 args.RootDir,
 		RunState:                runState,
 		Verbose:                 args.Verbose,
@@ -198,6 +200,7 @@ func undoRunState(args UnfinishedStateArgs, runState runstate.RunState) (bool, e
 		Backend:          args.Backend,
 		CommandsCounter:  args.CommandsCounter,
 		Config:           validatedConfig,
+		Connector:        args.Connector,
 		FinalMessages:    args.FinalMessages,
 		Frontend:         args.Frontend,
 		Git:              args.Git,
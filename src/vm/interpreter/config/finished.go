@@ -34,6 +34,7 @@ func Finished(args FinishedArgs) error {
 		BeginConfigSnapshot:      args.BeginConfigSnapshot,
 		BeginStashSize:           0,
 		Command:                  args.Command,
+		CreatedProposals:         []int{},
 		DryRun:                   false,
 		EndBranchesSnapshot:      None[gitdomain.BranchesSnapshot](),
 		EndConfigSnapshot:        Some(configSnapshot),
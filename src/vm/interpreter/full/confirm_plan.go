@@ -0,0 +1,38 @@
+package interpreter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/cli/dialog"
+	"github.com/git-town/git-town/v14/src/cli/report"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// confirmPlan displays the program to run in the format requested via the "--plan" flag
+// and asks the user whether to run it.
+// Returns whether the command should exit without running the program.
+func confirmPlan(args ExecuteArgs) (exit bool, err error) {
+	format, hasFormat := args.Plan.Get()
+	if !hasFormat {
+		return false, nil
+	}
+	switch format {
+	case report.FormatJSON:
+		content, err := json.MarshalIndent(args.RunState.RunProgram, "", "  ")
+		if err != nil {
+			return true, err
+		}
+		fmt.Println(string(content))
+	case report.FormatText:
+		fmt.Println(messages.PlanHeader)
+		fmt.Println()
+		fmt.Print(args.RunState.RunProgram.Plan(args.InitialBranch))
+	}
+	fmt.Println()
+	confirmed, aborted, err := dialog.ConfirmPlan(args.DialogTestInputs.Next())
+	if err != nil || aborted {
+		return true, err
+	}
+	return !confirmed, nil
+}
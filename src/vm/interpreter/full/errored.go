@@ -9,6 +9,7 @@ import (
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
 	"github.com/git-town/git-town/v14/src/vm/shared"
 	"github.com/git-town/git-town/v14/src/vm/statefile"
 )
@@ -55,7 +56,7 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if err != nil {
 		return err
 	}
-	if args.RunState.Command == "sync" && !(repoStatus.RebaseInProgress && args.Config.Config.IsMainBranch(currentBranch)) {
+	if (args.RunState.Command == "sync" || args.RunState.Command == runstate.WalkCommand) && !(repoStatus.RebaseInProgress && args.Config.Config.IsMainBranch(currentBranch)) {
 		if unfinishedDetails, hasUnfinishedDetails := args.RunState.UnfinishedDetails.Get(); hasUnfinishedDetails {
 			unfinishedDetails.CanSkip = true
 		}
@@ -2,6 +2,7 @@ package interpreter
 
 import (
 	"github.com/git-town/git-town/v14/src/cli/dialog/components"
+	"github.com/git-town/git-town/v14/src/cli/report"
 	"github.com/git-town/git-town/v14/src/config"
 	"github.com/git-town/git-town/v14/src/git"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
@@ -12,10 +13,21 @@ import (
 	"github.com/git-town/git-town/v14/src/undo/undoconfig"
 	"github.com/git-town/git-town/v14/src/vm/runstate"
 	"github.com/git-town/git-town/v14/src/vm/shared"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
 )
 
 // Execute runs the commands in the given runstate.
 func Execute(args ExecuteArgs) error {
+	exit, err := confirmPlan(args)
+	if err != nil || exit {
+		return err
+	}
+	addPendingPush := func(branch gitdomain.LocalBranchName) error {
+		return statefile.AddPendingPush(args.RootDir, branch)
+	}
+	removePendingPushes := func(branches gitdomain.LocalBranchNames) error {
+		return statefile.RemovePendingPushes(args.RootDir, branches)
+	}
 	for {
 		nextStep := args.RunState.RunProgram.Pop()
 		if nextStep == nil {
@@ -27,6 +39,7 @@ func Execute(args ExecuteArgs) error {
 			continue
 		}
 		err := nextStep.Run(shared.RunArgs{
+			AddPendingPush:                  addPendingPush,
 			Backend:                         args.Backend,
 			Config:                          args.Config,
 			Connector:                       args.Connector,
@@ -35,7 +48,9 @@ func Execute(args ExecuteArgs) error {
 			Frontend:                        args.Frontend,
 			Git:                             args.Git,
 			PrependOpcodes:                  args.RunState.RunProgram.Prepend,
+			RegisterCreatedProposal:         args.RunState.RegisterCreatedProposal,
 			RegisterUndoablePerennialCommit: args.RunState.RegisterUndoablePerennialCommit,
+			RemovePendingPushes:             removePendingPushes,
 			UpdateInitialBranchLocalSHA:     args.InitialBranchesSnapshot.Branches.UpdateLocalSHA,
 		})
 		if err != nil {
@@ -58,6 +73,7 @@ type ExecuteArgs struct {
 	InitialBranchesSnapshot gitdomain.BranchesSnapshot
 	InitialConfigSnapshot   undoconfig.ConfigSnapshot
 	InitialStashSize        gitdomain.StashSize
+	Plan                    Option[report.Format]
 	RootDir                 gitdomain.RepoRootDir
 	RunState                runstate.RunState
 	Verbose                 bool
@@ -18,15 +18,18 @@ import (
 func Execute(args ExecuteArgs) {
 	for _, opcode := range args.Prog {
 		err := opcode.Run(shared.RunArgs{
+			AddPendingPush:                  nil,
 			Backend:                         args.Backend,
 			Config:                          args.Config,
-			Connector:                       None[hostingdomain.Connector](),
+			Connector:                       args.Connector,
 			DialogTestInputs:                components.NewTestInputs(),
 			FinalMessages:                   args.FinalMessages,
 			Frontend:                        args.Frontend,
 			Git:                             args.Git,
 			PrependOpcodes:                  nil,
+			RegisterCreatedProposal:         nil,
 			RegisterUndoablePerennialCommit: nil,
+			RemovePendingPushes:             nil,
 			UpdateInitialBranchLocalSHA:     nil,
 		})
 		if err != nil {
@@ -38,6 +41,7 @@ func Execute(args ExecuteArgs) {
 type ExecuteArgs struct {
 	Backend       gitdomain.RunnerQuerier
 	Config        config.ValidatedConfig
+	Connector     Option[hostingdomain.Connector]
 	FinalMessages stringslice.Collector
 	Frontend      gitdomain.Runner
 	Git           git.Commands
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// AddToFeatureBranches adds the branch with the given name as a feature branch.
+type AddToFeatureBranches struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *AddToFeatureBranches) Run(args shared.RunArgs) error {
+	return args.Config.AddToFeatureBranches(self.Branch)
+}
@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// CloseProposal closes the proposal with the given number at the code hosting platform without merging it.
+type CloseProposal struct {
+	ProposalNumber          int
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CloseProposal) CreateAutomaticUndoError() error {
+	return fmt.Errorf(messages.ProposalCloseProblem, self.ProposalNumber)
+}
+
+func (self *CloseProposal) Run(args shared.RunArgs) error {
+	if connector, hasConnector := args.Connector.Get(); hasConnector {
+		return connector.CloseProposal(self.ProposalNumber)
+	}
+	return hostingdomain.UnsupportedServiceError()
+}
+
+func (self *CloseProposal) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
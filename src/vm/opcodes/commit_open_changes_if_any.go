@@ -0,0 +1,32 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// CommitOpenChangesIfAny commits all open changes on the current branch using the given commit message.
+// Does nothing if there are no open changes.
+type CommitOpenChangesIfAny struct {
+	Message                 string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CommitOpenChangesIfAny) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *CommitOpenChangesIfAny) Run(args shared.RunArgs) error {
+	repoStatus, err := args.Git.RepoStatus(args.Backend)
+	if err != nil {
+		return err
+	}
+	if !repoStatus.OpenChanges {
+		return nil
+	}
+	if err = args.Git.StageFiles(args.Frontend, "-A"); err != nil {
+		return err
+	}
+	return args.Git.CommitStagedChanges(args.Frontend, self.Message)
+}
@@ -0,0 +1,40 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// ConnectorMergeProposalWithMergeCommit merges the proposal with the given number
+// via the API of the code hosting platform using a merge commit.
+type ConnectorMergeProposalWithMergeCommit struct {
+	CommitMessage           Option[gitdomain.CommitMessage]
+	ProposalNumber          int
+	mergeError              error
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorMergeProposalWithMergeCommit) CreateAutomaticUndoError() error {
+	return self.mergeError
+}
+
+func (self *ConnectorMergeProposalWithMergeCommit) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *ConnectorMergeProposalWithMergeCommit) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	self.mergeError = connector.MergeProposal(self.ProposalNumber, self.CommitMessage)
+	return self.mergeError
+}
+
+func (self *ConnectorMergeProposalWithMergeCommit) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
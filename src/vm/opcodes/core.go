@@ -47,6 +47,7 @@ func Types() []shared.Opcode {
 	return []shared.Opcode{
 		&AbortMerge{},
 		&AbortRebase{},
+		&AddToFeatureBranches{},
 		&AddToPerennialBranches{},
 		&ChangeParent{},
 		&Checkout{},
@@ -54,8 +55,11 @@ func Types() []shared.Opcode {
 		&CheckoutIfExists{},
 		&CheckoutParent{},
 		&ChangeParent{},
+		&CloseProposal{},
 		&CommitOpenChanges{},
+		&CommitOpenChangesIfAny{},
 		&ConnectorMergeProposal{},
+		&ConnectorMergeProposalWithMergeCommit{},
 		&ContinueMerge{},
 		&ContinueRebase{},
 		&CreateAndCheckoutBranchExistingParent{},
@@ -69,26 +73,36 @@ func Types() []shared.Opcode {
 		&DiscardOpenChanges{},
 		&EndOfBranchProgram{},
 		&EnsureHasShippableChanges{},
+		&ExecuteShellCommand{},
 		&FetchUpstream{},
 		&ForcePushCurrentBranch{},
 		&DeleteBranchIfEmptyAtRuntime{},
 		&Merge{},
+		&MergeFastForward{},
+		&MergeNoFastForward{},
 		&MergeParent{},
 		&PreserveCheckoutHistory{},
 		&PullCurrentBranch{},
 		&PushCurrentBranch{},
 		&PushTags{},
+		&QueuePendingPush{},
 		&RebaseBranch{},
 		&RebaseFeatureTrackingBranch{},
+		&RebaseOnto{},
 		&RebaseParent{},
 		&RemoveBranchFromLineage{},
 		&RemoveFromPerennialBranches{},
+		&RemoveFromPrototypeBranches{},
 		&RemoveGlobalConfig{},
 		&RemoveLocalConfig{},
+		&RemovePendingPushes{},
+		&ReopenProposal{},
 		&ResetCurrentBranchToSHA{},
 		&ResetRemoteBranchToSHA{},
+		&ResetWorktreeToSHA{},
 		&RestoreOpenChanges{},
 		&RevertCommit{},
+		&RunHook{},
 		&SetExistingParent{},
 		&SetGlobalConfig{},
 		&SetLocalConfig{},
@@ -97,7 +111,9 @@ func Types() []shared.Opcode {
 		&SkipCurrentBranch{},
 		&StashOpenChanges{},
 		&SquashMerge{},
+		&SyncBranchInWorktree{},
 		&UndoLastCommit{},
+		&UpdateProposalLineage{},
 		&UpdateProposalTarget{},
 	} //exhaustruct:ignore
 }
@@ -5,15 +5,24 @@ import (
 
 	"github.com/git-town/git-town/v14/src/browser"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v14/src/messages"
 	"github.com/git-town/git-town/v14/src/vm/shared"
 )
 
 // CreateProposal creates a new proposal for the current branch.
+// If any of the proposal details are given, it creates the proposal via the API of the hosting platform.
+// Otherwise it opens the page to create a new proposal in the browser.
 type CreateProposal struct {
+	Body                    Option[string]
 	Branch                  gitdomain.LocalBranchName
+	Draft                   bool
 	MainBranch              gitdomain.LocalBranchName
+	NoBrowser               bool
+	OnlyIfMissing           bool // don't create a proposal if the branch already has one
+	Title                   Option[string]
+	ViaAPI                  bool // create the proposal via the API even if no proposal details are given
 	undeclaredOpcodeMethods `exhaustruct:"optional"`
 }
 
@@ -32,10 +41,41 @@ func (self *CreateProposal) Run(args shared.RunArgs) error {
 	if !hasConnector {
 		return hostingdomain.UnsupportedServiceError()
 	}
-	prURL, err := connector.NewProposalURL(self.Branch, parentBranch, self.MainBranch)
+	if self.OnlyIfMissing && !args.Config.DryRun {
+		existingProposal, err := connector.FindProposal(self.Branch, parentBranch)
+		if err != nil {
+			return err
+		}
+		if proposal, hasProposal := existingProposal.Get(); hasProposal {
+			args.FinalMessages.Add(fmt.Sprintf(messages.ProposalAlreadyExists, self.Branch, proposal.URL))
+			return nil
+		}
+	}
+	if !self.useAPI() {
+		prURL, err := connector.NewProposalURL(self.Branch, parentBranch, self.MainBranch)
+		if err != nil {
+			return err
+		}
+		browser.Open(prURL, args.Frontend, args.Backend)
+		return nil
+	}
+	if args.Config.DryRun {
+		return nil
+	}
+	title := self.Title.GetOrElse(self.Branch.String())
+	proposal, err := connector.CreateProposal(self.Branch, parentBranch, title, self.Body.GetOrDefault(), self.Draft)
 	if err != nil {
-		return err
+		return fmt.Errorf(messages.ProposalCreateProblem, self.Branch, err)
+	}
+	args.RegisterCreatedProposal(proposal.Number)
+	args.FinalMessages.Add(fmt.Sprintf(messages.ProposalCreated, proposal.URL))
+	if !self.NoBrowser {
+		browser.Open(proposal.URL, args.Frontend, args.Backend)
 	}
-	browser.Open(prURL, args.Frontend, args.Backend)
 	return nil
 }
+
+// useAPI indicates whether this opcode creates the proposal via the API of the hosting platform.
+func (self *CreateProposal) useAPI() bool {
+	return self.ViaAPI || self.NoBrowser || self.Draft || self.Title.IsSome() || self.Body.IsSome()
+}
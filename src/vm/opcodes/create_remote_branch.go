@@ -5,13 +5,14 @@ import (
 	"github.com/git-town/git-town/v14/src/vm/shared"
 )
 
-// CreateRemoteBranch pushes the given local branch up to origin.
+// CreateRemoteBranch pushes the given local branch up to the given remote.
 type CreateRemoteBranch struct {
 	Branch                  gitdomain.LocalBranchName
+	Remote                  gitdomain.Remote
 	SHA                     gitdomain.SHA
 	undeclaredOpcodeMethods `exhaustruct:"optional"`
 }
 
 func (self *CreateRemoteBranch) Run(args shared.RunArgs) error {
-	return args.Git.CreateRemoteBranch(args.Frontend, self.SHA, self.Branch, args.Config.Config.NoPushHook())
+	return args.Git.CreateRemoteBranch(args.Frontend, self.SHA, self.Branch, self.Remote, args.Config.Config.NoPushHook())
 }
@@ -5,7 +5,7 @@ import (
 	"github.com/git-town/git-town/v14/src/vm/shared"
 )
 
-// CreateTrackingBranch pushes the given local branch up to origin
+// CreateTrackingBranch pushes the given local branch up to the remote it should be tracked at
 // and marks it as tracking the current branch.
 type CreateTrackingBranch struct {
 	Branch                  gitdomain.LocalBranchName
@@ -19,5 +19,5 @@ func (self *CreateTrackingBranch) CreateContinueProgram() []shared.Opcode {
 }
 
 func (self *CreateTrackingBranch) Run(args shared.RunArgs) error {
-	return args.Git.CreateTrackingBranch(args.Frontend, self.Branch, gitdomain.RemoteOrigin, args.Config.Config.NoPushHook())
+	return args.Git.CreateTrackingBranch(args.Frontend, self.Branch, args.Config.Config.TrackingRemote(self.Branch), args.Config.Config.NoPushHook())
 }
@@ -0,0 +1,23 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// ExecuteShellCommand executes the given shell command on the current branch.
+// Continuing after a failed shell command doesn't execute it again
+// but proceeds with the remaining opcodes.
+type ExecuteShellCommand struct {
+	Command                 string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ExecuteShellCommand) Run(args shared.RunArgs) error {
+	if err := args.Frontend.Run("sh", "-c", self.Command); err != nil {
+		return fmt.Errorf(messages.ShellCommandFailed, self.Command, err)
+	}
+	return nil
+}
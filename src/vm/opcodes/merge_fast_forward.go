@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// MergeFastForward fast-forwards the current branch to the branch with the given name.
+type MergeFastForward struct {
+	Branch                  gitdomain.LocalBranchName
+	Parent                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *MergeFastForward) CreateAutomaticUndoError() error {
+	return fmt.Errorf(messages.ShipFastForwardProblem, self.Branch, self.Parent, self.Branch, self.Parent)
+}
+
+func (self *MergeFastForward) Run(args shared.RunArgs) error {
+	return args.Git.MergeFastForward(args.Frontend, self.Branch)
+}
+
+func (self *MergeFastForward) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
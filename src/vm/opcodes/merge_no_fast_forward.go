@@ -0,0 +1,35 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// MergeNoFastForward merges the branch with the given name into the current branch using a merge commit.
+type MergeNoFastForward struct {
+	Branch                  gitdomain.LocalBranchName
+	CommitMessage           Option[gitdomain.CommitMessage]
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *MergeNoFastForward) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortMerge{},
+	}
+}
+
+func (self *MergeNoFastForward) CreateAutomaticUndoError() error {
+	return errors.New(messages.ShipAbortedMergeError)
+}
+
+func (self *MergeNoFastForward) Run(args shared.RunArgs) error {
+	return args.Git.MergeNoFastForward(args.Frontend, self.CommitMessage, self.Branch)
+}
+
+func (self *MergeNoFastForward) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
@@ -31,7 +31,7 @@ func (self *MergeParent) Run(args shared.RunArgs) error {
 	}
 	var branchToMerge gitdomain.BranchName
 	if self.ParentActiveInOtherWorktree {
-		branchToMerge = parent.TrackingBranch().BranchName()
+		branchToMerge = parent.TrackingBranch(args.Config.Config.TrackingRemote(parent)).BranchName()
 	} else {
 		branchToMerge = parent.BranchName()
 	}
@@ -0,0 +1,21 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// QueuePendingPush remembers that the given branch couldn't be pushed
+// because the origin remote was unreachable,
+// so that a later "git town sync" or "git town push-pending" pushes it.
+type QueuePendingPush struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *QueuePendingPush) Run(args shared.RunArgs) error {
+	if args.Config.DryRun {
+		return nil
+	}
+	return args.AddPendingPush(self.Branch)
+}
@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// RebaseOnto moves the commits of the current branch that aren't in CommitsToRemove
+// onto the branch with the given name.
+type RebaseOnto struct {
+	BranchToRebaseOnto      gitdomain.BranchName
+	CommitsToRemove         gitdomain.BranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RebaseOnto) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{&AbortRebase{}}
+}
+
+func (self *RebaseOnto) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseOnto) Run(args shared.RunArgs) error {
+	return args.Git.RebaseOnto(args.Frontend, self.BranchToRebaseOnto, self.CommitsToRemove)
+}
@@ -31,7 +31,7 @@ func (self *RebaseParent) Run(args shared.RunArgs) error {
 	}
 	var branchToRebase gitdomain.BranchName
 	if self.ParentActiveInOtherWorktree {
-		branchToRebase = parent.TrackingBranch().BranchName()
+		branchToRebase = parent.TrackingBranch(args.Config.Config.TrackingRemote(parent)).BranchName()
 	} else {
 		branchToRebase = parent.BranchName()
 	}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// RemoveFromPrototypeBranches removes the branch with the given name as a prototype branch.
+type RemoveFromPrototypeBranches struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RemoveFromPrototypeBranches) Run(args shared.RunArgs) error {
+	return args.Config.RemoveFromPrototypeBranches(self.Branch)
+}
@@ -0,0 +1,20 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// RemovePendingPushes forgets the pending pushes of the given branches
+// after they got pushed.
+type RemovePendingPushes struct {
+	Branches                gitdomain.LocalBranchNames
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RemovePendingPushes) Run(args shared.RunArgs) error {
+	if args.Config.DryRun {
+		return nil
+	}
+	return args.RemovePendingPushes(self.Branches)
+}
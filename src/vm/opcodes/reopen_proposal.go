@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// ReopenProposal reopens the closed proposal with the given number at the code hosting platform.
+type ReopenProposal struct {
+	ProposalNumber          int
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ReopenProposal) CreateAutomaticUndoError() error {
+	return fmt.Errorf(messages.ProposalReopenProblem, self.ProposalNumber)
+}
+
+func (self *ReopenProposal) Run(args shared.RunArgs) error {
+	if connector, hasConnector := args.Connector.Get(); hasConnector {
+		return connector.ReopenProposal(self.ProposalNumber)
+	}
+	return hostingdomain.UnsupportedServiceError()
+}
+
+func (self *ReopenProposal) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
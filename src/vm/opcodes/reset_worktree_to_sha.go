@@ -0,0 +1,41 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// ResetWorktreeToSHA hard-resets the given branch, which is checked out in another worktree, to the given SHA,
+// but only if it currently has a particular SHA and the worktree has no uncommitted changes.
+type ResetWorktreeToSHA struct {
+	Branch                  gitdomain.LocalBranchName
+	MustHaveSHA             gitdomain.SHA
+	SetToSHA                gitdomain.SHA
+	Worktree                string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ResetWorktreeToSHA) Run(args shared.RunArgs) error {
+	currentSHA, err := args.Git.SHAForBranch(args.Backend, self.Branch.BranchName())
+	if err != nil {
+		return err
+	}
+	if currentSHA == self.SetToSHA {
+		// nothing to do
+		return nil
+	}
+	if currentSHA != self.MustHaveSHA {
+		return fmt.Errorf(messages.BranchHasWrongSHA, self.Branch, self.SetToSHA, self.MustHaveSHA, currentSHA)
+	}
+	hasOpenChanges, err := args.Git.HasOpenChangesInWorktree(args.Backend, self.Worktree)
+	if err != nil {
+		return err
+	}
+	if hasOpenChanges {
+		return fmt.Errorf(messages.UndoWorktreeOpenChanges, self.Branch, self.Worktree)
+	}
+	return args.Git.ResetWorktreeToSHA(args.Frontend, self.Worktree, self.SetToSHA)
+}
@@ -0,0 +1,46 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// RunHook runs the shell command that the user has configured for the given lifecycle hook.
+// It provides details about the current operation to the shell command via environment variables.
+// It looks up the shell command when it runs so that "git town continue" uses the updated command
+// after the user fixed a broken hook.
+type RunHook struct {
+	Branch                  gitdomain.LocalBranchName
+	Command                 string // the Git Town command that runs this hook
+	Hook                    configdomain.Hook
+	Parent                  Option[gitdomain.LocalBranchName]
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RunHook) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *RunHook) Run(args shared.RunArgs) error {
+	script, hasScript := args.Config.Config.Hooks.Command(self.Hook).Get()
+	if !hasScript {
+		return nil
+	}
+	env := []string{
+		"GIT_TOWN_BRANCH=" + self.Branch.String(),
+		"GIT_TOWN_COMMAND=" + self.Command,
+		"GIT_TOWN_HOOK=" + self.Hook.String(),
+		"GIT_TOWN_PARENT=" + self.Parent.GetOrDefault().String(),
+	}
+	if err := args.Frontend.RunWithEnv(env, "sh", "-c", script); err != nil {
+		return fmt.Errorf(messages.HookFailed, self.Hook, err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/config/configdomain"
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// SyncBranchInWorktree syncs the given feature branch, which is checked out in another worktree, in place in that worktree.
+// It leaves the branch alone if that worktree has uncommitted changes or if syncing the branch causes conflicts.
+type SyncBranchInWorktree struct {
+	Branch                  gitdomain.LocalBranchName
+	PushBranch              bool
+	SyncStrategy            configdomain.SyncFeatureStrategy
+	TrackingBranch          Option[gitdomain.RemoteBranchName]
+	Worktree                string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *SyncBranchInWorktree) Run(args shared.RunArgs) error {
+	hasOpenChanges, err := args.Git.HasOpenChangesInWorktree(args.Backend, self.Worktree)
+	if err != nil {
+		return err
+	}
+	if hasOpenChanges {
+		args.FinalMessages.Add(fmt.Sprintf(messages.SyncWorktreeOpenChanges, self.Branch, self.Worktree))
+		return nil
+	}
+	switch self.SyncStrategy {
+	case configdomain.SyncFeatureStrategyMerge:
+		return self.syncMerge(args)
+	case configdomain.SyncFeatureStrategyRebase:
+		return self.syncRebase(args)
+	}
+	return nil
+}
+
+// mergeOrAbort merges the given branch into the branch in the worktree and aborts the merge if it causes conflicts.
+func (self *SyncBranchInWorktree) mergeOrAbort(args shared.RunArgs, branch gitdomain.BranchName) bool {
+	if err := args.Git.MergeBranchNoEditInWorktree(args.Frontend, self.Worktree, branch); err != nil {
+		_ = args.Git.AbortMergeInWorktree(args.Frontend, self.Worktree)
+		args.FinalMessages.Add(fmt.Sprintf(messages.SyncWorktreeConflict, self.Branch, self.Worktree))
+		return false
+	}
+	return true
+}
+
+// rebaseOrAbort rebases the branch in the worktree against the given branch and aborts the rebase if it causes conflicts.
+func (self *SyncBranchInWorktree) rebaseOrAbort(args shared.RunArgs, branch gitdomain.BranchName) bool {
+	if err := args.Git.RebaseInWorktree(args.Frontend, self.Worktree, branch); err != nil {
+		_ = args.Git.AbortRebaseInWorktree(args.Frontend, self.Worktree)
+		args.FinalMessages.Add(fmt.Sprintf(messages.SyncWorktreeConflict, self.Branch, self.Worktree))
+		return false
+	}
+	return true
+}
+
+func (self *SyncBranchInWorktree) syncMerge(args shared.RunArgs) error {
+	trackingBranch, hasTrackingBranch := self.TrackingBranch.Get()
+	if hasTrackingBranch && !self.mergeOrAbort(args, trackingBranch.BranchName()) {
+		return nil
+	}
+	if parent, hasParent := args.Config.Config.Lineage.Parent(self.Branch).Get(); hasParent {
+		if !self.mergeOrAbort(args, parent.BranchName()) {
+			return nil
+		}
+	}
+	if !self.PushBranch || !hasTrackingBranch {
+		return nil
+	}
+	return args.Git.PushBranchInWorktree(args.Frontend, self.Worktree, args.Config.Config.NoPushHook())
+}
+
+func (self *SyncBranchInWorktree) syncRebase(args shared.RunArgs) error {
+	if parent, hasParent := args.Config.Config.Lineage.Parent(self.Branch).Get(); hasParent {
+		if !self.rebaseOrAbort(args, parent.BranchName()) {
+			return nil
+		}
+	}
+	trackingBranch, hasTrackingBranch := self.TrackingBranch.Get()
+	if !self.PushBranch || !hasTrackingBranch {
+		return nil
+	}
+	// the force-push succeeds if the tracking branch doesn't contain new commits
+	if err := args.Git.ForcePushBranchSafelyInWorktree(args.Frontend, self.Worktree, args.Config.Config.NoPushHook()); err == nil {
+		return nil
+	}
+	if !self.rebaseOrAbort(args, trackingBranch.BranchName()) {
+		return nil
+	}
+	return args.Git.ForcePushBranchSafelyInWorktree(args.Frontend, self.Worktree, args.Config.Config.NoPushHook())
+}
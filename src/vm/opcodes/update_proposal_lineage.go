@@ -0,0 +1,68 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/gohacks/slice"
+	"github.com/git-town/git-town/v14/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// UpdateProposalLineage updates the section listing the branch stack
+// in the bodies of the proposals for the stacks of the given branches.
+// Problems talking to the hosting platform only print a warning
+// because the stack section is informational.
+type UpdateProposalLineage struct {
+	Branches                gitdomain.LocalBranchNames
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *UpdateProposalLineage) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	if args.Config.DryRun {
+		return nil
+	}
+	lineage := args.Config.Config.Lineage
+	// the proposals of these branches display an outdated stack
+	branchesToUpdate := gitdomain.LocalBranchNames{}
+	// the proposals of these branches are linked from the stack sections
+	branchesInStacks := gitdomain.LocalBranchNames{}
+	for _, branch := range self.Branches {
+		if lineage.Parent(branch).IsNone() {
+			continue
+		}
+		branchesToUpdate = slice.AppendAllMissing(branchesToUpdate, lineage.BranchLineage(branch)...)
+		root := lineage.Ancestors(branch)[0]
+		branchesInStacks = slice.AppendAllMissing(branchesInStacks, lineage.BranchLineage(root)...)
+	}
+	if len(branchesToUpdate) == 0 {
+		return nil
+	}
+	// the stack sections only need the numbers and bodies of the proposals, not their review and CI state
+	proposals, err := connector.ListProposals(branchesInStacks)
+	if err != nil {
+		args.FinalMessages.Add(fmt.Sprintf(messages.ProposalLineageUpdateProblem, err))
+		return nil
+	}
+	for _, branch := range branchesToUpdate {
+		proposal, hasProposal := proposals[branch]
+		if !hasProposal {
+			continue
+		}
+		section := hostingdomain.LineageSection(lineage, branch, proposals)
+		body := hostingdomain.UpdateLineageSection(proposal.Body, section)
+		if body == proposal.Body {
+			continue
+		}
+		if err = connector.UpdateProposalBody(proposal.Number, body); err != nil {
+			args.FinalMessages.Add(fmt.Sprintf(messages.ProposalLineageUpdateProblem, err))
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package program
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/gohacks"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/opcodes"
+	"github.com/git-town/git-town/v14/src/vm/shared"
+)
+
+// Plan provides a human-readable description of this program
+// in which the opcodes are grouped by the branch on which they run.
+func (self Program) Plan(initialBranch gitdomain.LocalBranchName) string {
+	sb := strings.Builder{}
+	if self.IsEmpty() {
+		sb.WriteString("(empty program)\n")
+		return sb.String()
+	}
+	currentBranch := initialBranch
+	printedBranch := None[gitdomain.LocalBranchName]()
+	step := 0
+	for _, opcode := range self {
+		if branch, isCheckout := checkedOutBranch(opcode); isCheckout {
+			currentBranch = branch
+		}
+		if _, isEndOfBranch := opcode.(*opcodes.EndOfBranchProgram); isEndOfBranch {
+			continue
+		}
+		if !printedBranch.Equal(Some(currentBranch)) {
+			if printedBranch.IsSome() {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(currentBranch.String() + "\n")
+			printedBranch = Some(currentBranch)
+		}
+		step++
+		sb.WriteString(fmt.Sprintf("  %d. %s\n", step, planStep(opcode)))
+	}
+	return sb.String()
+}
+
+// checkedOutBranch provides the branch that the given opcode checks out, if it checks out a branch.
+func checkedOutBranch(opcode shared.Opcode) (gitdomain.LocalBranchName, bool) {
+	switch opcode := opcode.(type) {
+	case *opcodes.Checkout:
+		return opcode.Branch, true
+	case *opcodes.CheckoutIfExists:
+		return opcode.Branch, true
+	case *opcodes.CreateAndCheckoutBranchExistingParent:
+		return opcode.Branch, true
+	}
+	return "", false
+}
+
+// planStep provides the human-readable description of the given opcode,
+// consisting of its name and its populated fields.
+func planStep(opcode shared.Opcode) string {
+	result := gohacks.TypeName(opcode)
+	value := reflect.Indirect(reflect.ValueOf(opcode))
+	if value.Kind() != reflect.Struct {
+		return result
+	}
+	for f := 0; f < value.NumField(); f++ {
+		field := value.Type().Field(f)
+		fieldValue := value.Field(f)
+		if !field.IsExported() || fieldValue.IsZero() {
+			continue
+		}
+		result += fmt.Sprintf(" %s=%s", field.Name, planValue(fieldValue))
+	}
+	return result
+}
+
+// planValue provides the human-readable serialization of the given opcode field value.
+// Options serialize the pointer to their content, hence this unwraps them using their Get method.
+func planValue(value reflect.Value) string {
+	if get := value.MethodByName("Get"); get.IsValid() && get.Type().NumIn() == 0 && get.Type().NumOut() == 2 {
+		results := get.Call(nil)
+		if !results[1].Bool() {
+			return ""
+		}
+		value = results[0]
+	}
+	text := fmt.Sprint(value.Interface())
+	if strings.ContainsAny(text, " \t\n") {
+		return fmt.Sprintf("%q", text)
+	}
+	return text
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
 	"github.com/git-town/git-town/v14/src/vm/opcodes"
 	"github.com/git-town/git-town/v14/src/vm/program"
 	"github.com/git-town/git-town/v14/src/vm/shared"
@@ -117,6 +118,83 @@ func TestProgram(t *testing.T) {
 		})
 	})
 
+	t.Run("Plan", func(t *testing.T) {
+		t.Parallel()
+		t.Run("populated program", func(t *testing.T) {
+			t.Parallel()
+			give := program.Program{
+				&opcodes.FetchUpstream{Branch: gitdomain.NewLocalBranchName("main")},
+				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("feature")},
+				&opcodes.MergeParent{CurrentBranch: gitdomain.NewLocalBranchName("feature")},
+				&opcodes.CommitOpenChangesIfAny{Message: "make lint"},
+				&opcodes.EndOfBranchProgram{},
+				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
+			}
+			have := give.Plan(gitdomain.NewLocalBranchName("main"))
+			want := `
+main
+  1. FetchUpstream Branch=main
+
+feature
+  2. Checkout Branch=feature
+  3. MergeParent CurrentBranch=feature
+  4. CommitOpenChangesIfAny Message="make lint"
+
+main
+  5. Checkout Branch=main
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("opcodes with Option fields", func(t *testing.T) {
+			t.Parallel()
+			give := program.Program{
+				&opcodes.CreateProposal{
+					Body:       Some("my body"),
+					Branch:     gitdomain.NewLocalBranchName("feature"),
+					MainBranch: gitdomain.NewLocalBranchName("main"),
+					Title:      Some("title"),
+				},
+				&opcodes.SquashMerge{
+					Branch:        gitdomain.NewLocalBranchName("feature"),
+					CommitMessage: Some(gitdomain.CommitMessage("squashed")),
+					Parent:        gitdomain.NewLocalBranchName("main"),
+				},
+				&opcodes.MergeNoFastForward{
+					Branch:        gitdomain.NewLocalBranchName("feature"),
+					CommitMessage: None[gitdomain.CommitMessage](),
+				},
+			}
+			have := give.Plan(gitdomain.NewLocalBranchName("main"))
+			want := `
+main
+  1. CreateProposal Body="my body" Branch=feature MainBranch=main Title=title
+  2. SquashMerge Branch=feature CommitMessage=squashed Parent=main
+  3. MergeNoFastForward Branch=feature
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("program starts by checking out another branch", func(t *testing.T) {
+			t.Parallel()
+			give := program.Program{
+				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
+				&opcodes.PushCurrentBranch{CurrentBranch: gitdomain.NewLocalBranchName("main")},
+			}
+			have := give.Plan(gitdomain.NewLocalBranchName("feature"))
+			want := `
+main
+  1. Checkout Branch=main
+  2. PushCurrentBranch CurrentBranch=main
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("empty program", func(t *testing.T) {
+			t.Parallel()
+			give := program.Program{}
+			have := give.Plan(gitdomain.NewLocalBranchName("main"))
+			must.EqOp(t, "(empty program)\n", have)
+		})
+	})
+
 	t.Run("Pop", func(t *testing.T) {
 		t.Parallel()
 		t.Run("populated list", func(t *testing.T) {
@@ -0,0 +1,56 @@
+package runstate
+
+import (
+	"time"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+)
+
+// HistoryMaxLength defines how many finished Git Town commands the undo history remembers per repository.
+const HistoryMaxLength = 10
+
+// History contains the most recently finished Git Town commands in a repository,
+// ordered from oldest to newest.
+type History []HistoryEntry
+
+// HistoryEntry describes a Git Town command that finished successfully.
+type HistoryEntry struct {
+	EndTime  time.Time
+	RunState RunState
+}
+
+// Add provides a copy of this History that contains the given entry as the newest one.
+// The oldest entries get dropped so that the history contains at most HistoryMaxLength entries.
+func (self History) Add(entry HistoryEntry) History {
+	result := append(History{}, self...)
+	result = append(result, entry)
+	if len(result) > HistoryMaxLength {
+		result = result[len(result)-HistoryMaxLength:]
+	}
+	return result
+}
+
+// Newest provides the most recently finished command in this History.
+func (self History) Newest() Option[HistoryEntry] {
+	if len(self) == 0 {
+		return None[HistoryEntry]()
+	}
+	return Some(self[len(self)-1])
+}
+
+// NewestFirst provides the entries of this History from newest to oldest.
+func (self History) NewestFirst() History {
+	result := make(History, len(self))
+	for e, entry := range self {
+		result[len(self)-1-e] = entry
+	}
+	return result
+}
+
+// RemoveNewest provides a copy of this History without the most recently finished command.
+func (self History) RemoveNewest() History {
+	if len(self) == 0 {
+		return History{}
+	}
+	return append(History{}, self[:len(self)-1]...)
+}
@@ -0,0 +1,88 @@
+package runstate_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	newEntry := func(command string) runstate.HistoryEntry {
+		runState := runstate.EmptyRunState()
+		runState.Command = command
+		return runstate.HistoryEntry{
+			EndTime:  time.Time{},
+			RunState: runState,
+		}
+	}
+
+	commands := func(history runstate.History) []string {
+		result := []string{}
+		for _, entry := range history {
+			result = append(result, entry.RunState.Command)
+		}
+		return result
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		t.Run("within the limit", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack")}
+			have := history.Add(newEntry("sync"))
+			must.Eq(t, []string{"hack", "sync"}, commands(have))
+			must.Eq(t, []string{"hack"}, commands(history))
+		})
+		t.Run("drops the oldest entries", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			for e := 0; e < runstate.HistoryMaxLength; e++ {
+				history = history.Add(newEntry("sync"))
+			}
+			have := history.Add(newEntry("ship"))
+			must.Len(t, runstate.HistoryMaxLength, have)
+			must.EqOp(t, "ship", have[runstate.HistoryMaxLength-1].RunState.Command)
+		})
+	})
+
+	t.Run("Newest", func(t *testing.T) {
+		t.Parallel()
+		t.Run("has entries", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack"), newEntry("sync")}
+			newest, hasNewest := history.Newest().Get()
+			must.True(t, hasNewest)
+			must.EqOp(t, "sync", newest.RunState.Command)
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			must.Eq(t, None[runstate.HistoryEntry](), history.Newest())
+		})
+	})
+
+	t.Run("NewestFirst", func(t *testing.T) {
+		t.Parallel()
+		history := runstate.History{newEntry("hack"), newEntry("sync"), newEntry("ship")}
+		must.Eq(t, []string{"ship", "sync", "hack"}, commands(history.NewestFirst()))
+	})
+
+	t.Run("RemoveNewest", func(t *testing.T) {
+		t.Parallel()
+		t.Run("has entries", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack"), newEntry("sync")}
+			must.Eq(t, []string{"hack"}, commands(history.RemoveNewest()))
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			must.Len(t, 0, history.RemoveNewest())
+		})
+	})
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/git-town/git-town/v14/src/vm/shared"
 )
 
+// WalkCommand is the name of the command that runs a shell command on every branch of a stack.
+// Failed walk commands can be skipped.
+const WalkCommand = "walk"
+
 // RunState represents the current state of a Git Town command,
 // including which operations are left to do,
 // and how to undo what has been done so far.
@@ -22,6 +26,7 @@ type RunState struct {
 	BeginConfigSnapshot      undoconfig.ConfigSnapshot
 	BeginStashSize           gitdomain.StashSize
 	Command                  string
+	CreatedProposals         []int `exhaustruct:"optional"`
 	DryRun                   bool
 	EndBranchesSnapshot      Option[gitdomain.BranchesSnapshot]
 	EndConfigSnapshot        Option[undoconfig.ConfigSnapshot]
@@ -89,6 +94,13 @@ func (self *RunState) MarkAsUnfinished(gitCommands git.Commands, backend gitdoma
 	return nil
 }
 
+// RegisterCreatedProposal stores the number of a proposal that this command has created,
+// so that undo can close it again.
+// This method is used as a callback.
+func (self *RunState) RegisterCreatedProposal(number int) {
+	self.CreatedProposals = append(self.CreatedProposals, number)
+}
+
 // RegisterUndoablePerennialCommit stores the given commit on a perennial branch as undoable.
 // This method is used as a callback.
 func (self *RunState) RegisterUndoablePerennialCommit(commit gitdomain.SHA) {
@@ -19,8 +19,9 @@ func TestRunState(t *testing.T) {
 	t.Run("Marshal and Unmarshal", func(t *testing.T) {
 		t.Parallel()
 		runState := &runstate.RunState{
-			Command: "sync",
-			DryRun:  true,
+			Command:          "sync",
+			CreatedProposals: []int{},
+			DryRun:           true,
 			AbortProgram: program.Program{
 				&opcodes.ResetCurrentBranchToSHA{
 					MustHaveSHA: gitdomain.NewSHA("222222"),
@@ -53,6 +54,7 @@ func TestRunState(t *testing.T) {
 						SyncStatus: gitdomain.SyncStatusLocalOnly,
 					},
 				},
+				Worktrees: gitdomain.Worktrees{},
 			}),
 			EndConfigSnapshot:        None[undoconfig.ConfigSnapshot](),
 			EndStashSize:             Some(gitdomain.StashSize(1)),
@@ -77,7 +79,8 @@ func TestRunState(t *testing.T) {
   ],
   "BeginBranchesSnapshot": {
     "Active": null,
-    "Branches": []
+    "Branches": [],
+    "Worktrees": []
   },
   "BeginConfigSnapshot": {
     "Global": {},
@@ -85,6 +88,7 @@ func TestRunState(t *testing.T) {
   },
   "BeginStashSize": 0,
   "Command": "sync",
+  "CreatedProposals": [],
   "DryRun": true,
   "EndBranchesSnapshot": {
     "Active": "branch-1",
@@ -103,7 +107,8 @@ func TestRunState(t *testing.T) {
         "RemoteSHA": null,
         "SyncStatus": "local only"
       }
-    ]
+    ],
+    "Worktrees": []
   },
   "EndConfigSnapshot": null,
   "EndStashSize": 1,
@@ -11,6 +11,7 @@ import (
 )
 
 type RunArgs struct {
+	AddPendingPush                  func(gitdomain.LocalBranchName) error
 	Backend                         gitdomain.RunnerQuerier
 	Config                          config.ValidatedConfig
 	Connector                       Option[hostingdomain.Connector]
@@ -19,6 +20,8 @@ type RunArgs struct {
 	Frontend                        gitdomain.Runner
 	Git                             git.Commands
 	PrependOpcodes                  func(...Opcode)
+	RegisterCreatedProposal         func(int)
 	RegisterUndoablePerennialCommit func(gitdomain.SHA)
+	RemovePendingPushes             func(gitdomain.LocalBranchNames) error
 	UpdateInitialBranchLocalSHA     func(gitdomain.LocalBranchName, gitdomain.SHA) error
 }
@@ -0,0 +1,120 @@
+package statefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+)
+
+// HistoryFilePath provides the path of the file that stores the undo history for the given Git repo.
+func HistoryFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	runstatePath, err := FilePath(repoDir)
+	if err != nil {
+		return "", err
+	}
+	return runstatePath[:len(runstatePath)-len(filepath.Ext(runstatePath))] + "-history.json", nil
+}
+
+// LoadHistory loads the undo history for the given Git repo from disk.
+// Returns an empty History if there is no saved history.
+func LoadHistory(repoDir gitdomain.RepoRootDir) (runstate.History, error) {
+	filename, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return runstate.History{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return runstate.History{}, nil
+		}
+		return runstate.History{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var history runstate.History
+	err = json.Unmarshal(content, &history)
+	if err != nil {
+		return runstate.History{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return history, nil
+}
+
+// SaveHistory stores the given undo history for the given Git repo to disk.
+func SaveHistory(history runstate.History, repoDir gitdomain.RepoRootDir) error {
+	content, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
+	filename, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(filename), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filename, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, filename, err)
+	}
+	return nil
+}
+
+// RemoveNewestFromHistory removes the most recently finished command from the undo history of the given Git repo.
+func RemoveNewestFromHistory(repoDir gitdomain.RepoRootDir) error {
+	history, err := LoadHistory(repoDir)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return nil
+	}
+	return SaveHistory(history.RemoveNewest(), repoDir)
+}
+
+// Undoable provides the commands that "git town undo" can undo, from newest to oldest.
+// An unfinished command comes first, followed by the finished commands in the undo history.
+// Dry runs don't change the repo and are therefore not undoable.
+func Undoable(repoDir gitdomain.RepoRootDir) ([]runstate.HistoryEntry, error) {
+	result := []runstate.HistoryEntry{}
+	currentOpt, err := Load(repoDir)
+	if err != nil {
+		return result, err
+	}
+	current, hasCurrent := currentOpt.Get()
+	currentEntry := runstate.HistoryEntry{
+		EndTime:  time.Time{},
+		RunState: current,
+	}
+	if unfinishedDetails, isUnfinished := current.UnfinishedDetails.Get(); hasCurrent && isUnfinished {
+		currentEntry.EndTime = unfinishedDetails.EndTime
+	}
+	if hasCurrent && !current.IsFinished() && !current.DryRun {
+		result = append(result, currentEntry)
+	}
+	history, err := LoadHistory(repoDir)
+	if err != nil {
+		return result, err
+	}
+	result = append(result, history.NewestFirst()...)
+	if hasCurrent && current.IsFinished() && !current.DryRun && len(history) == 0 {
+		// run states stored before Git Town kept an undo history
+		result = append(result, currentEntry)
+	}
+	return result, nil
+}
+
+func addToHistory(runState runstate.RunState, repoDir gitdomain.RepoRootDir) error {
+	history, err := LoadHistory(repoDir)
+	if err != nil {
+		return err
+	}
+	return SaveHistory(history.Add(runstate.HistoryEntry{
+		EndTime:  time.Now(),
+		RunState: runState,
+	}), repoDir)
+}
@@ -0,0 +1,47 @@
+package statefile_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/runstate"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Save adds finished commands to the history", func(t *testing.T) {
+		t.Parallel()
+		repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-history")
+		historyPath, err := statefile.HistoryFilePath(repoRoot)
+		must.NoError(t, err)
+		_ = os.Remove(historyPath)
+		for _, command := range []string{"hack", "sync"} {
+			runState := runstate.EmptyRunState()
+			runState.Command = command
+			must.NoError(t, statefile.Save(runState, repoRoot))
+		}
+		dryRun := runstate.EmptyRunState()
+		dryRun.Command = "ship"
+		dryRun.DryRun = true
+		must.NoError(t, statefile.Save(dryRun, repoRoot))
+		history, err := statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 2, history)
+		undoable, err := statefile.Undoable(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 2, undoable)
+		must.EqOp(t, "sync", undoable[0].RunState.Command)
+		must.EqOp(t, "hack", undoable[1].RunState.Command)
+		must.NoError(t, statefile.RemoveNewestFromHistory(repoRoot))
+		history, err = statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 1, history)
+		must.EqOp(t, "hack", history[0].RunState.Command)
+		must.NoError(t, statefile.Delete(repoRoot))
+		must.NoError(t, os.Remove(historyPath))
+	})
+}
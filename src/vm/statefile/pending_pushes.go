@@ -0,0 +1,89 @@
+package statefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/messages"
+)
+
+// PendingPushesFilePath provides the path of the file that stores the pending pushes for the given Git repo.
+func PendingPushesFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	runstatePath, err := FilePath(repoDir)
+	if err != nil {
+		return "", err
+	}
+	return runstatePath[:len(runstatePath)-len(filepath.Ext(runstatePath))] + "-pending-pushes.json", nil
+}
+
+// LoadPendingPushes provides the branches whose pushes got skipped
+// because the origin remote was unreachable while syncing them.
+func LoadPendingPushes(repoDir gitdomain.RepoRootDir) (gitdomain.LocalBranchNames, error) {
+	filename, err := PendingPushesFilePath(repoDir)
+	if err != nil {
+		return gitdomain.LocalBranchNames{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return gitdomain.LocalBranchNames{}, nil
+		}
+		return gitdomain.LocalBranchNames{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var pendingPushes gitdomain.LocalBranchNames
+	err = json.Unmarshal(content, &pendingPushes)
+	if err != nil {
+		return gitdomain.LocalBranchNames{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return pendingPushes, nil
+}
+
+// SavePendingPushes stores the given pending pushes for the given Git repo to disk.
+// Removes the file if there are no pending pushes.
+func SavePendingPushes(pendingPushes gitdomain.LocalBranchNames, repoDir gitdomain.RepoRootDir) error {
+	filename, err := PendingPushesFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	if len(pendingPushes) == 0 {
+		err = os.Remove(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf(messages.FileDeleteProblem, filename, err)
+		}
+		return nil
+	}
+	content, err := json.MarshalIndent(pendingPushes, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
+	err = os.MkdirAll(filepath.Dir(filename), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filename, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, filename, err)
+	}
+	return nil
+}
+
+// AddPendingPush remembers that the given branch needs to be pushed once the origin remote is reachable again.
+func AddPendingPush(repoDir gitdomain.RepoRootDir, branch gitdomain.LocalBranchName) error {
+	pendingPushes, err := LoadPendingPushes(repoDir)
+	if err != nil {
+		return err
+	}
+	return SavePendingPushes(pendingPushes.AppendAllMissing(branch), repoDir)
+}
+
+// RemovePendingPushes forgets the pending pushes of the given branches.
+func RemovePendingPushes(repoDir gitdomain.RepoRootDir, branches gitdomain.LocalBranchNames) error {
+	pendingPushes, err := LoadPendingPushes(repoDir)
+	if err != nil {
+		return err
+	}
+	return SavePendingPushes(pendingPushes.Remove(branches...), repoDir)
+}
@@ -0,0 +1,33 @@
+package statefile_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/git-town/git-town/v14/src/git/gitdomain"
+	"github.com/git-town/git-town/v14/src/vm/statefile"
+	"github.com/shoenig/test/must"
+)
+
+func TestPendingPushes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Save and Load", func(t *testing.T) {
+		t.Parallel()
+		repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-pending-pushes")
+		pendingPushesPath, err := statefile.PendingPushesFilePath(repoRoot)
+		must.NoError(t, err)
+		_ = os.Remove(pendingPushesPath)
+		have, err := statefile.LoadPendingPushes(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 0, have)
+		want := gitdomain.NewLocalBranchNames("alpha", "beta")
+		must.NoError(t, statefile.SavePendingPushes(want, repoRoot))
+		have, err = statefile.LoadPendingPushes(repoRoot)
+		must.NoError(t, err)
+		must.Eq(t, want, have)
+		must.NoError(t, statefile.SavePendingPushes(gitdomain.LocalBranchNames{}, repoRoot))
+		_, err = os.Stat(pendingPushesPath)
+		must.True(t, os.IsNotExist(err))
+	})
+}
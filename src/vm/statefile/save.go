@@ -12,6 +12,7 @@ import (
 )
 
 // Save stores the given run state for the given Git repo to disk.
+// Run states of commands that finished also go into the undo history.
 func Save(runState runstate.RunState, repoDir gitdomain.RepoRootDir) error {
 	content, err := json.MarshalIndent(runState, "", "  ")
 	if err != nil {
@@ -30,5 +31,8 @@ func Save(runState runstate.RunState, repoDir gitdomain.RepoRootDir) error {
 	if err != nil {
 		return fmt.Errorf(messages.FileWriteProblem, persistencePath, err)
 	}
+	if runState.IsFinished() && !runState.DryRun {
+		return addToHistory(runState, repoDir)
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/git-town/git-town/v14/src/config/configdomain"
 	"github.com/git-town/git-town/v14/src/config/gitconfig"
 	"github.com/git-town/git-town/v14/src/git/gitdomain"
 	. "github.com/git-town/git-town/v14/src/gohacks/prelude"
@@ -41,6 +42,7 @@ func TestLoadSave(t *testing.T) {
 			BeginConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
 			BeginStashSize:        0,
 			Command:               "command",
+			CreatedProposals:      []int{12},
 			DryRun:                true,
 			EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
 			EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
@@ -54,13 +56,19 @@ func TestLoadSave(t *testing.T) {
 					Parent: gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("branch")},
+				&opcodes.CloseProposal{ProposalNumber: 123},
 				&opcodes.CommitOpenChanges{},
+				&opcodes.CommitOpenChangesIfAny{Message: "commit message"},
 				&opcodes.ConnectorMergeProposal{
 					Branch:          gitdomain.NewLocalBranchName("branch"),
 					CommitMessage:   Some(gitdomain.CommitMessage("commit message")),
 					ProposalMessage: "proposal message",
 					ProposalNumber:  123,
 				},
+				&opcodes.ConnectorMergeProposalWithMergeCommit{
+					CommitMessage:  Some(gitdomain.CommitMessage("commit message")),
+					ProposalNumber: 123,
+				},
 				&opcodes.ContinueMerge{},
 				&opcodes.ContinueRebase{},
 				&opcodes.CreateBranch{
@@ -68,11 +76,18 @@ func TestLoadSave(t *testing.T) {
 					StartingPoint: gitdomain.NewSHA("123456").Location(),
 				},
 				&opcodes.CreateProposal{
-					Branch:     gitdomain.NewLocalBranchName("branch"),
-					MainBranch: gitdomain.NewLocalBranchName("main"),
+					Body:          Some("body"),
+					Branch:        gitdomain.NewLocalBranchName("branch"),
+					Draft:         true,
+					MainBranch:    gitdomain.NewLocalBranchName("main"),
+					NoBrowser:     true,
+					OnlyIfMissing: true,
+					Title:         Some("title"),
+					ViaAPI:        true,
 				},
 				&opcodes.CreateRemoteBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
+					Remote: gitdomain.RemoteOrigin,
 					SHA:    gitdomain.NewSHA("123456"),
 				},
 				&opcodes.CreateTrackingBranch{
@@ -91,11 +106,20 @@ func TestLoadSave(t *testing.T) {
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					Parent: gitdomain.NewLocalBranchName("parent"),
 				},
+				&opcodes.ExecuteShellCommand{Command: "echo hello"},
 				&opcodes.FetchUpstream{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
 				&opcodes.ForcePushCurrentBranch{},
 				&opcodes.Merge{Branch: gitdomain.NewBranchName("branch")},
+				&opcodes.MergeFastForward{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+					Parent: gitdomain.NewLocalBranchName("parent"),
+				},
+				&opcodes.MergeNoFastForward{
+					Branch:        gitdomain.NewLocalBranchName("branch"),
+					CommitMessage: Some(gitdomain.CommitMessage("commit message")),
+				},
 				&opcodes.MergeParent{
 					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
 					ParentActiveInOtherWorktree: true,
@@ -108,6 +132,7 @@ func TestLoadSave(t *testing.T) {
 					CurrentBranch: gitdomain.NewLocalBranchName("branch"),
 				},
 				&opcodes.PushTags{},
+				&opcodes.QueuePendingPush{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.RebaseBranch{Branch: gitdomain.NewBranchName("branch")},
 				&opcodes.RebaseParent{
 					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
@@ -116,15 +141,23 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.RebaseFeatureTrackingBranch{
 					RemoteBranch: gitdomain.NewRemoteBranchName("origin/branch"),
 				},
+				&opcodes.RebaseOnto{
+					BranchToRebaseOnto: gitdomain.NewBranchName("new-parent"),
+					CommitsToRemove:    gitdomain.NewBranchName("old-parent"),
+				},
 				&opcodes.RemoveFromPerennialBranches{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.RemoveFromPrototypeBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.RemoveGlobalConfig{
 					Key: gitconfig.KeyOffline,
 				},
 				&opcodes.RemoveLocalConfig{
 					Key: gitconfig.KeyOffline,
 				},
+				&opcodes.RemovePendingPushes{Branches: gitdomain.NewLocalBranchNames("branch")},
 				&opcodes.ResetCurrentBranchToSHA{
 					Hard:        true,
 					MustHaveSHA: gitdomain.NewSHA("222222"),
@@ -134,6 +167,12 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.RevertCommit{
 					SHA: gitdomain.NewSHA("123456"),
 				},
+				&opcodes.RunHook{
+					Branch:  gitdomain.NewLocalBranchName("branch"),
+					Command: "sync",
+					Hook:    configdomain.HookPostSyncBranch,
+					Parent:  Some(gitdomain.NewLocalBranchName("main")),
+				},
 				&opcodes.SetGlobalConfig{
 					Key:   gitconfig.KeyOffline,
 					Value: "1",
@@ -157,6 +196,9 @@ func TestLoadSave(t *testing.T) {
 					Parent:        gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.StashOpenChanges{},
+				&opcodes.UpdateProposalLineage{
+					Branches: gitdomain.NewLocalBranchNames("branch-1", "branch-2"),
+				},
 				&opcodes.UpdateProposalTarget{
 					ProposalNumber: 123,
 					NewTarget:      gitdomain.NewLocalBranchName("new-target"),
@@ -175,7 +217,8 @@ func TestLoadSave(t *testing.T) {
   "AbortProgram": [],
   "BeginBranchesSnapshot": {
     "Active": null,
-    "Branches": []
+    "Branches": [],
+    "Worktrees": []
   },
   "BeginConfigSnapshot": {
     "Global": {},
@@ -183,6 +226,9 @@ func TestLoadSave(t *testing.T) {
   },
   "BeginStashSize": 0,
   "Command": "command",
+  "CreatedProposals": [
+    12
+  ],
   "DryRun": true,
   "EndBranchesSnapshot": null,
   "EndConfigSnapshot": null,
@@ -216,10 +262,22 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "Checkout"
     },
+    {
+      "data": {
+        "ProposalNumber": 123
+      },
+      "type": "CloseProposal"
+    },
     {
       "data": {},
       "type": "CommitOpenChanges"
     },
+    {
+      "data": {
+        "Message": "commit message"
+      },
+      "type": "CommitOpenChangesIfAny"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -229,6 +287,13 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ConnectorMergeProposal"
     },
+    {
+      "data": {
+        "CommitMessage": "commit message",
+        "ProposalNumber": 123
+      },
+      "type": "ConnectorMergeProposalWithMergeCommit"
+    },
     {
       "data": {},
       "type": "ContinueMerge"
@@ -246,14 +311,21 @@ func TestLoadSave(t *testing.T) {
     },
     {
       "data": {
+        "Body": "body",
         "Branch": "branch",
-        "MainBranch": "main"
+        "Draft": true,
+        "MainBranch": "main",
+        "NoBrowser": true,
+        "OnlyIfMissing": true,
+        "Title": "title",
+        "ViaAPI": true
       },
       "type": "CreateProposal"
     },
     {
       "data": {
         "Branch": "branch",
+        "Remote": "origin",
         "SHA": "123456"
       },
       "type": "CreateRemoteBranch"
@@ -297,6 +369,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "EnsureHasShippableChanges"
     },
+    {
+      "data": {
+        "Command": "echo hello"
+      },
+      "type": "ExecuteShellCommand"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -313,6 +391,20 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "Merge"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Parent": "parent"
+      },
+      "type": "MergeFastForward"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "CommitMessage": "commit message"
+      },
+      "type": "MergeNoFastForward"
+    },
     {
       "data": {
         "CurrentBranch": "branch",
@@ -342,6 +434,12 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "PushTags"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "QueuePendingPush"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -361,12 +459,25 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseFeatureTrackingBranch"
     },
+    {
+      "data": {
+        "BranchToRebaseOnto": "new-parent",
+        "CommitsToRemove": "old-parent"
+      },
+      "type": "RebaseOnto"
+    },
     {
       "data": {
         "Branch": "branch"
       },
       "type": "RemoveFromPerennialBranches"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromPrototypeBranches"
+    },
     {
       "data": {
         "Key": "git-town.offline"
@@ -379,6 +490,14 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RemoveLocalConfig"
     },
+    {
+      "data": {
+        "Branches": [
+          "branch"
+        ]
+      },
+      "type": "RemovePendingPushes"
+    },
     {
       "data": {
         "Hard": true,
@@ -397,6 +516,15 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RevertCommit"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Command": "sync",
+        "Hook": "post-sync-branch",
+        "Parent": "main"
+      },
+      "type": "RunHook"
+    },
     {
       "data": {
         "Key": "git-town.offline",
@@ -441,6 +569,15 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "StashOpenChanges"
     },
+    {
+      "data": {
+        "Branches": [
+          "branch-1",
+          "branch-2"
+        ]
+      },
+      "type": "UpdateProposalLineage"
+    },
     {
       "data": {
         "NewTarget": "new-target",
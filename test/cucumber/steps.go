@@ -141,6 +141,12 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^a prototype branch "([^"]+)"$`, func(branchText string) error {
+		branch := gitdomain.NewLocalBranchName(branchText)
+		state.fixture.DevRepo.CreatePrototypeBranches(branch)
+		return nil
+	})
+
 	suite.Step(`^a perennial branch "([^"]+)"$`, func(branchText string) error {
 		branch := gitdomain.NewLocalBranchName(branchText)
 		state.fixture.DevRepo.CreatePerennialBranches(branch)
@@ -196,6 +202,16 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^an uncommitted file in the other worktree$`, func() error {
+		state.uncommittedFileName = "uncommitted file"
+		state.uncommittedContent = "uncommitted content"
+		state.fixture.SecondWorktree.GetOrPanic().CreateFile(
+			state.uncommittedFileName,
+			state.uncommittedContent,
+		)
+		return nil
+	})
+
 	suite.Step(`^an uncommitted file with name "([^"]+)" and content "([^"]+)"$`, func(name, content string) error {
 		state.uncommittedFileName = name
 		state.uncommittedContent = content
@@ -262,6 +278,18 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is (?:now|still) a prototype branch`, func(name string) error {
+		branch := gitdomain.NewLocalBranchName(name)
+		if !state.fixture.DevRepo.Config.Config.IsPrototypeBranch(branch) {
+			return fmt.Errorf(
+				"branch %q isn't a prototype branch as expected.\nPrototype branches: %s",
+				branch,
+				strings.Join(state.fixture.DevRepo.Config.Config.PrototypeBranches.Strings(), ", "),
+			)
+		}
+		return nil
+	})
+
 	suite.Step(`^branch "([^"]+)" is (?:now|still) perennial`, func(name string) error {
 		branch := gitdomain.NewLocalBranchName(name)
 		if !state.fixture.DevRepo.Config.Config.IsPerennialBranch(branch) {
@@ -288,6 +316,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		if state.fixture.DevRepo.Config.Config.IsPerennialBranch(branch) {
 			return fmt.Errorf("branch %q is perennial", branch)
 		}
+		if state.fixture.DevRepo.Config.Config.IsPrototypeBranch(branch) {
+			return fmt.Errorf("branch %q is prototype", branch)
+		}
 		return nil
 	})
 
@@ -853,6 +884,55 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^local Git Town setting "azure-devops-token" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.AzureDevOpsToken.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "azure-devops-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-app-password" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketAppPassword.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-app-password" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-username" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketUsername.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-username" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "contribution-regex" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.ContributionRegex.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "contribution-regex" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "feature-branches" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.FeatureBranches
+		want := gitdomain.NewLocalBranchNames(strings.Split(wantStr, " ")...)
+		if cmp.Equal(have, want) {
+			return nil
+		}
+		return fmt.Errorf(`expected local setting "feature-branches" to be %q, but was %q`, want, have)
+	})
+
+	suite.Step(`^local Git Town setting "forgejo-token" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.ForgejoToken.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "forgejo-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "gitea-token" is now "([^"]*)"$`, func(want string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.GiteaToken.String()
 		if have != want {
@@ -909,6 +989,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^local Git Town setting "observed-regex" is now "([^"]*)"$`, func(want string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.ObservedRegex.String()
+		if have != want {
+			return fmt.Errorf(`expected local setting "observed-regex" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "perennial-branches" is now "([^"]*)"$`, func(wantStr string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.PerennialBranches
 		want := gitdomain.NewLocalBranchNames(strings.Split(wantStr, " ")...)
@@ -985,6 +1073,27 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^local Git Town setting "ship-strategy" is still not set$`, func() error {
+		have, has := state.fixture.DevRepo.Config.LocalGitConfig.ShipStrategy.Get()
+		if has {
+			return fmt.Errorf(`unexpected local setting "ship-strategy" %v`, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "ship-strategy" is now "([^"]*)"$`, func(wantStr string) error {
+		want, err := configdomain.NewShipStrategy(wantStr)
+		asserts.NoError(err)
+		have, has := state.fixture.DevRepo.Config.LocalGitConfig.ShipStrategy.Get()
+		if !has {
+			return fmt.Errorf(`expected local setting "ship-strategy" to be %v, but doesn't exist`, want)
+		}
+		if have != want {
+			return fmt.Errorf(`expected local setting "ship-strategy" to be %v, but was %v`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "sync-before-ship" is still not set$`, func() error {
 		have, has := state.fixture.DevRepo.Config.LocalGitConfig.SyncBeforeShip.Get()
 		if has {
@@ -1165,6 +1274,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^origin merges the "([^"]*)" branch into "([^"]*)"$`, func(branch, target string) error {
+		originRepo := state.fixture.OriginRepo.GetOrPanic()
+		originRepo.CheckoutBranch(gitdomain.NewLocalBranchName(target))
+		originRepo.MustRun("git", "merge", "--no-ff", "--no-edit", branch)
+		originRepo.CheckoutBranch(gitdomain.NewLocalBranchName("main"))
+		return nil
+	})
+
 	suite.Step(`^origin ships the "([^"]*)" branch$`, func(branch string) error {
 		originRepo := state.fixture.OriginRepo.GetOrPanic()
 		originRepo.CheckoutBranch(gitdomain.NewLocalBranchName("main"))
@@ -1323,7 +1440,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
+	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|prototype|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
 		branch := gitdomain.NewLocalBranchName(branchName)
 		isLocal := localStr != ""
 		switch configdomain.NewBranchType(branchType) {
@@ -1333,6 +1450,8 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 			state.fixture.DevRepo.CreatePerennialBranches(branch)
 		case configdomain.BranchTypeParkedBranch:
 			state.fixture.DevRepo.CreateParkedBranches(branch)
+		case configdomain.BranchTypePrototypeBranch:
+			state.fixture.DevRepo.CreatePrototypeBranches(branch)
 		case configdomain.BranchTypeContributionBranch:
 			state.fixture.DevRepo.CreateContributionBranches(branch)
 		case configdomain.BranchTypeObservedBranch:
@@ -1361,7 +1480,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^(contribution|feature|observed|parked) branch "([^"]*)" with these commits$`, func(branchTypeName, name string, table *messages.PickleStepArgument_PickleTable) error {
+	suite.Step(`^(contribution|feature|observed|parked|prototype) branch "([^"]*)" with these commits$`, func(branchTypeName, name string, table *messages.PickleStepArgument_PickleTable) error {
 		branchName := gitdomain.NewLocalBranchName(name)
 		switch configdomain.NewBranchType(branchTypeName) {
 		case configdomain.BranchTypeContributionBranch:
@@ -1372,6 +1491,8 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 			state.fixture.DevRepo.CreateObservedBranches(branchName)
 		case configdomain.BranchTypeParkedBranch:
 			state.fixture.DevRepo.CreateParkedBranches(branchName)
+		case configdomain.BranchTypePrototypeBranch:
+			state.fixture.DevRepo.CreatePrototypeBranches(branchName)
 		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 		state.fixture.DevRepo.CheckoutBranch(branchName)
@@ -1429,6 +1550,24 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the external hosting command responds with:$`, func(response *messages.PickleStepArgument_PickleDocString) error {
+		command := state.fixture.DevRepo.MockHostingCommand(response.Content)
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.KeyHostingExternalCommand, command)
+	})
+
+	suite.Step(`^the external hosting command responds to these requests:$`, func(table *messages.PickleStepArgument_PickleTable) error {
+		responses := []subshell.HostingCommandResponse{}
+		for _, row := range datatable.FromGherkin(table).Cells[1:] {
+			responses = append(responses, subshell.HostingCommandResponse{
+				Branch:    row[1],
+				Operation: row[0],
+				Response:  row[2],
+			})
+		}
+		command := state.fixture.DevRepo.MockHostingCommandResponses(responses)
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.KeyHostingExternalCommand, command)
+	})
+
 	suite.Step(`^the home directory contains file "([^"]+)" with content$`, func(filename string, docString *messages.PickleStepArgument_PickleDocString) error {
 		filePath := filepath.Join(state.fixture.DevRepo.HomeDir, filename)
 		//nolint:gosec // need permission 700 here in order for tests to work
@@ -1590,6 +1729,17 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the origin is reachable again$`, func() error {
+		state.fixture.DevRepo.MustRun("git", "remote", "set-url", gitdomain.RemoteOrigin.String(), state.fixture.OriginRepo.GetOrPanic().WorkingDir)
+		return nil
+	})
+
+	suite.Step(`^the origin is unreachable$`, func() error {
+		// pointing the origin remote to a non-existing location makes Git fail the same way as an offline network
+		state.fixture.DevRepo.MustRun("git", "remote", "set-url", gitdomain.RemoteOrigin.String(), state.fixture.OriginRepo.GetOrPanic().WorkingDir+"-unreachable")
+		return nil
+	})
+
 	suite.Step(`^the parked branches "([^"]+)" and "([^"]+)"$`, func(branch1, branch2 string) error {
 		return state.fixture.DevRepo.Config.SetParkedBranches(gitdomain.NewLocalBranchNames(branch1, branch2))
 	})
@@ -1660,6 +1810,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^there are (?:now|still) no prototype branches$`, func() error {
+		branches := state.fixture.DevRepo.Config.LocalGitConfig.PrototypeBranches
+		if len(branches) > 0 {
+			return fmt.Errorf("expected no prototype branches, got %q", branches)
+		}
+		return nil
+	})
+
 	suite.Step(`^there are (?:now|still) no perennial branches$`, func() error {
 		branches := state.fixture.DevRepo.Config.LocalGitConfig.PerennialBranches
 		if len(branches) > 0 {
@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// RecordingServer is an HTTP test server that records the requests it receives.
+// HTTP handlers run on other goroutines than the test,
+// hence tests must verify the recorded requests after the fact instead of inside the handler.
+type RecordingServer struct {
+	*httptest.Server
+	mutex    *sync.Mutex
+	requests *[]RecordedRequest
+}
+
+// NewRecordingServer starts a RecordingServer that answers requests using the given Responder.
+// The server shuts down when the given test ends.
+func NewRecordingServer(t *testing.T, responder Responder) RecordingServer {
+	t.Helper()
+	mutex := sync.Mutex{}
+	requests := []RecordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		username, password, _ := r.BasicAuth()
+		request := RecordedRequest{
+			Body:     string(body),
+			Header:   r.Header.Clone(),
+			Method:   r.Method,
+			Password: password,
+			Path:     r.URL.Path,
+			Query:    r.URL.Query(),
+			Username: username,
+		}
+		mutex.Lock()
+		requests = append(requests, request)
+		mutex.Unlock()
+		status, response := responder(request)
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+	return RecordingServer{
+		Server:   server,
+		mutex:    &mutex,
+		requests: &requests,
+	}
+}
+
+// OnlyRequest provides the request this server received
+// and fails the given test if it received no or multiple requests.
+func (self RecordingServer) OnlyRequest(t *testing.T) RecordedRequest {
+	t.Helper()
+	requests := self.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("expected the server to receive one request but it received %d", len(requests))
+	}
+	return requests[0]
+}
+
+// Requests provides the requests that this server has received so far.
+func (self RecordingServer) Requests() []RecordedRequest {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return slices.Clone(*self.requests)
+}
+
+// RecordedRequest describes an HTTP request that a RecordingServer received.
+type RecordedRequest struct {
+	Body     string
+	Header   http.Header
+	Method   string
+	Password string // password of the basic authentication
+	Path     string
+	Query    url.Values
+	Username string // username of the basic authentication
+}
+
+// JSON provides the JSON-decoded body of this request.
+func (self RecordedRequest) JSON(t *testing.T) map[string]any {
+	t.Helper()
+	result := map[string]any{}
+	if err := json.Unmarshal([]byte(self.Body), &result); err != nil {
+		t.Fatalf("request body %q is not a JSON object: %v", self.Body, err)
+	}
+	return result
+}
+
+// Responder determines the status code and body of the response to the given request.
+type Responder func(request RecordedRequest) (status int, body string)
+
+// Respond provides a Responder that answers all requests with the given status code and body.
+func Respond(status int, body string) Responder {
+	return func(_ RecordedRequest) (int, string) {
+		return status, body
+	}
+}
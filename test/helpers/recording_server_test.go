@@ -0,0 +1,43 @@
+package helpers_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/git-town/git-town/v14/test/helpers"
+	"github.com/shoenig/test/must"
+)
+
+func TestRecordingServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records requests and answers them", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusCreated, `{"id": 1}`))
+		request, err := http.NewRequest(http.MethodPost, server.URL+"/items?page=2", strings.NewReader(`{"name": "one"}`)) //nolint:noctx
+		must.NoError(t, err)
+		request.SetBasicAuth("user", "secret")
+		response, err := http.DefaultClient.Do(request)
+		must.NoError(t, err)
+		defer response.Body.Close()
+		content, err := io.ReadAll(response.Body)
+		must.NoError(t, err)
+		must.EqOp(t, http.StatusCreated, response.StatusCode)
+		must.EqOp(t, `{"id": 1}`, string(content))
+		have := server.OnlyRequest(t)
+		must.EqOp(t, http.MethodPost, have.Method)
+		must.EqOp(t, "/items", have.Path)
+		must.EqOp(t, "2", have.Query.Get("page"))
+		must.EqOp(t, "user", have.Username)
+		must.EqOp(t, "secret", have.Password)
+		must.Eq(t, map[string]any{"name": "one"}, have.JSON(t))
+	})
+
+	t.Run("no requests", func(t *testing.T) {
+		t.Parallel()
+		server := helpers.NewRecordingServer(t, helpers.Respond(http.StatusOK, ""))
+		must.SliceEmpty(t, server.Requests())
+	})
+}
@@ -97,6 +97,36 @@ fi`
 	self.createMockBinary("git", content)
 }
 
+// MockHostingCommand creates a shell command that responds to all requests
+// from the external hosting connector with the given JSON response.
+// Returns the path of the created command.
+func (self *TestRunner) MockHostingCommand(response string) string {
+	content := fmt.Sprintf("#!/usr/bin/env bash\n\ncat > /dev/null\ncat <<'EOF'\n%s\nEOF\n", response)
+	self.createMockBinary("hosting-command", content)
+	return filepath.Join(self.BinDir, "hosting-command")
+}
+
+// MockHostingCommandResponses creates a shell command that answers the requests
+// from the external hosting connector that match the given responses,
+// and all other requests with an empty JSON object.
+// Returns the path of the created command.
+func (self *TestRunner) MockHostingCommandResponses(responses []HostingCommandResponse) string {
+	content := strings.Builder{}
+	content.WriteString("#!/usr/bin/env bash\n\nrequest=$(cat)\ncase \"$request\" in\n")
+	for _, response := range responses {
+		// the request JSON contains the branch before the operation
+		pattern := "*"
+		if response.Branch != "" {
+			pattern += fmt.Sprintf(`'"branch":%q'*`, response.Branch)
+		}
+		pattern += fmt.Sprintf(`'"operation":%q'*`, response.Operation)
+		content.WriteString(fmt.Sprintf("  %s)\n    cat <<'EOF'\n%s\nEOF\n    ;;\n", pattern, response.Response))
+	}
+	content.WriteString("  *)\n    echo '{}'\n    ;;\nesac\n")
+	self.createMockBinary("hosting-command", content.String())
+	return filepath.Join(self.BinDir, "hosting-command")
+}
+
 // MockNoCommandsInstalled pretends that no commands are installed.
 func (self *TestRunner) MockNoCommandsInstalled() {
 	content := "#!/usr/bin/env bash\n\nexit 1\n"
@@ -248,6 +278,12 @@ func (self *TestRunner) Run(name string, arguments ...string) error {
 	return err
 }
 
+// RunWithEnv runs the given command with the given additional environment variables.
+func (self *TestRunner) RunWithEnv(env []string, name string, arguments ...string) error {
+	_, err := self.QueryWith(&Options{Env: append(os.Environ(), env...), IgnoreOutput: true}, name, arguments...)
+	return err
+}
+
 // SetTestOrigin adds the given environment variable to subsequent runs of commands.
 func (self *TestRunner) SetTestOrigin(content string) {
 	self.testOrigin = Some(content)
@@ -271,6 +307,14 @@ func (self *TestRunner) createMockBinary(name string, content string) {
 	asserts.NoError(os.WriteFile(filepath.Join(self.BinDir, name), []byte(content), 0o744))
 }
 
+// HostingCommandResponse defines how the mock external hosting command
+// responds to requests with the given operation for the given branch.
+type HostingCommandResponse struct {
+	Branch    string // matches requests for all branches if empty
+	Operation string
+	Response  string
+}
+
 // Options defines optional arguments for ShellRunner.RunWith().
 type Options struct {
 	// Dir contains the directory in which to execute the command.